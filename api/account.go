@@ -2,14 +2,27 @@ package api
 
 import (
 	"database/sql"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/policy"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 )
 
+var (
+	ErrAccountNotActive     = errors.New("account is not active")
+	ErrAccountNotFrozen     = errors.New("account is not frozen")
+	ErrAccountFrozenByOther = errors.New("account is frozen by another user and can't be unfrozen by its owner")
+)
+
 // createAccountRequest holds the params of the request's and response's
 type createAccountRequest struct {
 	Currency string `json:"currency"  binding:"required,currency"`
@@ -63,25 +76,13 @@ func (server *Server) getAccountById(ctx *gin.Context) {
 		return
 	}
 
-	account, err := server.store.GetAccount(ctx, req.ID)
-
-	if err != nil {
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
+	// here we prevent users to check other user's accounts, admins can check every account
+	account, valid := server.authorizeAccount(ctx, req.ID, policy.ReadAccount)
 
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	if !valid {
 		return
 	}
 
-	// here we prevent users to check other user's accounts
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
-	if account.Owner != authPayload.Username {
-		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
-	}
-
 	ctx.JSON(http.StatusOK, account)
 }
 
@@ -93,6 +94,11 @@ type ListAccountsRequest struct {
 
 // listAccounts returns a accounts as specified in the query of the URI
 func (server *Server) listAccounts(ctx *gin.Context) {
+	if !pagedByOffset(ctx) {
+		server.listAccountsPage(ctx)
+		return
+	}
+
 	var req ListAccountsRequest
 
 	if err := ctx.ShouldBindQuery(&req); err != nil {
@@ -116,3 +122,234 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, accounts)
 }
+
+// listAccountsPageRequest holds the filters and the cursor of the page of the accounts, from is inclusive and to is exclusive
+type listAccountsPageRequest struct {
+	Currency   string    `form:"currency" binding:"omitempty,currency"`
+	From       time.Time `form:"from"`
+	To         time.Time `form:"to" binding:"omitempty,gtfield=From"`
+	MinBalance *int64    `form:"min_balance"`
+	MaxBalance *int64    `form:"max_balance"`
+	cursorPageRequest
+}
+
+// listAccountsPageResponse holds a page of the accounts and the cursor of the next page, which is empty on the last page
+type listAccountsPageResponse struct {
+	Accounts   []db.Account `json:"accounts"`
+	NextCursor string       `json:"next_cursor"`
+}
+
+// listAccountsPage returns a page of the accounts of the authenticated user which match the filters
+func (server *Server) listAccountsPage(ctx *gin.Context) {
+	var req listAccountsPageRequest
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.ListAccountsPageParams{
+		Owner:      authPayload.Username,
+		Currency:   req.Currency,
+		From:       req.From,
+		To:         req.To,
+		MinBalance: req.MinBalance,
+		MaxBalance: req.MaxBalance,
+	}
+
+	page, err := req.page(arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg.PageParams = page.Params()
+
+	accounts, err := server.store.ListAccountsPage(ctx, arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	resp := listAccountsPageResponse{}
+	resp.Accounts, resp.NextCursor = pagination.Cut(page, accounts, func(account db.Account) int64 { return account.ID })
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// freezeAccount freezes an active account of the authenticated user or any account for admins, frozen accounts can receive money but can't send it
+func (server *Server) freezeAccount(ctx *gin.Context) {
+	var req getAccountByIdRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizeAccount(ctx, req.ID, policy.FreezeAccount)
+
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	account, err := server.store.FreezeAccountTx(ctx, db.FreezeAccountTxParams{
+		AccountID:     req.ID,
+		FrozenBy:      authPayload.Username,
+		AdminUsername: adminActor(ctx, account.Owner),
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		// only active accounts are frozen
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(ErrAccountNotActive))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+// unfreezeAccount activates a frozen account of the authenticated user or any account for admins again,
+// owners can only lift the freezes which they placed
+func (server *Server) unfreezeAccount(ctx *gin.Context) {
+	var req getAccountByIdRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizeAccount(ctx, req.ID, policy.UnfreezeAccount)
+
+	if !valid {
+		return
+	}
+
+	// owners can't lift the freezes which admins placed on their accounts
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	if !policy.CanUnfreeze(authPayload, account.Owner, account.FrozenBy) {
+		ctx.JSON(http.StatusForbidden, errorResponse(ErrAccountFrozenByOther))
+		return
+	}
+
+	account, err := server.store.UnfreezeAccountTx(ctx, db.UnfreezeAccountTxParams{
+		AccountID:     req.ID,
+		AdminUsername: adminActor(ctx, account.Owner),
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(ErrAccountNotFrozen))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, account)
+}
+
+// closeAccountBody holds the account which receives the remaining balance,
+// fx quote is only needed if its currency is different
+type closeAccountBody struct {
+	SweepAccountID int64  `json:"sweep_account_id" binding:"omitempty,min=1"`
+	FxQuoteID      string `json:"fx_quote_id" binding:"omitempty,uuid"`
+}
+
+// closeAccount closes an account of the authenticated user, the account must be empty or its balance is swept
+// to another account of the user. Closed accounts are kept with their entries and transfers
+func (server *Server) closeAccount(ctx *gin.Context) {
+	var req getAccountByIdRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var body closeAccountBody
+	if err := ctx.ShouldBindJSON(&body); err != nil && err != io.EOF {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizeAccount(ctx, req.ID, policy.CloseAccount)
+
+	if !valid {
+		return
+	}
+
+	arg := db.CloseAccountTxParams{
+		AccountID:      req.ID,
+		SweepAccountID: body.SweepAccountID,
+		Actor:          auditActor(ctx),
+	}
+
+	if body.SweepAccountID != 0 {
+		sweepAccount, valid := server.authorizeAccount(ctx, body.SweepAccountID, policy.CloseAccount)
+
+		if !valid {
+			return
+		}
+
+		if body.FxQuoteID != "" {
+			quoteID, valid := server.validSweepFxQuote(ctx, body.FxQuoteID, account, sweepAccount)
+
+			if !valid {
+				return
+			}
+
+			arg.QuoteID = &quoteID
+		}
+	}
+
+	result, err := server.store.CloseAccountTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrAccountClosed, db.ErrAccountFrozen, db.ErrAccountBalanceNotZero, db.ErrAccountHasHolds,
+			db.ErrInvalidSweepAccount, db.ErrSweepNeedsFxQuote, db.ErrFxQuoteUnavailable,
+			db.ErrConvertedAmountTooSmall, db.ErrFxQuoteCurrencyMismatch, db.ErrFxQuoteNotOwned, db.ErrSystemAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// validSweepFxQuote checks if the quote belongs to the authenticated user and converts between the currencies of the accounts
+func (server *Server) validSweepFxQuote(ctx *gin.Context, id string, account, sweepAccount db.Account) (uuid.UUID, bool) {
+	quote, err := server.store.GetFxQuote(ctx, uuid.MustParse(id))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return quote.ID, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return quote.ID, false
+	}
+
+	if !authorize(ctx, policy.UseFxQuote, quote.Username) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrFxQuoteIsNotAuthenticatedUsers))
+		return quote.ID, false
+	}
+
+	if quote.FromCurrency != account.Currency || quote.ToCurrency != sweepAccount.Currency {
+		err := fmt.Errorf("fx quote currency mismatch: %s/%s vs %s/%s", quote.FromCurrency, quote.ToCurrency, account.Currency, sweepAccount.Currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return quote.ID, false
+	}
+
+	return quote.ID, true
+}
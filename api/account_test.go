@@ -13,6 +13,7 @@ import (
 
 	mockdb "github.com/burakkarasel/Bank-App/db/mock"
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/burakkarasel/Bank-App/util"
 	"github.com/gin-gonic/gin"
@@ -123,6 +124,20 @@ func TestGetAccountByIdAPI(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name:      "Admin User",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, "admin_user", util.AdminRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, acc)
+			},
+		},
 		{
 			name:      "No Authorization",
 			accountID: acc.ID,
@@ -394,16 +409,22 @@ func TestListAccountsAPI(t *testing.T) {
 			},
 		},
 		{
-			name:  "No page id",
+			name:  "No page id pages by cursor",
 			query: "?page_size=9",
 			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
 				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
 			},
 			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsPageParams{
+					Owner:      user.Username,
+					PageParams: db.PageParams{Limit: 10},
+				}
 				store.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return(accounts, nil)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccountsPage(t, recorder.Body, accounts, false)
 			},
 		},
 		{
@@ -455,3 +476,625 @@ func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Ac
 
 	require.Equal(t, accounts, gotAccounts)
 }
+
+// TestListAccountsPageAPI tests listAccounts handler when it pages by cursor with multiple cases
+func TestListAccountsPageAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	var accounts []db.Account
+	n := 3
+
+	for i := 0; i < n; i++ {
+		acc := randomAccount(user.Username)
+		acc.ID = int64(i + 1)
+		accounts = append(accounts, acc)
+	}
+
+	//! cursor points after the second account in descending order of the USD accounts
+	filter := db.ListAccountsPageParams{Owner: user.Username, Currency: util.USD}
+	page, err := pagination.New("", 2, pagination.SortDesc, filter)
+	require.NoError(t, err)
+	_, cursor := pagination.Cut(page, []db.Account{accounts[2], accounts[1], accounts[0]}, func(account db.Account) int64 { return account.ID })
+	require.NotEmpty(t, cursor)
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "FirstPage",
+			query: "?page_size=2&sort=desc&currency=USD",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsPageParams{
+					Owner:      user.Username,
+					Currency:   util.USD,
+					PageParams: db.PageParams{Descending: true, Limit: 3},
+				}
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Account{accounts[2], accounts[1], accounts[0]}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				nextCursor := requireBodyMatchAccountsPage(t, recorder.Body, []db.Account{accounts[2], accounts[1]}, true)
+				require.Equal(t, cursor, nextCursor)
+			},
+		},
+		{
+			name:  "NextPage",
+			query: "?page_size=2&sort=desc&currency=USD&cursor=" + cursor,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsPageParams{
+					Owner:      user.Username,
+					Currency:   util.USD,
+					PageParams: db.PageParams{AfterID: accounts[1].ID, Descending: true, Limit: 3},
+				}
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Account{accounts[0]}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccountsPage(t, recorder.Body, []db.Account{accounts[0]}, false)
+			},
+		},
+		{
+			name:  "Filters",
+			query: "?from=2022-01-01T00:00:00Z&to=2022-02-01T00:00:00Z&min_balance=10&max_balance=100",
+			buildStubs: func(store *mockdb.MockStore) {
+				minBalance, maxBalance := int64(10), int64(100)
+				arg := db.ListAccountsPageParams{
+					Owner:      user.Username,
+					From:       time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+					To:         time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+					MinBalance: &minBalance,
+					MaxBalance: &maxBalance,
+					PageParams: db.PageParams{Limit: pagination.DefaultPageSize + 1},
+				}
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Account{}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccountsPage(t, recorder.Body, []db.Account{}, false)
+			},
+		},
+		{
+			name:  "CursorWithOtherFilters",
+			query: "?page_size=2&sort=desc&currency=EUR&cursor=" + cursor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "CursorWithOtherSort",
+			query: "?page_size=2&currency=USD&cursor=" + cursor,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidCursor",
+			query: "?cursor=random",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidSort",
+			query: "?sort=random",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidPageSize",
+			query: "?page_size=101",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InvalidTimeRange",
+			query: "?from=2022-02-01T00:00:00Z&to=2022-01-01T00:00:00Z",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "InternalServerError",
+			query: "?page_size=5",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/accounts"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// requireBodyMatchAccountsPage checks the accounts of the page and whether it has a next cursor, and returns the cursor
+func requireBodyMatchAccountsPage(t *testing.T, body *bytes.Buffer, accounts []db.Account, hasNext bool) string {
+	var got listAccountsPageResponse
+	err := json.Unmarshal(body.Bytes(), &got)
+	require.NoError(t, err)
+
+	require.Equal(t, accounts, got.Accounts)
+	require.Equal(t, hasNext, got.NextCursor != "")
+
+	return got.NextCursor
+}
+
+// TestFreezeAccountAPI tests freezeAccount and unfreezeAccount handlers with multiple cases
+func TestFreezeAccountAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	acc := randomAccount(user.Username)
+
+	frozen := acc
+	frozen.Status = db.AccountFrozen
+	frozen.FrozenBy = &user.Username
+
+	adminUsername := "admin_user"
+
+	frozenByAdmin := acc
+	frozenByAdmin.Status = db.AccountFrozen
+	frozenByAdmin.FrozenBy = &adminUsername
+
+	active := acc
+	active.Status = db.AccountActive
+
+	testCases := []struct {
+		name          string
+		action        string
+		accountID     int64
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:      "Freeze OK",
+			action:    "freeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().FreezeAccountTx(gomock.Any(), gomock.Eq(db.FreezeAccountTxParams{AccountID: acc.ID, FrozenBy: user.Username, Actor: testAuditActor(user.Username)})).Times(1).Return(frozen, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, frozen)
+			},
+		},
+		{
+			name:      "Freeze Not Active",
+			action:    "freeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().FreezeAccountTx(gomock.Any(), gomock.Eq(db.FreezeAccountTxParams{AccountID: acc.ID, FrozenBy: user.Username, Actor: testAuditActor(user.Username)})).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:      "Freeze Unauthorized User",
+			action:    "freeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, "unauthorized_user", time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().FreezeAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Freeze By Admin",
+			action:    "freeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, adminUsername, util.AdminRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().FreezeAccountTx(gomock.Any(), gomock.Eq(db.FreezeAccountTxParams{AccountID: acc.ID, FrozenBy: adminUsername, AdminUsername: adminUsername, Actor: testAuditActor(adminUsername)})).Times(1).Return(frozenByAdmin, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, frozenByAdmin)
+			},
+		},
+		{
+			name:      "Freeze Not Found",
+			action:    "freeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().FreezeAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "Unfreeze OK",
+			action:    "unfreeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(frozen, nil)
+				store.EXPECT().UnfreezeAccountTx(gomock.Any(), gomock.Eq(db.UnfreezeAccountTxParams{AccountID: acc.ID, Actor: testAuditActor(user.Username)})).Times(1).Return(active, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, active)
+			},
+		},
+		{
+			name:      "Unfreeze Frozen By Admin",
+			action:    "unfreeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(frozenByAdmin, nil)
+				store.EXPECT().UnfreezeAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:      "Unfreeze Frozen By Admin As Admin",
+			action:    "unfreeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, adminUsername, util.AdminRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(frozenByAdmin, nil)
+				store.EXPECT().UnfreezeAccountTx(gomock.Any(), gomock.Eq(db.UnfreezeAccountTxParams{AccountID: acc.ID, AdminUsername: adminUsername, Actor: testAuditActor(adminUsername)})).Times(1).Return(active, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccount(t, recorder.Body, active)
+			},
+		},
+		{
+			name:      "Unfreeze Not Frozen",
+			action:    "unfreeze",
+			accountID: acc.ID,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(active, nil)
+				store.EXPECT().UnfreezeAccountTx(gomock.Any(), gomock.Eq(db.UnfreezeAccountTxParams{AccountID: acc.ID, Actor: testAuditActor(user.Username)})).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:      "Invalid ID",
+			action:    "freeze",
+			accountID: 0,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/accounts/%d/%s", tt.accountID, tt.action)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			tt.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestCloseAccountAPI tests closeAccount handler with multiple cases
+func TestCloseAccountAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	acc := randomAccount(user.Username)
+	acc.Currency = util.USD
+
+	sweepAcc := randomAccount(user.Username)
+	sweepAcc.ID = acc.ID + 1
+	sweepAcc.Currency = util.EUR
+
+	otherUser, _ := randomUser(t)
+	otherAcc := randomAccount(otherUser.Username)
+	otherAcc.ID = acc.ID + 2
+
+	quote := randomFxQuote(user.Username)
+
+	closed := acc
+	closed.Status = db.AccountClosed
+	closed.Balance = 0
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: nil,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(db.CloseAccountTxParams{AccountID: acc.ID, Actor: testAuditActor(user.Username)})).
+					Times(1).Return(db.CloseAccountTxResult{Account: closed}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var result db.CloseAccountTxResult
+				err := json.Unmarshal(recorder.Body.Bytes(), &result)
+				require.NoError(t, err)
+				require.Equal(t, db.AccountClosed, result.Account.Status)
+				require.Nil(t, result.Sweep)
+			},
+		},
+		{
+			name: "OK With Fx Sweep",
+			body: gin.H{
+				"sweep_account_id": sweepAcc.ID,
+				"fx_quote_id":      quote.ID.String(),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CloseAccountTxParams{
+					AccountID:      acc.ID,
+					SweepAccountID: sweepAcc.ID,
+					QuoteID:        &quote.ID,
+					Actor:          testAuditActor(user.Username),
+				}
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(sweepAcc.ID)).Times(1).Return(sweepAcc, nil)
+				store.EXPECT().GetFxQuote(gomock.Any(), gomock.Eq(quote.ID)).Times(1).Return(quote, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).Return(db.CloseAccountTxResult{Account: closed, Sweep: &db.TransferTxResult{}}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Balance Not Zero",
+			body: nil,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, db.ErrAccountBalanceNotZero)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Pending Holds",
+			body: nil,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, db.ErrAccountHasHolds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "System Account",
+			body: nil,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, db.ErrSystemAccount)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Sweep Account Of Other User",
+			body: gin.H{
+				"sweep_account_id": otherAcc.ID,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(otherAcc.ID)).Times(1).Return(otherAcc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Admin can't close",
+			body: gin.H{},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, "admin_user", util.AdminRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Fx Quote Currency Mismatch",
+			body: gin.H{
+				"sweep_account_id": sweepAcc.ID,
+				"fx_quote_id":      quote.ID.String(),
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				mismatched := quote
+				mismatched.ToCurrency = util.CAD
+
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(sweepAcc.ID)).Times(1).Return(sweepAcc, nil)
+				store.EXPECT().GetFxQuote(gomock.Any(), gomock.Eq(quote.ID)).Times(1).Return(mismatched, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Fx Quote ID",
+			body: gin.H{
+				"sweep_account_id": sweepAcc.ID,
+				"fx_quote_id":      "invalid",
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			body: nil,
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().CloseAccountTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CloseAccountTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			var body *bytes.Reader
+
+			if tt.body != nil {
+				data, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			} else {
+				body = bytes.NewReader(nil)
+			}
+
+			url := fmt.Sprintf("/accounts/%d/close", acc.ID)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			tt.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrAdminRoleRequired = errors.New("admin role is required")
+var ErrSessionNotBlocked = errors.New("session is not blocked")
+
+// adminPageRequest holds the query params of the admin handlers which list records
+type adminPageRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+// adminUserRequest holds the username of the user in the URI
+type adminUserRequest struct {
+	Username string `uri:"username" binding:"required,alphanum"`
+}
+
+// adminSearchUsersRequest holds the part of the email to search and the page
+type adminSearchUsersRequest struct {
+	Email string `form:"email" binding:"required"`
+	adminPageRequest
+}
+
+// adminSearchUsers lists the users whose email contains the given email
+func (server *Server) adminSearchUsers(ctx *gin.Context) {
+	var req adminSearchUsersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	users, err := server.store.SearchUsers(ctx, db.SearchUsersParams{
+		Email:  req.Email,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.auditAdminAction(ctx, db.AdminActionSearchUsers, db.AuditTargetUser, req.Email) {
+		return
+	}
+
+	resp := make([]userResponse, len(users))
+
+	for i, user := range users {
+		resp[i] = newUserResponse(user)
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// adminListUserAccounts lists the accounts of any user
+func (server *Server) adminListUserAccounts(ctx *gin.Context) {
+	var uri adminUserRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminPageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:  uri.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.auditAdminAction(ctx, db.AdminActionListUserAccounts, db.AuditTargetUser, uri.Username) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, accounts)
+}
+
+// adminListUserEntries lists the entries of every account of any user
+func (server *Server) adminListUserEntries(ctx *gin.Context) {
+	var uri adminUserRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminPageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	entries, err := server.store.ListEntriesByOwner(ctx, db.ListEntriesByOwnerParams{
+		Owner:  uri.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.auditAdminAction(ctx, db.AdminActionListUserEntries, db.AuditTargetUser, uri.Username) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// adminAccountRequest holds the ID of the account in the URI
+type adminAccountRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// adminListAccountEntries lists the entries of any account
+func (server *Server) adminListAccountEntries(ctx *gin.Context) {
+	var uri adminAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminPageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, err := server.store.GetAccount(ctx, uri.ID); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID: uri.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !server.auditAdminAction(ctx, db.AdminActionListAccountEntries, db.AuditTargetAccount, strconv.FormatInt(uri.ID, 10)) {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// adminAdjustBalanceRequest holds the amount which is added to the balance, negative amount takes money out
+type adminAdjustBalanceRequest struct {
+	Amount int64  `json:"amount" binding:"required"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+// adminAdjustBalance adjusts the balance of any account with an entry, the reason is written to the audit log
+func (server *Server) adminAdjustBalance(ctx *gin.Context) {
+	var uri adminAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminAdjustBalanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	result, err := server.store.AdjustBalanceTx(ctx, db.AdjustBalanceTxParams{
+		AdminUsername: authPayload.Username,
+		AccountID:     uri.ID,
+		Amount:        req.Amount,
+		Reason:        req.Reason,
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		if err == db.ErrAccountClosed || err == db.ErrInsufficientFunds || err == db.ErrSystemAccount {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// adminSetOverdraftLimitRequest holds how far the balance of the account can go below zero, zero disables the overdraft
+type adminSetOverdraftLimitRequest struct {
+	OverdraftLimit *int64 `json:"overdraft_limit" binding:"required,min=0"`
+	Reason         string `json:"reason" binding:"required"`
+}
+
+// adminSetOverdraftLimit sets the overdraft limit of any customer account, the reason is written to the audit log
+func (server *Server) adminSetOverdraftLimit(ctx *gin.Context) {
+	var uri adminAccountRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminSetOverdraftLimitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	result, err := server.store.SetOverdraftLimitTx(ctx, db.SetOverdraftLimitTxParams{
+		AdminUsername:  authPayload.Username,
+		AccountID:      uri.ID,
+		OverdraftLimit: *req.OverdraftLimit,
+		Reason:         req.Reason,
+		Actor:          auditActor(ctx),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		if err == db.ErrAccountClosed || err == db.ErrSystemAccount {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// adminSessionRequest holds the ID of the session in the URI
+type adminSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// adminUnblockSessionRequest holds the reason of unblocking the session
+type adminUnblockSessionRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// adminUnblockSessionResponse holds the unblocked session and the audit log of it
+type adminUnblockSessionResponse struct {
+	Session  sessionResponse  `json:"session"`
+	AuditLog db.AdminAuditLog `json:"audit_log"`
+}
+
+// adminUnblockSession unblocks a blocked session of any user, so its refresh token can renew access tokens again
+func (server *Server) adminUnblockSession(ctx *gin.Context) {
+	var uri adminSessionRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req adminUnblockSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	id := uuid.MustParse(uri.ID)
+
+	if _, err := server.store.GetSession(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	result, err := server.store.UnblockSessionTx(ctx, db.UnblockSessionTxParams{
+		AdminUsername: authPayload.Username,
+		SessionID:     id,
+		Reason:        req.Reason,
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		// only blocked sessions are unblocked
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(ErrSessionNotBlocked))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, adminUnblockSessionResponse{
+		Session:  newSessionResponse(result.Session),
+		AuditLog: result.AuditLog,
+	})
+}
+
+// adminListAuditLogs lists the audit logs of the admins, the latest ones first
+func (server *Server) adminListAuditLogs(ctx *gin.Context) {
+	var req adminPageRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	logs, err := server.store.ListAdminAuditLogs(ctx, db.ListAdminAuditLogsParams{
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, logs)
+}
+
+// adminGetLatestReconciliationReport returns the latest report of the ledger reconciliation with its discrepancies
+func (server *Server) adminGetLatestReconciliationReport(ctx *gin.Context) {
+	report, err := server.store.GetLatestReconciliationReport(ctx)
+
+	if err != nil {
+		// the ledger isn't reconciled yet
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// auditAdminAction writes the audit log of an admin action which only reads data,
+// the data must not be returned if the action can't be audited
+func (server *Server) auditAdminAction(ctx *gin.Context, action, targetType, targetID string) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	_, err := server.store.CreateAdminAuditLog(ctx, db.CreateAdminAuditLogParams{
+		AdminUsername: authPayload.Username,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return false
+	}
+
+	return true
+}
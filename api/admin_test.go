@@ -0,0 +1,617 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// addAdminAuthorization creates a token of an admin and sets request's header with it
+func addAdminAuthorization(t *testing.T, request *http.Request, tokenMaker token.Maker, username string) {
+	addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, username, util.AdminRole, time.Minute)
+}
+
+// TestAdminSearchUsersAPI tests adminSearchUsers handler and the admin role check of the admin routes
+func TestAdminSearchUsersAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		query         string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: fmt.Sprintf("email=%s&page_id=1&page_size=5", user.Email),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SearchUsersParams{
+					Email:  user.Email,
+					Limit:  5,
+					Offset: 0,
+				}
+				store.EXPECT().SearchUsers(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.User{user}, nil)
+
+				audit := db.CreateAdminAuditLogParams{
+					AdminUsername: admin.Username,
+					Action:        db.AdminActionSearchUsers,
+					TargetType:    db.AuditTargetUser,
+					TargetID:      user.Email,
+				}
+				store.EXPECT().CreateAdminAuditLog(gomock.Any(), gomock.Eq(audit)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got []db.User
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+
+				require.Len(t, got, 1)
+				require.Equal(t, user.Username, got[0].Username)
+				require.Empty(t, got[0].HashedPassword)
+			},
+		},
+		{
+			name:  "Depositor",
+			query: fmt.Sprintf("email=%s&page_id=1&page_size=5", user.Email),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchUsers(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateAdminAuditLog(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "No Authorization",
+			query: fmt.Sprintf("email=%s&page_id=1&page_size=5", user.Email),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:  "No Email",
+			query: "page_id=1&page_size=5",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchUsers(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Audit Error",
+			query: fmt.Sprintf("email=%s&page_id=1&page_size=5", user.Email),
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SearchUsers(gomock.Any(), gomock.Any()).Times(1).Return([]db.User{user}, nil)
+				store.EXPECT().CreateAdminAuditLog(gomock.Any(), gomock.Any()).Times(1).Return(db.AdminAuditLog{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/users?"+tt.query, nil)
+			require.NoError(t, err)
+
+			tt.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAdminAdjustBalanceAPI tests adminAdjustBalance handler with multiple cases
+func TestAdminAdjustBalanceAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	user, _ := randomUser(t)
+	acc := randomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"amount": -10, "reason": "chargeback"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.AdjustBalanceTxParams{
+					AdminUsername: admin.Username,
+					AccountID:     acc.ID,
+					Amount:        -10,
+					Reason:        "chargeback",
+					Actor:         testAuditActor(admin.Username),
+				}
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "No Reason",
+			body: gin.H{"amount": 10},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Zero Amount",
+			body: gin.H{"amount": 0, "reason": "chargeback"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Account Not Found",
+			body: gin.H{"amount": 10, "reason": "chargeback"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AdjustBalanceTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Insufficient Funds",
+			body: gin.H{"amount": -10000, "reason": "chargeback"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AdjustBalanceTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			body: gin.H{"amount": 10, "reason": "chargeback"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AdjustBalanceTx(gomock.Any(), gomock.Any()).Times(1).Return(db.AdjustBalanceTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/accounts/%d/adjustments", acc.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAdminAuthorization(t, req, server.tokenMaker, admin.Username)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAdminSetOverdraftLimitAPI tests adminSetOverdraftLimit handler with multiple cases
+func TestAdminSetOverdraftLimitAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	user, _ := randomUser(t)
+	acc := randomAccount(user.Username)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"overdraft_limit": 500, "reason": "credit line"},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.SetOverdraftLimitTxParams{
+					AdminUsername:  admin.Username,
+					AccountID:      acc.ID,
+					OverdraftLimit: 500,
+					Reason:         "credit line",
+					Actor:          testAuditActor(admin.Username),
+				}
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Zero Limit",
+			body: gin.H{"overdraft_limit": 0, "reason": "credit line closed"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Negative Limit",
+			body: gin.H{"overdraft_limit": -1, "reason": "credit line"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "No Limit",
+			body: gin.H{"reason": "credit line"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Account Not Found",
+			body: gin.H{"overdraft_limit": 500, "reason": "credit line"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetOverdraftLimitTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Closed Account",
+			body: gin.H{"overdraft_limit": 500, "reason": "credit line"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().SetOverdraftLimitTx(gomock.Any(), gomock.Any()).Times(1).Return(db.SetOverdraftLimitTxResult{}, db.ErrAccountClosed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/accounts/%d/overdraft_limit", acc.ID)
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAdminAuthorization(t, req, server.tokenMaker, admin.Username)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAdminUnblockSessionAPI tests adminUnblockSession handler with multiple cases
+func TestAdminUnblockSessionAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	user, _ := randomUser(t)
+
+	session := randomSession(user.Username)
+	session.IsBlocked = true
+
+	unblocked := session
+	unblocked.IsBlocked = false
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{"reason": "customer verified"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+
+				arg := db.UnblockSessionTxParams{
+					AdminUsername: admin.Username,
+					SessionID:     session.ID,
+					Reason:        "customer verified",
+					Actor:         testAuditActor(admin.Username),
+				}
+				store.EXPECT().UnblockSessionTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.UnblockSessionTxResult{Session: unblocked}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got adminUnblockSessionResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+
+				require.Equal(t, session.ID, got.Session.ID)
+				require.False(t, got.Session.IsBlocked)
+			},
+		},
+		{
+			name: "Not Blocked",
+			body: gin.H{"reason": "customer verified"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(unblocked, nil)
+				store.EXPECT().UnblockSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.UnblockSessionTxResult{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Session Not Found",
+			body: gin.H{"reason": "customer verified"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+				store.EXPECT().UnblockSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "No Reason",
+			body: gin.H{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().UnblockSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			url := fmt.Sprintf("/admin/sessions/%s/unblock", session.ID)
+			req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAdminAuthorization(t, req, server.tokenMaker, admin.Username)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAdminGetLatestReconciliationReportAPI tests adminGetLatestReconciliationReport handler with multiple cases
+func TestAdminGetLatestReconciliationReportAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+
+	report := db.ReconciliationReport{
+		ID:                util.RandomInt(1, 1000),
+		AccountsChecked:   3,
+		TransfersChecked:  1,
+		CurrenciesChecked: 1,
+		DiscrepancyCount:  1,
+		Discrepancies:     json.RawMessage(`[{"kind":"account_balance","account_id":1,"expected":100,"actual":90}]`),
+	}
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(1).Return(report, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got struct {
+					ID               int64            `json:"id"`
+					DiscrepancyCount int64            `json:"discrepancy_count"`
+					Discrepancies    []db.Discrepancy `json:"discrepancies"`
+				}
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+
+				require.Equal(t, report.ID, got.ID)
+				require.Equal(t, report.DiscrepancyCount, got.DiscrepancyCount)
+				require.Len(t, got.Discrepancies, 1)
+				require.Equal(t, db.DiscrepancyAccountBalance, got.Discrepancies[0].Kind)
+			},
+		},
+		{
+			name: "Depositor",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, admin.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "Not Reconciled",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(1).
+					Return(db.ReconciliationReport{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, admin.Username)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetLatestReconciliationReport(gomock.Any()).Times(1).
+					Return(db.ReconciliationReport{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/reconciliation_reports/latest", nil)
+			require.NoError(t, err)
+
+			tt.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAdminMetricsAPI tests that only admins get the metrics with the retry counts of the DB transactions
+func TestAdminMetricsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		setupAuth     func(t *testing.T, request *http.Request, tokenMaker token.Maker)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAdminAuthorization(t, request, tokenMaker, user.Username)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"db_tx"`)
+			},
+		},
+		{
+			name: "Not Admin",
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/metrics", nil)
+			require.NoError(t, err)
+
+			tt.setupAuth(t, req, server.tokenMaker)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+)
+
+// auditActor returns the authenticated user and the client of the request, which are written to the audit events
+func auditActor(ctx *gin.Context) *db.AuditActor {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	return &db.AuditActor{
+		Username:  authPayload.Username,
+		Channel:   db.AuditChannelHTTP,
+		ClientIP:  ctx.ClientIP(),
+		UserAgent: ctx.Request.UserAgent(),
+	}
+}
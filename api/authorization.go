@@ -0,0 +1,206 @@
+package api
+
+import (
+	"database/sql"
+	"net/http"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+)
+
+// authorize checks if the authenticated user may do the action on a resource which belongs to owner
+func authorize(ctx *gin.Context, action policy.Action, owner string) bool {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	return policy.Can(authPayload, action, owner)
+}
+
+// adminActor returns the authenticated user if it acts on a resource of owner through its role, those actions
+// are written to the admin audit logs. It returns an empty username when the user acts on its own resource
+func adminActor(ctx *gin.Context, owner string) string {
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	if authPayload.Username == owner {
+		return ""
+	}
+
+	return authPayload.Username
+}
+
+// authorizeAccount gets the account and checks if the authenticated user may do the action on it
+func (server *Server) authorizeAccount(ctx *gin.Context, id int64, action policy.Action) (db.Account, bool) {
+	account, err := server.store.GetAccount(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return account, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return account, false
+	}
+
+	if !authorize(ctx, action, account.Owner) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
+		return account, false
+	}
+
+	return account, true
+}
+
+// authorizeTransfer gets the transfer and checks if the authenticated user may read the account on either side of it
+func (server *Server) authorizeTransfer(ctx *gin.Context, id int64) (db.Transfer, bool) {
+	transfer, err := server.store.GetTransfer(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return transfer, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return transfer, false
+	}
+
+	for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+		account, err := server.store.GetAccount(ctx, accountID)
+
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return transfer, false
+		}
+
+		if authorize(ctx, policy.ReadAccount, account.Owner) {
+			return transfer, true
+		}
+	}
+
+	ctx.JSON(http.StatusUnauthorized, errorResponse(ErrTransferIsNotAuthenticatedUsers))
+	return transfer, false
+}
+
+// authorizeHold gets the hold and checks if the authenticated user may read the account on either side of it
+func (server *Server) authorizeHold(ctx *gin.Context, id int64) (db.Hold, bool) {
+	hold, err := server.store.GetHold(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return hold, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return hold, false
+	}
+
+	for _, accountID := range []int64{hold.FromAccountID, hold.ToAccountID} {
+		account, err := server.store.GetAccount(ctx, accountID)
+
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return hold, false
+		}
+
+		if authorize(ctx, policy.ReadAccount, account.Owner) {
+			return hold, true
+		}
+	}
+
+	ctx.JSON(http.StatusUnauthorized, errorResponse(ErrHoldIsNotAuthenticatedUsers))
+	return hold, false
+}
+
+// authorizePayeeHold gets the hold and checks if the authenticated user may do the action on the account which the hold is for
+func (server *Server) authorizePayeeHold(ctx *gin.Context, id int64, action policy.Action) (db.Hold, bool) {
+	hold, err := server.store.GetHold(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return hold, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return hold, false
+	}
+
+	if _, valid := server.authorizeAccount(ctx, hold.ToAccountID, action); !valid {
+		return hold, false
+	}
+
+	return hold, true
+}
+
+// authorizePaymentRequest gets the payment request and checks if the authenticated user may read it as its requester or its payer
+func (server *Server) authorizePaymentRequest(ctx *gin.Context, id int64) (db.PaymentRequest, bool) {
+	request, err := server.store.GetPaymentRequest(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return request, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return request, false
+	}
+
+	if !authorize(ctx, policy.ReadPaymentRequest, request.Requester) && !authorize(ctx, policy.ReadPaymentRequest, request.Payer) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrPaymentRequestIsNotAuthenticatedUsers))
+		return request, false
+	}
+
+	return request, true
+}
+
+// authorizePaymentRequestUser gets the payment request and checks if the authenticated user may manage it as the user
+// on the side which answers it, the payer accepts or declines it and the requester cancels it
+func (server *Server) authorizePaymentRequestUser(ctx *gin.Context, id int64, user func(request db.PaymentRequest) string) (db.PaymentRequest, bool) {
+	request, valid := server.authorizePaymentRequest(ctx, id)
+
+	if !valid {
+		return request, false
+	}
+
+	if !authorize(ctx, policy.ManagePaymentRequest, user(request)) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrPaymentRequestIsNotAuthenticatedUsers))
+		return request, false
+	}
+
+	return request, true
+}
+
+// authorizeBillSplit gets the bill split with its payment requests and checks if the authenticated user may read it
+// as its initiator or as the payer of one of its payment requests
+func (server *Server) authorizeBillSplit(ctx *gin.Context, id int64) (db.BillSplitDetail, bool) {
+	var detail db.BillSplitDetail
+
+	billSplit, err := server.store.GetBillSplit(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return detail, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return detail, false
+	}
+
+	requests, err := server.store.ListSplitPaymentRequests(ctx, &billSplit.ID)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return detail, false
+	}
+
+	valid := authorize(ctx, policy.ReadPaymentRequest, billSplit.Initiator)
+
+	for _, request := range requests {
+		valid = valid || authorize(ctx, policy.ReadPaymentRequest, request.Payer)
+	}
+
+	if !valid {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrBillSplitIsNotAuthenticatedUsers))
+		return detail, false
+	}
+
+	return db.NewBillSplitDetail(billSplit, requests), true
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/split"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+)
+
+var ErrBillSplitIsNotAuthenticatedUsers = errors.New("bill split doesn't belong to authenticated user")
+
+// billSplitParticipant holds a user who shares the bill, value is the amount in minor units for exact splits
+// and the share in basis points for percentage splits, it is ignored by equal splits
+type billSplitParticipant struct {
+	Username string `json:"username" binding:"required,alphanum"`
+	Value    int64  `json:"value" binding:"min=0"`
+}
+
+// createBillSplitRequest holds the params of a bill split, the authenticated user may be one of the participants
+// to split the bill with the others but isn't asked for its own share. expires_in is in seconds and the payment requests
+// last db.DefaultPaymentRequestTTL if it is omitted
+type createBillSplitRequest struct {
+	TotalAmount  int64                  `json:"total_amount" binding:"required,gt=0"`
+	Currency     string                 `json:"currency" binding:"required,currency"`
+	Memo         string                 `json:"memo" binding:"max=140"`
+	Rule         string                 `json:"rule" binding:"required,split_rule"`
+	Participants []billSplitParticipant `json:"participants" binding:"required,min=1,dive"`
+	ExpiresIn    int64                  `json:"expires_in" binding:"omitempty,min=1"`
+}
+
+// createBillSplit splits a bill which the authenticated user paid between the participants by the rule and asks each
+// of them for their share with a payment request, the shares are paid into the authenticated user's account in the currency
+func (server *Server) createBillSplit(ctx *gin.Context) {
+	var req createBillSplitRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ttl := db.DefaultPaymentRequestTTL
+
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	if ttl > db.MaxPaymentRequestTTL {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrPaymentRequestTTLTooLong))
+		return
+	}
+
+	participants := make([]split.Participant, len(req.Participants))
+
+	for i, participant := range req.Participants {
+		participants[i] = split.Participant{Username: participant.Username, Value: participant.Value}
+	}
+
+	shares, err := split.Shares(req.TotalAmount, req.Rule, participants)
+
+	if err != nil {
+		ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.CreateBillSplitTxParams{
+		Initiator:   authPayload.Username,
+		TotalAmount: req.TotalAmount,
+		Currency:    req.Currency,
+		Memo:        req.Memo,
+		Rule:        req.Rule,
+		Shares:      make([]db.BillSplitShare, len(shares)),
+		ExpiresIn:   ttl,
+	}
+
+	for i, share := range shares {
+		arg.Shares[i] = db.BillSplitShare{Username: participants[i].Username, Amount: share}
+	}
+
+	detail, err := server.store.CreateBillSplitTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrParticipantNotFound:
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		case db.ErrRequesterHasNoAccount, db.ErrSplitWithoutPayers, db.ErrSplitSharesMismatch:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, detail)
+}
+
+// billSplitURIRequest holds the ID of the bill split in the URI
+type billSplitURIRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getBillSplit returns a bill split with its payment requests and how much of it is paid, its initiator and its payers
+// can get it and admins can get any bill split
+func (server *Server) getBillSplit(ctx *gin.Context) {
+	var req billSplitURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	detail, valid := server.authorizeBillSplit(ctx, req.ID)
+
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, detail)
+}
+
+// listBillSplitsRequest holds the cursor of the page of the bill splits
+type listBillSplitsRequest struct {
+	cursorPageRequest
+}
+
+// listBillSplitsResponse holds a page of the bill splits and the cursor of the next page, which is empty on the last page
+type listBillSplitsResponse struct {
+	BillSplits []db.BillSplit `json:"bill_splits"`
+	NextCursor string         `json:"next_cursor"`
+}
+
+// listBillSplits returns a page of the bill splits which the authenticated user initiated
+func (server *Server) listBillSplits(ctx *gin.Context) {
+	var req listBillSplitsRequest
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.ListBillSplitsPageParams{
+		Initiator: authPayload.Username,
+	}
+
+	page, err := req.page(arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg.PageParams = page.Params()
+
+	splits, err := server.store.ListBillSplitsPage(ctx, arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	splits, nextCursor := pagination.Cut(page, splits, func(billSplit db.BillSplit) int64 { return billSplit.ID })
+
+	ctx.JSON(http.StatusOK, listBillSplitsResponse{
+		BillSplits: splits,
+		NextCursor: nextCursor,
+	})
+}
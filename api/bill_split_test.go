@@ -0,0 +1,499 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/split"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// randomBillSplit returns a bill split of the initiator
+func randomBillSplit(initiator string) db.BillSplit {
+	return db.BillSplit{
+		ID:          util.RandomInt(1, 1000),
+		Initiator:   initiator,
+		ToAccountID: util.RandomInt(1, 1000),
+		TotalAmount: 1000,
+		Currency:    util.USD,
+		Memo:        util.RandomString(10),
+		Rule:        split.RuleEqual,
+	}
+}
+
+// TestCreateBillSplitAPI tests createBillSplit handler with multiple cases
+func TestCreateBillSplitAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+
+	billSplit := randomBillSplit(user1.Username)
+	requests := []db.PaymentRequest{
+		randomPaymentRequest(user1.Username, user2.Username),
+		randomPaymentRequest(user1.Username, user3.Username),
+	}
+	detail := db.NewBillSplitDetail(billSplit, requests)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Equal",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"memo":         billSplit.Memo,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{
+					{"username": user1.Username},
+					{"username": user2.Username},
+					{"username": user3.Username},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				//! the initiator's share is passed on and skipped by the transaction
+				arg := db.CreateBillSplitTxParams{
+					Initiator:   user1.Username,
+					TotalAmount: 1000,
+					Currency:    util.USD,
+					Memo:        billSplit.Memo,
+					Rule:        split.RuleEqual,
+					Shares: []db.BillSplitShare{
+						{Username: user1.Username, Amount: 334},
+						{Username: user2.Username, Amount: 333},
+						{Username: user3.Username, Amount: 333},
+					},
+					ExpiresIn: db.DefaultPaymentRequestTTL,
+				}
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(detail, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchBillSplitDetail(t, recorder.Body, detail)
+			},
+		},
+		{
+			name: "Percentage",
+			body: gin.H{
+				"total_amount": 1001,
+				"currency":     util.USD,
+				"rule":         split.RulePercentage,
+				"participants": []gin.H{
+					{"username": user2.Username, "value": 1550},
+					{"username": user3.Username, "value": 8450},
+				},
+				"expires_in": 3600,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateBillSplitTxParams{
+					Initiator:   user1.Username,
+					TotalAmount: 1001,
+					Currency:    util.USD,
+					Rule:        split.RulePercentage,
+					Shares: []db.BillSplitShare{
+						{Username: user2.Username, Amount: 155},
+						{Username: user3.Username, Amount: 846},
+					},
+					ExpiresIn: time.Hour,
+				}
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(detail, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Exact amounts mismatch",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleExact,
+				"participants": []gin.H{
+					{"username": user2.Username, "value": 400},
+					{"username": user3.Username, "value": 400},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Duplicate participant",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{
+					{"username": user2.Username},
+					{"username": user2.Username},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Participant not found",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{{"username": user2.Username}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.BillSplitDetail{}, db.ErrParticipantNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Without payers",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{{"username": user1.Username}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.BillSplitDetail{}, db.ErrSplitWithoutPayers)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Initiator has no account",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{{"username": user2.Username}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.BillSplitDetail{}, db.ErrRequesterHasNoAccount)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid rule",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         "random",
+				"participants": []gin.H{{"username": user2.Username}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "No participants",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleEqual,
+				"participants": []gin.H{},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Negative value",
+			body: gin.H{
+				"total_amount": 1000,
+				"currency":     util.USD,
+				"rule":         split.RuleExact,
+				"participants": []gin.H{{"username": user2.Username, "value": -1}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateBillSplitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/bill_splits", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestGetBillSplitAPI tests getBillSplit handler with multiple cases
+func TestGetBillSplitAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+	user4, _ := randomUser(t)
+
+	billSplit := randomBillSplit(user1.Username)
+
+	accepted := randomPaymentRequest(user1.Username, user2.Username)
+	accepted.Status = db.PaymentRequestAccepted
+
+	requests := []db.PaymentRequest{accepted, randomPaymentRequest(user1.Username, user3.Username)}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Initiator",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).Times(1).Return(billSplit, nil)
+				store.EXPECT().ListSplitPaymentRequests(gomock.Any(), gomock.Eq(&billSplit.ID)).Times(1).Return(requests, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.BillSplitDetail
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, billSplit.ID, got.BillSplit.ID)
+				require.Len(t, got.PaymentRequests, 2)
+				require.Equal(t, db.BillSplitProgress{
+					Status:            db.BillSplitOpen,
+					Requests:          2,
+					Accepted:          1,
+					PaidAmount:        accepted.Amount,
+					OutstandingAmount: requests[1].Amount,
+				}, got.Progress)
+			},
+		},
+		{
+			name:     "Payer",
+			username: user3.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).Times(1).Return(billSplit, nil)
+				store.EXPECT().ListSplitPaymentRequests(gomock.Any(), gomock.Eq(&billSplit.ID)).Times(1).Return(requests, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).Times(1).Return(billSplit, nil)
+				store.EXPECT().ListSplitPaymentRequests(gomock.Any(), gomock.Eq(&billSplit.ID)).Times(1).Return(requests, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Other user",
+			username: user4.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).Times(1).Return(billSplit, nil)
+				store.EXPECT().ListSplitPaymentRequests(gomock.Any(), gomock.Eq(&billSplit.ID)).Times(1).Return(requests, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not found",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetBillSplit(gomock.Any(), gomock.Eq(billSplit.ID)).Times(1).Return(db.BillSplit{}, sql.ErrNoRows)
+				store.EXPECT().ListSplitPaymentRequests(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/bill_splits/%d", billSplit.ID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestListBillSplitsAPI tests listBillSplits handler with multiple cases
+func TestListBillSplitsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	splits := []db.BillSplit{randomBillSplit(user.Username), randomBillSplit(user.Username)}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK",
+			query: "page_size=5",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListBillSplitsPageParams{
+					Initiator:  user.Username,
+					PageParams: db.PageParams{Limit: 6},
+				}
+				store.EXPECT().ListBillSplitsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return(splits, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listBillSplitsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got.BillSplits, 2)
+				require.Empty(t, got.NextCursor)
+			},
+		},
+		{
+			name:  "Next page",
+			query: "page_size=1",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListBillSplitsPage(gomock.Any(), gomock.Any()).Times(1).Return(splits, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listBillSplitsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got.BillSplits, 1)
+				require.NotEmpty(t, got.NextCursor)
+			},
+		},
+		{
+			name:  "Invalid cursor",
+			query: "cursor=invalid",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListBillSplitsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal error",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListBillSplitsPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/bill_splits?"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// requireBodyMatchBillSplitDetail checks if the body matches the bill split and the progress of its settlement
+func requireBodyMatchBillSplitDetail(t *testing.T, body *bytes.Buffer, detail db.BillSplitDetail) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var got db.BillSplitDetail
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, detail.BillSplit.ID, got.BillSplit.ID)
+	require.Equal(t, detail.BillSplit.TotalAmount, got.BillSplit.TotalAmount)
+	require.Len(t, got.PaymentRequests, len(detail.PaymentRequests))
+	require.Equal(t, detail.Progress, got.Progress)
+}
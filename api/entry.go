@@ -3,9 +3,11 @@ package api
 import (
 	"database/sql"
 	"net/http"
+	"time"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
-	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/policy"
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,31 +26,34 @@ func (server *Server) createEntry(ctx *gin.Context) {
 		return
 	}
 
-	// here we check the authenticated user and accounID is associated or not
-
-	err := server.getAuthenticationValidation(ctx, req.AccountID)
+	// retries with the same idempotency key get the result of the first entry
+	idempotency, err := idempotencyParams(ctx)
 
 	if err != nil {
-		if err == ErrAccountIsNotAuthenticatedUsers {
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-			return
-		}
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// here we check the authenticated user and accounID is associated or not
+	if _, valid := server.authorizeAccount(ctx, req.AccountID, policy.MoveMoney); !valid {
 		return
 	}
 
 	arg := db.EntryTxParams{
-		AccountID: req.AccountID,
-		Amount:    req.Amount,
+		AccountID:   req.AccountID,
+		Amount:      req.Amount,
+		Idempotency: idempotency,
+		Actor:       auditActor(ctx),
 	}
 
 	result, err := server.store.EntryTx(ctx, arg)
 
 	if err != nil {
+		if err == db.ErrInsufficientFunds || err == db.ErrIdempotencyKeyMismatch || err == db.ErrAccountFrozen ||
+			err == db.ErrAccountClosed {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
 		if err == sql.ErrConnDone {
 			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 			return
@@ -86,20 +91,8 @@ func (server *Server) getEntry(ctx *gin.Context) {
 		return
 	}
 
-	// here we check the authenticated user and accounID is associated or not
-
-	err = server.getAuthenticationValidation(ctx, entry.AccountID)
-
-	if err != nil {
-		if err == ErrAccountIsNotAuthenticatedUsers {
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-			return
-		}
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// here we check the authenticated user and accounID is associated or not, admins can read every entry
+	if _, valid := server.authorizeAccount(ctx, entry.AccountID, policy.ReadAccount); !valid {
 		return
 	}
 
@@ -115,6 +108,11 @@ type listEntriesRequest struct {
 
 // listEntries list entries for the user for a specific account
 func (server *Server) listEntries(ctx *gin.Context) {
+	if !pagedByOffset(ctx) {
+		server.listEntriesPage(ctx)
+		return
+	}
+
 	var req listEntriesRequest
 
 	if err := ctx.ShouldBindQuery(&req); err != nil {
@@ -122,20 +120,8 @@ func (server *Server) listEntries(ctx *gin.Context) {
 		return
 	}
 
-	// here we check the authenticated user and accounID is associated or not
-
-	err := server.getAuthenticationValidation(ctx, req.AccountID)
-
-	if err != nil {
-		if err == ErrAccountIsNotAuthenticatedUsers {
-			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
-			return
-		}
-		if err == sql.ErrNoRows {
-			ctx.JSON(http.StatusNotFound, errorResponse(err))
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+	// here we check the authenticated user and accounID is associated or not, admins can list every entry
+	if _, valid := server.authorizeAccount(ctx, req.AccountID, policy.ReadAccount); !valid {
 		return
 	}
 
@@ -159,19 +145,66 @@ func (server *Server) listEntries(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, entries)
 }
 
-// getAuthenticationValidation checks if auhtenticated user and account matches
-func (server *Server) getAuthenticationValidation(ctx *gin.Context, accountID int64) error {
-	acc, err := server.store.GetAccount(ctx, accountID)
+// listEntriesPageRequest holds the filters and the cursor of the page of the entries, from is inclusive and to is exclusive.
+// Direction is credit or debit, and the counterparty is the other account of the transfer of the entry
+type listEntriesPageRequest struct {
+	AccountID             int64     `form:"account_id" binding:"required,min=1"`
+	From                  time.Time `form:"from"`
+	To                    time.Time `form:"to" binding:"omitempty,gtfield=From"`
+	MinAmount             *int64    `form:"min_amount"`
+	MaxAmount             *int64    `form:"max_amount"`
+	Direction             string    `form:"direction" binding:"omitempty,oneof=credit debit"`
+	CounterpartyAccountID int64     `form:"counterparty_account_id" binding:"omitempty,min=1"`
+	cursorPageRequest
+}
+
+// listEntriesPageResponse holds a page of the entries and the cursor of the next page, which is empty on the last page
+type listEntriesPageResponse struct {
+	Entries    []db.Entry `json:"entries"`
+	NextCursor string     `json:"next_cursor"`
+}
+
+// listEntriesPage returns a page of the entries of an account which match the filters
+func (server *Server) listEntriesPage(ctx *gin.Context) {
+	var req listEntriesPageRequest
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.ListEntriesPageParams{
+		AccountID:             req.AccountID,
+		From:                  req.From,
+		To:                    req.To,
+		MinAmount:             req.MinAmount,
+		MaxAmount:             req.MaxAmount,
+		Direction:             req.Direction,
+		CounterpartyAccountID: req.CounterpartyAccountID,
+	}
+
+	page, err := req.page(arg)
 
 	if err != nil {
-		return err
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
 	}
 
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+	if _, valid := server.authorizeAccount(ctx, req.AccountID, policy.ReadAccount); !valid {
+		return
+	}
+
+	arg.PageParams = page.Params()
+
+	entries, err := server.store.ListEntriesPage(ctx, arg)
 
-	if acc.Owner != authPayload.Username {
-		return ErrAccountIsNotAuthenticatedUsers
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
 	}
 
-	return nil
+	resp := listEntriesPageResponse{}
+	resp.Entries, resp.NextCursor = pagination.Cut(page, entries, func(entry db.Entry) int64 { return entry.ID })
+
+	ctx.JSON(http.StatusOK, resp)
 }
@@ -46,6 +46,7 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(amount),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(1)
 			},
@@ -67,6 +68,7 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(amount),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -87,6 +89,7 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(amount),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -108,6 +111,7 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(amount),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -180,6 +184,7 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(amount),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.EntryTxResult{}, sql.ErrConnDone)
 			},
@@ -201,11 +206,57 @@ func TestCreateEntryAPI(t *testing.T) {
 				arg := db.EntryTxParams{
 					AccountID: acc.ID,
 					Amount:    int64(-(acc.Balance + 1)),
+					Actor:     testAuditActor(user.Username),
 				}
 				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.EntryTxResult{}, db.ErrInsufficientFunds)
 			},
 			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
-				require.Equal(t, http.StatusBadRequest, recorder.Code)
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "OK with idempotency key",
+			body: gin.H{
+				"account_id": acc.ID,
+				"amount":     amount,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "entry-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				arg := db.EntryTxParams{
+					AccountID: acc.ID,
+					Amount:    int64(amount),
+					Idempotency: &db.IdempotencyParams{
+						Username: user.Username,
+						Key:      "entry-key",
+					},
+					Actor: testAuditActor(user.Username),
+				}
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "idempotency key mismatch",
+			body: gin.H{
+				"account_id": acc.ID,
+				"amount":     amount,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user.Username, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "entry-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Any()).Times(1).Return(db.EntryTxResult{}, db.ErrIdempotencyKeyMismatch)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
 			},
 		},
 	}
@@ -615,6 +666,134 @@ func TestListEntriesAPI(t *testing.T) {
 	}
 }
 
+// TestListEntriesPageAPI tests listEntries handler when it pages by cursor with multiple cases
+func TestListEntriesPageAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	acc := randomAccount(user.Username)
+
+	entries := make([]db.Entry, 3)
+	for i := range entries {
+		entries[i] = randomEntry(t, acc.ID)
+		entries[i].ID = int64(i + 1)
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			query:    fmt.Sprintf("?account_id=%d&page_size=2&direction=credit&counterparty_account_id=7&min_amount=0", acc.ID),
+			username: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				minAmount := int64(0)
+				arg := db.ListEntriesPageParams{
+					AccountID:             acc.ID,
+					MinAmount:             &minAmount,
+					Direction:             db.DirectionCredit,
+					CounterpartyAccountID: 7,
+					PageParams:            db.PageParams{Limit: 3},
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().ListEntries(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return(entries, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listEntriesPageResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, entries[:2], got.Entries)
+				require.NotEmpty(t, got.NextCursor)
+			},
+		},
+		{
+			name:     "InvalidDirection",
+			query:    fmt.Sprintf("?account_id=%d&direction=incoming", acc.ID),
+			username: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "InvalidCursor",
+			query:    fmt.Sprintf("?account_id=%d&cursor=random", acc.ID),
+			username: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "NoAccountID",
+			query:    "?page_size=5",
+			username: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "UnauthorizedUser",
+			query:    fmt.Sprintf("?account_id=%d", acc.ID),
+			username: "unauthorized",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "InternalServerError",
+			query:    fmt.Sprintf("?account_id=%d", acc.ID),
+			username: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc.ID)).Times(1).Return(acc, nil)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/entries"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
 // randomEntry creates a random entry with given account id
 func randomEntry(t *testing.T, accID int64) db.Entry {
 	return db.Entry{
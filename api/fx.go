@@ -0,0 +1,165 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/fx"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrFxQuoteIsNotAuthenticatedUsers = errors.New("fx quote doesn't belong to authenticated user")
+var ErrSameCurrencyQuote = errors.New("fx quote currencies must be different")
+
+// createFxQuoteRequest holds the currency pair of the quote
+type createFxQuoteRequest struct {
+	FromCurrency string `json:"from_currency" binding:"required,currency"`
+	ToCurrency   string `json:"to_currency" binding:"required,currency"`
+}
+
+// fxQuoteResponse holds the locked rate and its expiration time
+type fxQuoteResponse struct {
+	ID           uuid.UUID `json:"id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         string    `json:"rate"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// newFxQuoteResponse creates a returnable response from the quote
+func newFxQuoteResponse(quote db.FxQuote) fxQuoteResponse {
+	return fxQuoteResponse{
+		ID:           quote.ID,
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate,
+		ExpiresAt:    quote.ExpiresAt,
+	}
+}
+
+// createFxQuote gets the current rate for the currency pair and locks it for the authenticated user for a short time
+func (server *Server) createFxQuote(ctx *gin.Context) {
+	var req createFxQuoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.FromCurrency == req.ToCurrency {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrSameCurrencyQuote))
+		return
+	}
+
+	rate, err := server.rateProvider.GetRate(ctx, req.FromCurrency, req.ToCurrency, time.Now())
+
+	if err != nil {
+		if err == fx.ErrRateNotFound {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	quoteID, err := uuid.NewRandom()
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	quote, err := server.store.CreateFxQuote(ctx, db.CreateFxQuoteParams{
+		ID:           quoteID,
+		Username:     authPayload.Username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Value,
+		ExpiresAt:    time.Now().Add(server.config.FxQuoteDuration),
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newFxQuoteResponse(quote))
+}
+
+// createFxTransfer transfers money between accounts with different currencies using the quote in the request
+func (server *Server) createFxTransfer(ctx *gin.Context, req createTransferRequest, idempotency *db.IdempotencyParams) {
+	quote, err := server.store.GetFxQuote(ctx, uuid.MustParse(req.FxQuoteID))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !authorize(ctx, policy.UseFxQuote, quote.Username) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrFxQuoteIsNotAuthenticatedUsers))
+		return
+	}
+
+	// amount of the transfer is always in the currency of the from account
+	if quote.FromCurrency != req.Currency {
+		err := fmt.Errorf("fx quote currency mismatch: %s vs %s", quote.FromCurrency, req.Currency)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, quote.FromCurrency, req.FromAccountID)
+
+	if !valid {
+		return
+	}
+
+	if !authorize(ctx, policy.MoveMoney, fromAccount.Owner) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
+		return
+	}
+
+	req.ToAccountID, valid = server.recipientAccount(ctx, req, quote.ToCurrency)
+
+	if !valid {
+		return
+	}
+
+	arg := db.FxTransferTxParams{
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		QuoteID:       quote.ID,
+		Idempotency:   idempotency,
+		Actor:         auditActor(ctx),
+	}
+
+	result, err := server.store.FxTransferTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrConvertedAmountTooSmall:
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		case db.ErrFxQuoteUnavailable, db.ErrFxQuoteCurrencyMismatch, db.ErrFxQuoteNotOwned, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed, db.ErrSystemAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
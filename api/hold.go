@@ -0,0 +1,196 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrHoldIsNotAuthenticatedUsers = errors.New("hold doesn't belong to authenticated user")
+	ErrHoldTTLTooLong              = fmt.Errorf("expires_in can be at most %d seconds", int64(db.MaxHoldTTL/time.Second))
+)
+
+// createHoldRequest holds the params of a hold, expires_in is in seconds and the hold lasts db.DefaultHoldTTL if it is omitted
+type createHoldRequest struct {
+	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1,nefield=FromAccountID"`
+	Amount        int64  `json:"amount" binding:"required,gt=0"`
+	Currency      string `json:"currency" binding:"required,currency"`
+	ExpiresIn     int64  `json:"expires_in" binding:"omitempty,min=1"`
+}
+
+// createHold reserves money on the authenticated user's account for another account, the money stays in the balance
+// but it can't be spent until the hold is captured, voided or expired
+func (server *Server) createHold(ctx *gin.Context) {
+	var req createHoldRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ttl := db.DefaultHoldTTL
+
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	if ttl > db.MaxHoldTTL {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrHoldTTLTooLong))
+		return
+	}
+
+	// retries with the same idempotency key get the result of the first hold
+	idempotency, err := idempotencyParams(ctx)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.Currency, req.FromAccountID)
+
+	if !valid {
+		return
+	}
+
+	if !authorize(ctx, policy.MoveMoney, fromAccount.Owner) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
+		return
+	}
+
+	if _, valid := server.validAccount(ctx, req.Currency, req.ToAccountID); !valid {
+		return
+	}
+
+	result, err := server.store.CreateHoldTx(ctx, db.CreateHoldTxParams{
+		FromAccountID: req.FromAccountID,
+		ToAccountID:   req.ToAccountID,
+		Amount:        req.Amount,
+		ExpiresIn:     ttl,
+		Idempotency:   idempotency,
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed,
+			db.ErrSystemAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// holdURIRequest holds the ID of the hold in the URI
+type holdURIRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getHold returns a hold from or to an account of the authenticated user, admins can get any hold
+func (server *Server) getHold(ctx *gin.Context) {
+	var req holdURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	hold, valid := server.authorizeHold(ctx, req.ID)
+
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, hold)
+}
+
+// captureHoldBody holds the amount to capture, if it is omitted the whole hold is captured
+type captureHoldBody struct {
+	Amount int64 `json:"amount" binding:"omitempty,gt=0"`
+}
+
+// captureHold transfers the held money to the account which the hold is for, only its owner can capture the hold
+func (server *Server) captureHold(ctx *gin.Context) {
+	var req holdURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var body captureHoldBody
+	if err := ctx.ShouldBindJSON(&body); err != nil && err != io.EOF {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// retries with the same idempotency key get the result of the first capture
+	idempotency, err := idempotencyParams(ctx)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.authorizePayeeHold(ctx, req.ID, policy.CaptureHold); !valid {
+		return
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, db.CaptureHoldTxParams{
+		HoldID:      req.ID,
+		Amount:      body.Amount,
+		Idempotency: idempotency,
+		Actor:       auditActor(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrHoldNotPending, db.ErrHoldExpired, db.ErrCaptureExceedsHold, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// voidHold releases the held money without moving it, the owner of the account which the hold is for or an admin can void it
+func (server *Server) voidHold(ctx *gin.Context) {
+	var req holdURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.authorizePayeeHold(ctx, req.ID, policy.VoidHold); !valid {
+		return
+	}
+
+	result, err := server.store.VoidHoldTx(ctx, db.VoidHoldTxParams{
+		HoldID: req.ID,
+		Actor:  auditActor(ctx),
+	})
+
+	if err != nil {
+		if err == db.ErrHoldNotPending {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,603 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// randomHold returns a pending hold from the from account to the to account
+func randomHold(fromAccountID, toAccountID int64) db.Hold {
+	return db.Hold{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        100,
+		Status:        db.HoldPending,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+}
+
+// TestCreateHoldAPI tests createHold handler with multiple cases
+func TestCreateHoldAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	acc1.Currency = util.USD
+	acc2.Currency = util.USD
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          100,
+				"currency":        util.USD,
+				"expires_in":      3600,
+			},
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.CreateHoldTxParams{
+					FromAccountID: acc1.ID,
+					ToAccountID:   acc2.ID,
+					Amount:        100,
+					ExpiresIn:     time.Hour,
+					Actor:         testAuditActor(user1.Username),
+				}
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Default TTL",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          100,
+				"currency":        util.USD,
+			},
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.CreateHoldTxParams{
+					FromAccountID: acc1.ID,
+					ToAccountID:   acc2.ID,
+					Amount:        100,
+					ExpiresIn:     db.DefaultHoldTTL,
+					Actor:         testAuditActor(user1.Username),
+				}
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Insufficient funds",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          100,
+				"currency":        util.USD,
+			},
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CreateHoldTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Unauthorized user",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          100,
+				"currency":        util.USD,
+			},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "TTL too long",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          100,
+				"currency":        util.USD,
+				"expires_in":      int64(db.MaxHoldTTL/time.Second) + 1,
+			},
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Same account",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc1.ID,
+				"amount":          100,
+				"currency":        util.USD,
+			},
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/holds", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestGetHoldAPI tests getHold handler with multiple cases
+func TestGetHoldAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	hold := randomHold(acc1.ID, acc2.ID)
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Payer",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchHold(t, recorder.Body, hold)
+			},
+		},
+		{
+			name:     "Payee",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchHold(t, recorder.Body, hold)
+			},
+		},
+		{
+			name:     "Admin",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Other user",
+			username: user3.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not found",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(db.Hold{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/holds/%d", hold.ID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestCaptureHoldAPI tests captureHold handler with multiple cases
+func TestCaptureHoldAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	hold := randomHold(acc1.ID, acc2.ID)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Full capture",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.CaptureHoldTxParams{
+					HoldID: hold.ID,
+					Actor:  testAuditActor(user2.Username),
+				}
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Partial capture",
+			body:     gin.H{"amount": 40},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.CaptureHoldTxParams{
+					HoldID: hold.ID,
+					Amount: 40,
+					Actor:  testAuditActor(user2.Username),
+				}
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Capture exceeds hold",
+			body:     gin.H{"amount": 400},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CaptureHoldTxResult{}, db.ErrCaptureExceedsHold)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Expired",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(1).Return(db.CaptureHoldTxResult{}, db.ErrHoldExpired)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Payer can't capture",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin can't capture",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not found",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(db.Hold{}, sql.ErrNoRows)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid amount",
+			body:     gin.H{"amount": -10},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CaptureHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			var body io.Reader = http.NoBody
+
+			if tt.body != nil {
+				data, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/holds/%d/capture", hold.ID)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestVoidHoldAPI tests voidHold handler with multiple cases
+func TestVoidHoldAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	hold := randomHold(acc1.ID, acc2.ID)
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Payee voids",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.VoidHoldTxParams{
+					HoldID: hold.ID,
+					Actor:  testAuditActor(user2.Username),
+				}
+				store.EXPECT().VoidHoldTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin voids",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().VoidHoldTx(gomock.Any(), gomock.Any()).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Payer can't void",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().VoidHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not pending",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(hold, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().VoidHoldTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ReleaseHoldTxResult{}, db.ErrHoldNotPending)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal error",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetHold(gomock.Any(), gomock.Eq(hold.ID)).Times(1).Return(db.Hold{}, sql.ErrConnDone)
+				store.EXPECT().VoidHoldTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/holds/%d/void", hold.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// requireBodyMatchHold checks if the body of the response matches with the hold
+func requireBodyMatchHold(t *testing.T, body *bytes.Buffer, hold db.Hold) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotHold db.Hold
+	err = json.Unmarshal(data, &gotHold)
+	require.NoError(t, err)
+
+	require.Equal(t, hold.ID, gotHold.ID)
+	require.Equal(t, hold.FromAccountID, gotHold.FromAccountID)
+	require.Equal(t, hold.ToAccountID, gotHold.ToAccountID)
+	require.Equal(t, hold.Amount, gotHold.Amount)
+	require.Equal(t, hold.Status, gotHold.Status)
+}
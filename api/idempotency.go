@@ -0,0 +1,33 @@
+package api
+
+import (
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/gin-gonic/gin"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyParams returns the idempotency params of the request for the authenticated user,
+// if the request has no idempotency key it returns nil so the request is executed as usual
+func idempotencyParams(ctx *gin.Context) (*db.IdempotencyParams, error) {
+	key := ctx.GetHeader(idempotencyKeyHeader)
+
+	if key == "" {
+		return nil, nil
+	}
+
+	if err := val.ValidateIdempotencyKey(key); err != nil {
+		return nil, fmt.Errorf("invalid %s header: %s", idempotencyKeyHeader, err)
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	return &db.IdempotencyParams{
+		Username: authPayload.Username,
+		Key:      key,
+	}, nil
+}
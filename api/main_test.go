@@ -22,6 +22,7 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 	config := util.Config{
 		TokenSymmetricKey:   util.RandomString(32),
 		AccessTokenDuration: time.Minute,
+		FxQuoteDuration:     time.Minute,
 	}
 
 	server, err := NewServer(config, store)
@@ -29,3 +30,11 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 
 	return server
 }
+
+// testAuditActor returns the audit actor of the test requests of the user, they have no client IP or user agent
+func testAuditActor(username string) *db.AuditActor {
+	return &db.AuditActor{
+		Username: username,
+		Channel:  db.AuditChannelHTTP,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/burakkarasel/Bank-App/policy"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/gin-gonic/gin"
 )
@@ -59,3 +60,18 @@ func authMiddleware(tokenMaker token.Maker) gin.HandlerFunc {
 		ctx.Next()
 	}
 }
+
+// adminMiddleware is a middleware that checks if the authorized user may use the admin routes,
+// it must be used after authMiddleware
+func adminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		payload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+		if !policy.Allowed(payload, policy.UseAdminAPI) {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, errorResponse(ErrAdminRoleRequired))
+			return
+		}
+
+		ctx.Next()
+	}
+}
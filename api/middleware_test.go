@@ -8,14 +8,20 @@ import (
 	"time"
 
 	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
 
-// addAuthorization creates a token and sets request's header with given authorizationType and the token
+// addAuthorization creates a token of a depositor and sets request's header with given authorizationType and the token
 func addAuthorization(t *testing.T, r *http.Request, tokenMaker token.Maker, authorizationType, username string, duration time.Duration) {
+	addAuthorizationWithRole(t, r, tokenMaker, authorizationType, username, util.DepositorRole, duration)
+}
+
+// addAuthorizationWithRole creates a token with the given role and sets request's header with given authorizationType and the token
+func addAuthorizationWithRole(t *testing.T, r *http.Request, tokenMaker token.Maker, authorizationType, username, role string, duration time.Duration) {
 	// here we create token with given input
-	token, payload, err := tokenMaker.CreateToken(username, duration)
+	token, payload, err := tokenMaker.CreateToken(username, role, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/gin-gonic/gin"
+)
+
+// cursorPageRequest holds the query params of the list handlers which page by cursor,
+// the cursor is the next_cursor of the previous page and empty for the first page
+type cursorPageRequest struct {
+	Cursor   string `form:"cursor"`
+	PageSize int32  `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=asc desc"`
+}
+
+// page returns the page which the cursor points to, filter must hold every filter of the list
+func (req cursorPageRequest) page(filter interface{}) (pagination.Page, error) {
+	return pagination.New(req.Cursor, req.PageSize, req.Sort, filter)
+}
+
+// pagedByOffset returns true if the request pages by page_id as before the cursors
+func pagedByOffset(ctx *gin.Context) bool {
+	_, ok := ctx.GetQuery("page_id")
+	return ok
+}
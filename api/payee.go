@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+)
+
+// getPayeeRequest holds the username or the email of the recipient and the currency of the transfer
+type getPayeeRequest struct {
+	Recipient string `form:"recipient" binding:"required,recipient"`
+	Currency  string `form:"currency" binding:"required,currency"`
+}
+
+// payeeResponse holds the masked name and email of the recipient, so users can confirm the recipient
+// before they send money without seeing the details of other users
+type payeeResponse struct {
+	Recipient string `json:"recipient"`
+	FullName  string `json:"full_name"`
+	Email     string `json:"email"`
+	Currency  string `json:"currency"`
+}
+
+// getPayee resolves the recipient to the account which a transfer in the currency goes to and returns the masked recipient
+func (server *Server) getPayee(ctx *gin.Context) {
+	var req getPayeeRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payee, valid := server.resolvePayee(ctx, req.Recipient, req.Currency)
+
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, payeeResponse{
+		Recipient: req.Recipient,
+		FullName:  util.MaskName(payee.FullName),
+		Email:     util.MaskEmail(payee.Email),
+		Currency:  payee.Account.Currency,
+	})
+}
+
+// resolvePayee finds the account of the recipient in the currency
+func (server *Server) resolvePayee(ctx *gin.Context, recipient, currency string) (db.Payee, bool) {
+	payee, err := server.store.ResolvePayee(ctx, recipient, currency)
+
+	if err != nil {
+		switch err {
+		case db.ErrRecipientNotFound:
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+		case db.ErrRecipientHasNoAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+		default:
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		}
+		return payee, false
+	}
+
+	return payee, true
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetPayeeAPI tests getPayee handler with multiple cases
+func TestGetPayeeAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc2 := randomAccount(user2.Username)
+	acc2.Currency = util.USD
+
+	payee := db.Payee{Account: acc2, FullName: user2.FullName, Email: user2.Email}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "OK by email",
+			query: fmt.Sprintf("?recipient=%s&currency=%s", url.QueryEscape(user2.Email), util.USD),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Eq(user2.Email), gomock.Eq(util.USD)).Times(1).Return(payee, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var res payeeResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				require.Equal(t, user2.Email, res.Recipient)
+				require.Equal(t, util.MaskName(user2.FullName), res.FullName)
+				require.Equal(t, util.MaskEmail(user2.Email), res.Email)
+				require.Equal(t, util.USD, res.Currency)
+
+				//! the account of the recipient isn't exposed
+				require.NotContains(t, recorder.Body.String(), "account")
+			},
+		},
+		{
+			name:  "OK by username",
+			query: fmt.Sprintf("?recipient=%s&currency=%s", user2.Username, util.USD),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Eq(user2.Username), gomock.Eq(util.USD)).Times(1).Return(payee, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:  "Recipient not found",
+			query: fmt.Sprintf("?recipient=%s&currency=%s", user2.Username, util.USD),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(db.Payee{}, db.ErrRecipientNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:  "Recipient has no account in currency",
+			query: fmt.Sprintf("?recipient=%s&currency=%s", user2.Username, util.EUR),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(db.Payee{}, db.ErrRecipientHasNoAccount)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal error",
+			query: fmt.Sprintf("?recipient=%s&currency=%s", user2.Username, util.USD),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(db.Payee{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid currency",
+			query: fmt.Sprintf("?recipient=%s&currency=ABC", user2.Username),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "No recipient",
+			query: fmt.Sprintf("?currency=%s", util.USD),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/payees"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrPaymentRequestIsNotAuthenticatedUsers = errors.New("payment request doesn't belong to authenticated user")
+	ErrPaymentRequestTTLTooLong              = fmt.Errorf("expires_in can be at most %d seconds", int64(db.MaxPaymentRequestTTL/time.Second))
+)
+
+// createPaymentRequestRequest holds the params of a payment request, payer is the username or the email of the user
+// who is asked for the money. expires_in is in seconds and the request lasts db.DefaultPaymentRequestTTL if it is omitted
+type createPaymentRequestRequest struct {
+	Payer     string `json:"payer" binding:"required,recipient"`
+	Amount    int64  `json:"amount" binding:"required,gt=0"`
+	Currency  string `json:"currency" binding:"required,currency"`
+	Memo      string `json:"memo" binding:"max=140"`
+	ExpiresIn int64  `json:"expires_in" binding:"omitempty,min=1"`
+}
+
+// createPaymentRequest asks another user for money, it is paid into the authenticated user's account in the currency
+func (server *Server) createPaymentRequest(ctx *gin.Context) {
+	var req createPaymentRequestRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	ttl := db.DefaultPaymentRequestTTL
+
+	if req.ExpiresIn > 0 {
+		ttl = time.Duration(req.ExpiresIn) * time.Second
+	}
+
+	if ttl > db.MaxPaymentRequestTTL {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrPaymentRequestTTLTooLong))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	request, err := server.store.CreatePaymentRequestTx(ctx, db.CreatePaymentRequestTxParams{
+		Requester: authPayload.Username,
+		Payer:     req.Payer,
+		Amount:    req.Amount,
+		Currency:  req.Currency,
+		Memo:      req.Memo,
+		ExpiresIn: ttl,
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrRecipientNotFound:
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		case db.ErrPaymentRequestToSelf, db.ErrRequesterHasNoAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, request)
+}
+
+// paymentRequestURIRequest holds the ID of the payment request in the URI
+type paymentRequestURIRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// paymentRequestResponse holds a payment request and the history of its statuses from the oldest
+type paymentRequestResponse struct {
+	PaymentRequest db.PaymentRequest        `json:"payment_request"`
+	History        []db.PaymentRequestEvent `json:"history"`
+}
+
+// getPaymentRequest returns a payment request which the authenticated user sent or must pay with its history,
+// admins can get any payment request
+func (server *Server) getPaymentRequest(ctx *gin.Context) {
+	var req paymentRequestURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	request, valid := server.authorizePaymentRequest(ctx, req.ID)
+
+	if !valid {
+		return
+	}
+
+	history, err := server.store.ListPaymentRequestEvents(ctx, request.ID)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, paymentRequestResponse{
+		PaymentRequest: request,
+		History:        history,
+	})
+}
+
+// listPaymentRequestsRequest holds the filters and the cursor of the page of the payment requests,
+// direction is incoming for the requests the user must pay and outgoing for the ones the user sent
+type listPaymentRequestsRequest struct {
+	Direction string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	Status    string `form:"status" binding:"omitempty,oneof=pending accepted declined cancelled expired"`
+	cursorPageRequest
+}
+
+// listPaymentRequestsResponse holds a page of the payment requests and the cursor of the next page, which is empty on the last page
+type listPaymentRequestsResponse struct {
+	PaymentRequests []db.PaymentRequest `json:"payment_requests"`
+	NextCursor      string              `json:"next_cursor"`
+}
+
+// listPaymentRequests returns a page of the payment requests which the authenticated user sent or must pay
+func (server *Server) listPaymentRequests(ctx *gin.Context) {
+	var req listPaymentRequestsRequest
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.ListPaymentRequestsPageParams{
+		Username:  authPayload.Username,
+		Direction: req.Direction,
+		Status:    req.Status,
+	}
+
+	page, err := req.page(arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg.PageParams = page.Params()
+
+	requests, err := server.store.ListPaymentRequestsPage(ctx, arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	requests, nextCursor := pagination.Cut(page, requests, func(request db.PaymentRequest) int64 { return request.ID })
+
+	ctx.JSON(http.StatusOK, listPaymentRequestsResponse{
+		PaymentRequests: requests,
+		NextCursor:      nextCursor,
+	})
+}
+
+// acceptPaymentRequest pays a payment request from the authenticated user's account in its currency,
+// only its payer can accept it
+func (server *Server) acceptPaymentRequest(ctx *gin.Context) {
+	var req paymentRequestURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// retries with the same idempotency key get the result of the first payment
+	idempotency, err := idempotencyParams(ctx)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	payer := func(request db.PaymentRequest) string { return request.Payer }
+
+	if _, valid := server.authorizePaymentRequestUser(ctx, req.ID, payer); !valid {
+		return
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		PaymentRequestID: req.ID,
+		Idempotency:      idempotency,
+		Actor:            auditActor(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrPaymentRequestNotPending, db.ErrPaymentRequestExpired, db.ErrPayerHasNoAccount, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// declinePaymentRequest closes a payment request without paying it, only its payer can decline it
+func (server *Server) declinePaymentRequest(ctx *gin.Context) {
+	payer := func(request db.PaymentRequest) string { return request.Payer }
+
+	server.closePaymentRequest(ctx, payer, server.store.DeclinePaymentRequestTx)
+}
+
+// cancelPaymentRequest closes a payment request before it is paid, only its requester can cancel it
+func (server *Server) cancelPaymentRequest(ctx *gin.Context) {
+	requester := func(request db.PaymentRequest) string { return request.Requester }
+
+	server.closePaymentRequest(ctx, requester, server.store.CancelPaymentRequestTx)
+}
+
+// closePaymentRequest closes the payment request in the URI with closeTx if the authenticated user is the user who may close it
+func (server *Server) closePaymentRequest(
+	ctx *gin.Context,
+	user func(request db.PaymentRequest) string,
+	closeTx func(ctx context.Context, arg db.ClosePaymentRequestTxParams) (db.PaymentRequest, error),
+) {
+	var req paymentRequestURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.authorizePaymentRequestUser(ctx, req.ID, user); !valid {
+		return
+	}
+
+	request, err := closeTx(ctx, db.ClosePaymentRequestTxParams{
+		PaymentRequestID: req.ID,
+		Actor:            auditActor(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrPaymentRequestNotPending, db.ErrPaymentRequestExpired:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, request)
+}
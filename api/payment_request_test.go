@@ -0,0 +1,652 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// randomPaymentRequest returns a pending payment request of the requester from the payer
+func randomPaymentRequest(requester, payer string) db.PaymentRequest {
+	return db.PaymentRequest{
+		ID:          util.RandomInt(1, 1000),
+		Requester:   requester,
+		Payer:       payer,
+		ToAccountID: util.RandomInt(1, 1000),
+		Amount:      100,
+		Currency:    util.USD,
+		Memo:        util.RandomString(10),
+		Status:      db.PaymentRequestPending,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+}
+
+// TestCreatePaymentRequestAPI tests createPaymentRequest handler with multiple cases
+func TestCreatePaymentRequestAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	request := randomPaymentRequest(user1.Username, user2.Username)
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "OK",
+			body: gin.H{
+				"payer":      user2.Email,
+				"amount":     100,
+				"currency":   util.USD,
+				"memo":       request.Memo,
+				"expires_in": 3600,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreatePaymentRequestTxParams{
+					Requester: user1.Username,
+					Payer:     user2.Email,
+					Amount:    100,
+					Currency:  util.USD,
+					Memo:      request.Memo,
+					ExpiresIn: time.Hour,
+				}
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(request, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchPaymentRequest(t, recorder.Body, request)
+			},
+		},
+		{
+			name: "Default TTL",
+			body: gin.H{
+				"payer":    user2.Username,
+				"amount":   100,
+				"currency": util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreatePaymentRequestTxParams{
+					Requester: user1.Username,
+					Payer:     user2.Username,
+					Amount:    100,
+					Currency:  util.USD,
+					ExpiresIn: db.DefaultPaymentRequestTTL,
+				}
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(request, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Payer not found",
+			body: gin.H{
+				"payer":    user2.Username,
+				"amount":   100,
+				"currency": util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.PaymentRequest{}, db.ErrRecipientNotFound)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Requester has no account",
+			body: gin.H{
+				"payer":    user2.Username,
+				"amount":   100,
+				"currency": util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.PaymentRequest{}, db.ErrRequesterHasNoAccount)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Request to self",
+			body: gin.H{
+				"payer":    user1.Username,
+				"amount":   100,
+				"currency": util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.PaymentRequest{}, db.ErrPaymentRequestToSelf)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "TTL too long",
+			body: gin.H{
+				"payer":      user2.Username,
+				"amount":     100,
+				"currency":   util.USD,
+				"expires_in": int64((db.MaxPaymentRequestTTL + time.Hour) / time.Second),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Memo too long",
+			body: gin.H{
+				"payer":    user2.Username,
+				"amount":   100,
+				"currency": util.USD,
+				"memo":     util.RandomString(141),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid amount",
+			body: gin.H{
+				"payer":    user2.Username,
+				"amount":   -1,
+				"currency": util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			data, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/payment_requests", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestGetPaymentRequestAPI tests getPaymentRequest handler with multiple cases
+func TestGetPaymentRequestAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+
+	request := randomPaymentRequest(user1.Username, user2.Username)
+
+	history := []db.PaymentRequestEvent{
+		{ID: 1, PaymentRequestID: request.ID, Status: db.PaymentRequestPending, Actor: &user1.Username},
+	}
+
+	testCases := []struct {
+		name          string
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Requester",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().ListPaymentRequestEvents(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(history, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got paymentRequestResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Equal(t, request.ID, got.PaymentRequest.ID)
+				require.Len(t, got.History, 1)
+				require.Equal(t, db.PaymentRequestPending, got.History[0].Status)
+			},
+		},
+		{
+			name:     "Payer",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().ListPaymentRequestEvents(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(history, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().ListPaymentRequestEvents(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(history, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Other user",
+			username: user3.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().ListPaymentRequestEvents(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not found",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(db.PaymentRequest{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/payment_requests/%d", request.ID)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestListPaymentRequestsAPI tests listPaymentRequests handler with multiple cases
+func TestListPaymentRequestsAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	requests := []db.PaymentRequest{
+		randomPaymentRequest(user2.Username, user1.Username),
+		randomPaymentRequest(user2.Username, user1.Username),
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Incoming",
+			query: "direction=incoming&status=pending&page_size=5",
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListPaymentRequestsPageParams{
+					Username:   user1.Username,
+					Direction:  db.DirectionIncoming,
+					Status:     db.PaymentRequestPending,
+					PageParams: db.PageParams{Limit: 6},
+				}
+				store.EXPECT().ListPaymentRequestsPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return(requests, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listPaymentRequestsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+				require.Len(t, got.PaymentRequests, 2)
+				require.Empty(t, got.NextCursor)
+			},
+		},
+		{
+			name:  "Invalid direction",
+			query: "direction=internal",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPaymentRequestsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Invalid status",
+			query: "status=paid",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPaymentRequestsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Internal error",
+			query: "",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListPaymentRequestsPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/payment_requests?"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestAcceptPaymentRequestAPI tests acceptPaymentRequest handler with multiple cases
+func TestAcceptPaymentRequestAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	request := randomPaymentRequest(user1.Username, user2.Username)
+
+	testCases := []struct {
+		name          string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+
+				arg := db.AcceptPaymentRequestTxParams{
+					PaymentRequestID: request.ID,
+					Actor:            testAuditActor(user2.Username),
+				}
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Requester can't accept",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Insufficient funds",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Payer has no account",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrPayerHasNoAccount)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Not pending",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrPaymentRequestNotPending)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal error",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/payment_requests/%d/accept", request.ID)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestClosePaymentRequestAPI tests declinePaymentRequest and cancelPaymentRequest handlers with multiple cases
+func TestClosePaymentRequestAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	request := randomPaymentRequest(user1.Username, user2.Username)
+
+	testCases := []struct {
+		name          string
+		action        string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Payer declines",
+			action:   "decline",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+
+				arg := db.ClosePaymentRequestTxParams{
+					PaymentRequestID: request.ID,
+					Actor:            testAuditActor(user2.Username),
+				}
+				store.EXPECT().DeclinePaymentRequestTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(request, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchPaymentRequest(t, recorder.Body, request)
+			},
+		},
+		{
+			name:     "Requester can't decline",
+			action:   "decline",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().DeclinePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Requester cancels",
+			action:   "cancel",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+
+				arg := db.ClosePaymentRequestTxParams{
+					PaymentRequestID: request.ID,
+					Actor:            testAuditActor(user1.Username),
+				}
+				store.EXPECT().CancelPaymentRequestTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(request, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Payer can't cancel",
+			action:   "cancel",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().CancelPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Expired",
+			action:   "decline",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(request.ID)).Times(1).Return(request, nil)
+				store.EXPECT().DeclinePaymentRequestTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.PaymentRequest{}, db.ErrPaymentRequestExpired)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/payment_requests/%d/%s", request.ID, tt.action)
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// requireBodyMatchPaymentRequest checks if the response body matches the payment request
+func requireBodyMatchPaymentRequest(t *testing.T, body *bytes.Buffer, request db.PaymentRequest) {
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	var gotRequest db.PaymentRequest
+	err = json.Unmarshal(data, &gotRequest)
+	require.NoError(t, err)
+
+	require.Equal(t, request.ID, gotRequest.ID)
+	require.Equal(t, request.Requester, gotRequest.Requester)
+	require.Equal(t, request.Payer, gotRequest.Payer)
+	require.Equal(t, request.Amount, gotRequest.Amount)
+	require.Equal(t, request.Status, gotRequest.Status)
+}
@@ -0,0 +1,315 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrScheduledTransferIsNotAuthenticatedUsers = errors.New("scheduled transfer doesn't belong to authenticated user")
+	ErrScheduledTransferNotActive               = errors.New("scheduled transfer is not active")
+	ErrEndBeforeStart                           = errors.New("end_at must be after start_at")
+	ErrRetryIntervalRequired                    = errors.New("retry_interval must be at least 1 when max_retries is set")
+)
+
+// createScheduledTransferRequest holds the params of the scheduled transfer, retry interval is in seconds
+type createScheduledTransferRequest struct {
+	FromAccountID  int64      `json:"from_account_id" binding:"required,min=1"`
+	ToAccountID    int64      `json:"to_account_id" binding:"required,min=1"`
+	Amount         int64      `json:"amount" binding:"required,gt=0"`
+	Currency       string     `json:"currency" binding:"required,currency"`
+	Frequency      string     `json:"frequency" binding:"required,frequency"`
+	StartAt        time.Time  `json:"start_at" binding:"required"`
+	EndAt          *time.Time `json:"end_at"`
+	MaxOccurrences *int32     `json:"max_occurrences" binding:"omitempty,min=1"`
+	MaxRetries     int32      `json:"max_retries" binding:"min=0,max=10"`
+	RetryInterval  int64      `json:"retry_interval" binding:"min=0"`
+}
+
+// createScheduledTransfer schedules a one-off or recurring transfer from the authenticated user's account
+func (server *Server) createScheduledTransfer(ctx *gin.Context) {
+	var req createScheduledTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if req.EndAt != nil && req.EndAt.Before(req.StartAt) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrEndBeforeStart))
+		return
+	}
+
+	// failed executions would be retried back-to-back without an interval
+	if req.MaxRetries > 0 && req.RetryInterval < 1 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrRetryIntervalRequired))
+		return
+	}
+
+	fromAccount, valid := server.validAccount(ctx, req.Currency, req.FromAccountID)
+
+	if !valid {
+		return
+	}
+
+	if !authorize(ctx, policy.MoveMoney, fromAccount.Owner) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
+		return
+	}
+
+	_, valid = server.validAccount(ctx, req.Currency, req.ToAccountID)
+
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:          authPayload.Username,
+		FromAccountID:  req.FromAccountID,
+		ToAccountID:    req.ToAccountID,
+		Amount:         req.Amount,
+		Frequency:      req.Frequency,
+		StartAt:        req.StartAt,
+		EndAt:          req.EndAt,
+		MaxOccurrences: req.MaxOccurrences,
+		MaxRetries:     req.MaxRetries,
+		RetryInterval:  req.RetryInterval,
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx, arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// scheduledTransferURIRequest holds the ID of the scheduled transfer in the URI
+type scheduledTransferURIRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getScheduledTransfer returns the scheduled transfer of the authenticated user
+func (server *Server) getScheduledTransfer(ctx *gin.Context) {
+	var req scheduledTransferURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	scheduled, valid := server.validScheduledTransfer(ctx, req.ID)
+
+	if !valid {
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// listScheduledTransfersRequest holds the query params for the listScheduledTransfers handler
+type listScheduledTransfersRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+// listScheduledTransfers lists the scheduled transfers of the authenticated user
+func (server *Server) listScheduledTransfers(ctx *gin.Context) {
+	var req listScheduledTransfersRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	scheduledTransfers, err := server.store.ListScheduledTransfers(ctx, db.ListScheduledTransfersParams{
+		Owner:  authPayload.Username,
+		Limit:  req.PageSize,
+		Offset: (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduledTransfers)
+}
+
+// updateScheduledTransferRequest holds the fields of the scheduled transfer which can be updated, nil fields are not changed
+type updateScheduledTransferRequest struct {
+	Amount         *int64     `json:"amount" binding:"omitempty,gt=0"`
+	EndAt          *time.Time `json:"end_at"`
+	MaxOccurrences *int32     `json:"max_occurrences" binding:"omitempty,min=1"`
+	MaxRetries     *int32     `json:"max_retries" binding:"omitempty,min=0,max=10"`
+	RetryInterval  *int64     `json:"retry_interval" binding:"omitempty,min=0"`
+}
+
+// updateScheduledTransfer updates an active scheduled transfer of the authenticated user
+func (server *Server) updateScheduledTransfer(ctx *gin.Context) {
+	var uri scheduledTransferURIRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req updateScheduledTransferRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	scheduled, valid := server.validScheduledTransfer(ctx, uri.ID)
+
+	if !valid {
+		return
+	}
+
+	if req.EndAt != nil && req.EndAt.Before(scheduled.StartAt) {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrEndBeforeStart))
+		return
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID:             uri.ID,
+		EndAt:          req.EndAt,
+		MaxOccurrences: req.MaxOccurrences,
+	}
+
+	if req.Amount != nil {
+		arg.Amount = sql.NullInt64{Int64: *req.Amount, Valid: true}
+	}
+
+	if req.MaxRetries != nil {
+		arg.MaxRetries = sql.NullInt32{Int32: *req.MaxRetries, Valid: true}
+	}
+
+	if req.RetryInterval != nil {
+		arg.RetryInterval = sql.NullInt64{Int64: *req.RetryInterval, Valid: true}
+	}
+
+	// the retry policy is checked with the fields which aren't updated
+	maxRetries, retryInterval := scheduled.MaxRetries, scheduled.RetryInterval
+
+	if arg.MaxRetries.Valid {
+		maxRetries = arg.MaxRetries.Int32
+	}
+
+	if arg.RetryInterval.Valid {
+		retryInterval = arg.RetryInterval.Int64
+	}
+
+	if maxRetries > 0 && retryInterval < 1 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(ErrRetryIntervalRequired))
+		return
+	}
+
+	scheduled, err := server.store.UpdateScheduledTransfer(ctx, arg)
+
+	if err != nil {
+		// only active scheduled transfers are updated
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(ErrScheduledTransferNotActive))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// cancelScheduledTransfer cancels an active scheduled transfer of the authenticated user, its executions are kept
+func (server *Server) cancelScheduledTransfer(ctx *gin.Context) {
+	var req scheduledTransferURIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.validScheduledTransfer(ctx, req.ID); !valid {
+		return
+	}
+
+	scheduled, err := server.store.CancelScheduledTransfer(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(ErrScheduledTransferNotActive))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, scheduled)
+}
+
+// listScheduledTransferExecutionsRequest holds the query params for the listScheduledTransferExecutions handler
+type listScheduledTransferExecutionsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+// listScheduledTransferExecutions lists the execution attempts of a scheduled transfer of the authenticated user
+func (server *Server) listScheduledTransferExecutions(ctx *gin.Context) {
+	var uri scheduledTransferURIRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listScheduledTransferExecutionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.validScheduledTransfer(ctx, uri.ID); !valid {
+		return
+	}
+
+	executions, err := server.store.ListScheduledTransferExecutions(ctx, db.ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: uri.ID,
+		Limit:               req.PageSize,
+		Offset:              (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, executions)
+}
+
+// validScheduledTransfer gets the scheduled transfer and checks if it belongs to the authenticated user
+func (server *Server) validScheduledTransfer(ctx *gin.Context, id int64) (db.ScheduledTransfer, bool) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return scheduled, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return scheduled, false
+	}
+
+	if !authorize(ctx, policy.ManageScheduledTransfer, scheduled.Owner) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrScheduledTransferIsNotAuthenticatedUsers))
+		return scheduled, false
+	}
+
+	return scheduled, true
+}
@@ -2,9 +2,12 @@ package api
 
 import (
 	"errors"
+	"expvar"
 	"fmt"
+	"net/http"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/fx"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/burakkarasel/Bank-App/util"
 	"github.com/gin-gonic/gin"
@@ -13,13 +16,15 @@ import (
 )
 
 var ErrAccountIsNotAuthenticatedUsers = errors.New("account doesn't belong to authenticated user")
+var ErrTransferIsNotAuthenticatedUsers = errors.New("transfer doesn't belong to authenticated user")
 
 // Server serves all HTTP request for banking services
 type Server struct {
-	config     util.Config
-	store      db.Store // which we will hold the db, and queries
-	router     *gin.Engine
-	tokenMaker token.Maker
+	config       util.Config
+	store        db.Store // which we will hold the db, and queries
+	router       *gin.Engine
+	tokenMaker   token.Maker
+	rateProvider fx.RateProvider
 }
 
 // NewServer creates a new Server which will hold our routes and DB
@@ -30,13 +35,18 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:       config,
+		store:        store,
+		tokenMaker:   tokenMaker,
+		rateProvider: fx.NewLocalRateProvider(store),
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("currency", validCurrency)
+		v.RegisterValidation("frequency", validFrequency)
+		v.RegisterValidation("statement_format", validStatementFormat)
+		v.RegisterValidation("recipient", validRecipient)
+		v.RegisterValidation("split_rule", validSplitRule)
 	}
 
 	server.setupRouter()
@@ -55,19 +65,80 @@ func (server *Server) setupRouter() {
 
 	authRoutes := router.Group("/").Use(authMiddleware(server.tokenMaker))
 
+	// sessions
+	authRoutes.POST("/users/logout", server.logoutUser)
+	authRoutes.GET("/sessions", server.listSessions)
+	authRoutes.DELETE("/sessions/:id", server.revokeSession)
+	authRoutes.POST("/sessions/revoke_others", server.revokeOtherSessions)
+
 	// accounts
 	authRoutes.POST("/accounts", server.createAccount)
 	authRoutes.GET("/accounts/:id", server.getAccountById)
 	authRoutes.GET("/accounts", server.listAccounts)
+	authRoutes.POST("/accounts/:id/freeze", server.freezeAccount)
+	authRoutes.POST("/accounts/:id/unfreeze", server.unfreezeAccount)
+	authRoutes.POST("/accounts/:id/close", server.closeAccount)
+	authRoutes.GET("/accounts/:id/statement", server.getAccountStatement)
+	authRoutes.GET("/accounts/:id/statements", server.listMonthlyStatements)
+	authRoutes.GET("/accounts/:id/statements/:statement_id", server.downloadMonthlyStatement)
 
 	// transfers
 	authRoutes.POST("/transfers", server.createTransfer)
+	authRoutes.GET("/transfers/:id", server.getTransfer)
+	authRoutes.GET("/transfers", server.listTransfers)
+	authRoutes.GET("/payees", server.getPayee)
+	authRoutes.POST("/transfers/:id/reversals", server.reverseTransfer)
+
+	// holds
+	authRoutes.POST("/holds", server.createHold)
+	authRoutes.GET("/holds/:id", server.getHold)
+	authRoutes.POST("/holds/:id/capture", server.captureHold)
+	authRoutes.POST("/holds/:id/void", server.voidHold)
+
+	// payment requests
+	authRoutes.POST("/payment_requests", server.createPaymentRequest)
+	authRoutes.GET("/payment_requests", server.listPaymentRequests)
+	authRoutes.GET("/payment_requests/:id", server.getPaymentRequest)
+	authRoutes.POST("/payment_requests/:id/accept", server.acceptPaymentRequest)
+	authRoutes.POST("/payment_requests/:id/decline", server.declinePaymentRequest)
+	authRoutes.POST("/payment_requests/:id/cancel", server.cancelPaymentRequest)
+
+	// bill splits
+	authRoutes.POST("/bill_splits", server.createBillSplit)
+	authRoutes.GET("/bill_splits", server.listBillSplits)
+	authRoutes.GET("/bill_splits/:id", server.getBillSplit)
+
+	// scheduled transfers
+	authRoutes.POST("/scheduled_transfers", server.createScheduledTransfer)
+	authRoutes.GET("/scheduled_transfers/:id", server.getScheduledTransfer)
+	authRoutes.GET("/scheduled_transfers", server.listScheduledTransfers)
+	authRoutes.PATCH("/scheduled_transfers/:id", server.updateScheduledTransfer)
+	authRoutes.DELETE("/scheduled_transfers/:id", server.cancelScheduledTransfer)
+	authRoutes.GET("/scheduled_transfers/:id/executions", server.listScheduledTransferExecutions)
+
+	// fx
+	authRoutes.POST("/fx/quotes", server.createFxQuote)
 
 	// entries
 	authRoutes.POST("/entries", server.createEntry)
 	authRoutes.GET("/entries/:id", server.getEntry)
 	authRoutes.GET("/entries", server.listEntries)
 
+	// back-office routes, every admin action is written to the audit logs. Admins who freeze, unfreeze or reverse
+	// through the shared routes above on the accounts of other users are written to the audit logs as well
+	adminRoutes := router.Group("/admin").Use(authMiddleware(server.tokenMaker), adminMiddleware())
+
+	adminRoutes.GET("/users", server.adminSearchUsers)
+	adminRoutes.GET("/users/:username/accounts", server.adminListUserAccounts)
+	adminRoutes.GET("/users/:username/entries", server.adminListUserEntries)
+	adminRoutes.GET("/accounts/:id/entries", server.adminListAccountEntries)
+	adminRoutes.POST("/accounts/:id/adjustments", server.adminAdjustBalance)
+	adminRoutes.PUT("/accounts/:id/overdraft_limit", server.adminSetOverdraftLimit)
+	adminRoutes.POST("/sessions/:id/unblock", server.adminUnblockSession)
+	adminRoutes.GET("/audit_logs", server.adminListAuditLogs)
+	adminRoutes.GET("/reconciliation_reports/latest", server.adminGetLatestReconciliationReport)
+	adminRoutes.GET("/metrics", gin.WrapH(expvar.Handler()))
+
 	server.router = router
 }
 
@@ -76,6 +147,11 @@ func (server *Server) Start(address string) error {
 	return server.router.Run(address)
 }
 
+// Handler returns the HTTP handler of the server, so it can be served by an http.Server we can shut down
+func (server *Server) Handler() http.Handler {
+	return server.router
+}
+
 // errorResponse lets us to send error to client in JSON format (key:value)
 func errorResponse(err error) gin.H {
 	return gin.H{"error": err.Error()}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+var ErrSessionIsNotAuthenticatedUsers = errors.New("session doesn't belong to authenticated user")
+
+// sessionResponse holds the session without its refresh token
+type sessionResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Username  string    `json:"username"`
+	UserAgent string    `json:"user_agent"`
+	ClientIp  string    `json:"client_ip"`
+	IsBlocked bool      `json:"is_blocked"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// newSessionResponse creates a returnable response from the session, refresh token is never returned
+func newSessionResponse(session db.Session) sessionResponse {
+	return sessionResponse{
+		ID:        session.ID,
+		Username:  session.Username,
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		IsBlocked: session.IsBlocked,
+		ExpiresAt: session.ExpiresAt,
+		CreatedAt: session.CreatedAt,
+	}
+}
+
+// listSessionsRequest holds the query params for the listSessions handler
+type listSessionsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+// listSessions lists the sessions of the authenticated user which are not blocked or expired
+func (server *Server) listSessions(ctx *gin.Context) {
+	var req listSessionsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	sessions, err := server.store.ListActiveSessions(ctx, db.ListActiveSessionsParams{
+		Username: authPayload.Username,
+		Limit:    req.PageSize,
+		Offset:   (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	resp := make([]sessionResponse, len(sessions))
+
+	for i, session := range sessions {
+		resp[i] = newSessionResponse(session)
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// revokeSessionRequest holds the ID of the session in the URI
+type revokeSessionRequest struct {
+	ID string `uri:"id" binding:"required,uuid"`
+}
+
+// revokeSession blocks a session of the authenticated user, so its refresh token can't renew access tokens anymore
+func (server *Server) revokeSession(ctx *gin.Context) {
+	var req revokeSessionRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	id := uuid.MustParse(req.ID)
+
+	session, err := server.store.GetSession(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	if !authorize(ctx, policy.ManageSession, session.Username) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrSessionIsNotAuthenticatedUsers))
+		return
+	}
+
+	session, err = server.store.BlockSession(ctx, id)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionResponse(session))
+}
+
+// currentSessionRequest holds the refresh token which identifies the session of the request
+type currentSessionRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// revokeOtherSessionsResponse holds the number of the sessions which are blocked
+type revokeOtherSessionsResponse struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
+
+// revokeOtherSessions blocks every session of the authenticated user except the one of the refresh token
+func (server *Server) revokeOtherSessions(ctx *gin.Context) {
+	var req currentSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, valid := server.validCurrentSession(ctx, req.RefreshToken)
+
+	if !valid {
+		return
+	}
+
+	revoked, err := server.store.BlockOtherSessions(ctx, db.BlockOtherSessionsParams{
+		Username: session.Username,
+		ID:       session.ID,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revokeOtherSessionsResponse{RevokedSessions: revoked})
+}
+
+// logoutUser blocks the session of the refresh token
+func (server *Server) logoutUser(ctx *gin.Context) {
+	var req currentSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	session, valid := server.validCurrentSession(ctx, req.RefreshToken)
+
+	if !valid {
+		return
+	}
+
+	session, err := server.store.BlockSession(ctx, session.ID)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, newSessionResponse(session))
+}
+
+// validCurrentSession gets the session of the refresh token and checks if it is an unblocked session of the authenticated user
+func (server *Server) validCurrentSession(ctx *gin.Context, refreshToken string) (db.Session, bool) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken)
+
+	if err != nil {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return db.Session{}, false
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return session, false
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return session, false
+	}
+
+	if !authorize(ctx, policy.ManageSession, session.Username) {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrSessionUserIsInvalid))
+		return session, false
+	}
+
+	if session.RefreshToken != refreshToken {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrInvalidToken))
+		return session, false
+	}
+
+	if session.IsBlocked {
+		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrSessionBlocked))
+		return session, false
+	}
+
+	return session, true
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/statement"
+	"github.com/gin-gonic/gin"
+)
+
+// getAccountStatementRequest holds the format and the period of the statement, from is inclusive and to is exclusive
+type getAccountStatementRequest struct {
+	Format string    `form:"format" binding:"required,statement_format"`
+	From   time.Time `form:"from" binding:"required"`
+	To     time.Time `form:"to" binding:"required,gtfield=From"`
+}
+
+// getAccountStatement renders the statement of the account for the period in the requested format,
+// the lines are streamed to the response as they are read from DB
+func (server *Server) getAccountStatement(ctx *gin.Context) {
+	var uri getAccountByIdRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req getAccountStatementRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	account, valid := server.authorizeAccount(ctx, uri.ID, policy.ReadAccount)
+
+	if !valid {
+		return
+	}
+
+	generator := statement.NewGenerator(server.store)
+
+	result, err := generator.Generate(ctx, account, req.From, req.To)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", statement.FileName(result, req.Format)))
+	ctx.Header("Content-Type", statement.ContentType(req.Format))
+	ctx.Status(http.StatusOK)
+
+	if err := generator.Write(ctx, ctx.Writer, req.Format, result); err != nil {
+		// the status is already sent, so we can only cut the statement short
+		log.Printf("cannot write statement of account [%d]: %s", account.ID, err)
+		ctx.Abort()
+	}
+}
+
+// listMonthlyStatementsRequest holds the page of the monthly statements
+type listMonthlyStatementsRequest struct {
+	PageID   int32 `form:"page_id" binding:"required,min=1"`
+	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+}
+
+// listMonthlyStatements lists the issued monthly statements of the account without their PDFs, the latest month first
+func (server *Server) listMonthlyStatements(ctx *gin.Context) {
+	var uri getAccountByIdRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listMonthlyStatementsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.authorizeAccount(ctx, uri.ID, policy.ReadAccount); !valid {
+		return
+	}
+
+	statements, err := server.store.ListStatements(ctx, db.ListStatementsParams{
+		AccountID: uri.ID,
+		Limit:     req.PageSize,
+		Offset:    (req.PageID - 1) * req.PageSize,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, statements)
+}
+
+// downloadMonthlyStatementRequest holds the IDs of the account and the statement in the URI
+type downloadMonthlyStatementRequest struct {
+	ID          int64 `uri:"id" binding:"required,min=1"`
+	StatementID int64 `uri:"statement_id" binding:"required,min=1"`
+}
+
+// downloadMonthlyStatement returns the PDF of an issued monthly statement of the account
+func (server *Server) downloadMonthlyStatement(ctx *gin.Context) {
+	var uri downloadMonthlyStatementRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	if _, valid := server.authorizeAccount(ctx, uri.ID, policy.ReadAccount); !valid {
+		return
+	}
+
+	result, err := server.store.GetStatement(ctx, db.GetStatementParams{
+		ID:        uri.StatementID,
+		AccountID: uri.ID,
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", result.FileName))
+	ctx.Data(http.StatusOK, statement.ContentType(statement.FormatPDF), result.Content)
+}
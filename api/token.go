@@ -6,7 +6,9 @@ import (
 	"net/http"
 	"time"
 
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 var ErrSessionBlocked = errors.New("blocked session")
@@ -16,16 +18,20 @@ var ErrExpiredSession = errors.New("expired session")
 
 // renewAccessTokenRequest holds the params of the request's
 type renewAccessTokenRequest struct {
-	RefreshToken string `json:"refresh_token " binding:"required"`
+	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
-// renewAccessTokenResponse holds access token and user
+// renewAccessTokenResponse holds the new access token and the refresh token which replaces the used one
 type renewAccessTokenResponse struct {
-	AccessToken          string    `json:"access_token"`
-	AccessTokenExpiresAt time.Time `json:"access_token_expires_at"`
+	SessionID             uuid.UUID `json:"session_id"`
+	AccessToken           string    `json:"access_token"`
+	AccessTokenExpiresAt  time.Time `json:"access_token_expires_at"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
 }
 
-// renewAccessToken renews the acces token after checking it
+// renewAccessToken renews the acces token after checking it, the refresh token is rotated on every renewal
+// and presenting a rotated refresh token again blocks every session which is rotated from the same login
 func (server *Server) renewAccessToken(ctx *gin.Context) {
 	var req renewAccessTokenRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
@@ -78,18 +84,57 @@ func (server *Server) renewAccessToken(ctx *gin.Context) {
 		return
 	}
 
+	// role is read again, so the new tokens carry the current role of the user
+	user, err := server.store.GetUser(ctx, session.Username)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
 	// then we create an access token for this logged in user
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(refreshPayload.Username, server.config.RefreshTokenDuration)
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// and a new refresh token which replaces the presented one
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.RefreshTokenDuration)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	result, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		SessionID: session.ID,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			RefreshToken: refreshToken,
+			UserAgent:    ctx.Request.UserAgent(),
+			ClientIp:     ctx.ClientIP(),
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
 
 	if err != nil {
+		if err == db.ErrRefreshTokenReused || err == db.ErrSessionBlocked {
+			ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	// and then we send access token and it's expiration time
+	// and then we send the new tokens and their expiration times
 	resp := renewAccessTokenResponse{
-		AccessToken:          accessToken,
-		AccessTokenExpiresAt: accessPayload.ExpiredAt,
+		SessionID:             result.Session.ID,
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessPayload.ExpiredAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: newRefreshPayload.ExpiredAt,
 	}
 
 	ctx.JSON(http.StatusOK, resp)
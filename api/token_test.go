@@ -0,0 +1,195 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRenewAccessTokenAPI tests renewAccessToken handler with multiple cases,
+// the refresh token of the session is created with the token maker of the test server
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		refreshToken  func(refreshToken string) string
+		buildStubs    func(store *mockdb.MockStore, session db.Session)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(session.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(_ interface{}, arg db.RotateSessionTxParams) (db.RotateSessionTxResult, error) {
+						require.Equal(t, session.ID, arg.SessionID)
+						require.NotEqual(t, session.ID, arg.NewSession.ID)
+						require.NotEqual(t, session.RefreshToken, arg.NewSession.RefreshToken)
+
+						return db.RotateSessionTxResult{
+							ParentSession: session,
+							Session: db.Session{
+								ID:           arg.NewSession.ID,
+								Username:     session.Username,
+								RefreshToken: arg.NewSession.RefreshToken,
+								FamilyID:     session.FamilyID,
+								ParentID:     &session.ID,
+							},
+						}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got renewAccessTokenResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+
+				require.NotEmpty(t, got.AccessToken)
+				require.NotEmpty(t, got.RefreshToken)
+				require.NotEqual(t, session.RefreshToken, got.RefreshToken)
+				require.NotEqual(t, session.ID, got.SessionID)
+				require.WithinDuration(t, time.Now().Add(time.Minute), got.AccessTokenExpiresAt, time.Second)
+			},
+		},
+		{
+			name: "Reused Refresh Token",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(session.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RotateSessionTxResult{}, db.ErrRefreshTokenReused)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Blocked Session",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.IsBlocked = true
+
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Expired Session",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.ExpiresAt = time.Now().Add(-time.Minute)
+
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Mismatched Token",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.RefreshToken = util.RandomString(32)
+
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Session Not Found",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid Refresh Token",
+			refreshToken: func(refreshToken string) string {
+				return "invalid"
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Get User Error",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(session.Username)).Times(1).Return(db.User{}, sql.ErrConnDone)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+		{
+			name: "Internal Error",
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(session.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RotateSessionTxResult{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder, session db.Session) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			server := newTestServer(t, store)
+
+			refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Hour)
+			require.NoError(t, err)
+
+			session := randomSession(user.Username)
+			session.ID = refreshPayload.ID
+			session.FamilyID = refreshPayload.ID
+			session.RefreshToken = refreshToken
+
+			tt.buildStubs(store, session)
+
+			if tt.refreshToken != nil {
+				refreshToken = tt.refreshToken(refreshToken)
+			}
+
+			data, err := json.Marshal(gin.H{"refresh_token": refreshToken})
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodPost, "/token/renew_access", bytes.NewReader(data))
+			require.NoError(t, err)
+
+			server.router.ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder, session)
+		})
+	}
+}
@@ -3,19 +3,26 @@ package api
 import (
 	"database/sql"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/policy"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/gin-gonic/gin"
 )
 
-// createAccountRequest holds the params of the request's and response's
+// createAccountRequest holds the params of the request's and response's, money goes either to the account
+// with to_account_id or to the account of the recipient in the currency of the transfer
 type createTransferRequest struct {
 	FromAccountID int64  `json:"from_account_id" binding:"required,min=1"`
-	ToAccountID   int64  `json:"to_account_id" binding:"required,min=1"`
+	ToAccountID   int64  `json:"to_account_id" binding:"required_without=Recipient,excluded_with=Recipient,omitempty,min=1"`
+	Recipient     string `json:"recipient" binding:"omitempty,recipient"`
 	Amount        int64  `json:"amount" binding:"required,gt=0"`
 	Currency      string `json:"currency"  binding:"required,currency"`
+	FxQuoteID     string `json:"fx_quote_id" binding:"omitempty,uuid"`
 }
 
 // createAccount handles account creation requests, checks the binding, and finally if the account is succesfully inserted to DB
@@ -27,21 +34,33 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		return
 	}
 
+	// retries with the same idempotency key get the result of the first transfer
+	idempotency, err := idempotencyParams(ctx)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// transfers between different currencies need a quote which locks the rate
+	if req.FxQuoteID != "" {
+		server.createFxTransfer(ctx, req, idempotency)
+		return
+	}
+
 	fromAccount, valid := server.validAccount(ctx, req.Currency, req.FromAccountID)
 
 	if !valid {
 		return
 	}
 
-	// here after checking fromAccount is valid or not we check if the fromAccount and authenticated user is same
-	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-
-	if fromAccount.Owner != authPayload.Username {
+	// here after checking fromAccount is valid or not we check if the authenticated user may send money from it
+	if !authorize(ctx, policy.MoveMoney, fromAccount.Owner) {
 		ctx.JSON(http.StatusUnauthorized, errorResponse(ErrAccountIsNotAuthenticatedUsers))
 		return
 	}
 
-	_, valid = server.validAccount(ctx, req.Currency, req.ToAccountID)
+	req.ToAccountID, valid = server.recipientAccount(ctx, req, req.Currency)
 
 	if !valid {
 		return
@@ -51,11 +70,19 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		FromAccountID: req.FromAccountID,
 		ToAccountID:   req.ToAccountID,
 		Amount:        req.Amount,
+		Idempotency:   idempotency,
+		Actor:         auditActor(ctx),
 	}
 
 	result, err := server.store.TransferTx(ctx, arg)
 
 	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed,
+			db.ErrSystemAccount:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -84,3 +111,203 @@ func (server *Server) validAccount(ctx *gin.Context, currency string, accID int6
 
 	return acc, true
 }
+
+// recipientAccount returns the ID of the account which the transfer goes to, transfers which are sent to a recipient
+// go to the account of the recipient in the currency
+func (server *Server) recipientAccount(ctx *gin.Context, req createTransferRequest, currency string) (int64, bool) {
+	if req.Recipient == "" {
+		account, valid := server.validAccount(ctx, currency, req.ToAccountID)
+		return account.ID, valid
+	}
+
+	payee, valid := server.resolvePayee(ctx, req.Recipient, currency)
+
+	return payee.Account.ID, valid
+}
+
+// reverseTransferRequest holds the ID of the transfer which is reversed
+type reverseTransferRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// reverseTransferBody holds the amount to refund, if it is omitted the amount which is not refunded yet is reversed
+type reverseTransferBody struct {
+	Amount int64 `json:"amount" binding:"omitempty,gt=0"`
+}
+
+// reverseTransfer refunds a transfer fully or partially, only the owner of the account which received the transfer or an admin can reverse it
+func (server *Server) reverseTransfer(ctx *gin.Context) {
+	var req reverseTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var body reverseTransferBody
+	if err := ctx.ShouldBindJSON(&body); err != nil && err != io.EOF {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// retries with the same idempotency key get the result of the first reversal
+	idempotency, err := idempotencyParams(ctx)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	// money is taken back from the account which received the transfer, so it must belong to the authenticated user
+	// unless an admin reverses it
+	toAccount, valid := server.authorizeAccount(ctx, transfer.ToAccountID, policy.ReverseTransfer)
+
+	if !valid {
+		return
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID:    req.ID,
+		Amount:        body.Amount,
+		AdminUsername: adminActor(ctx, toAccount.Owner),
+		Idempotency:   idempotency,
+		Actor:         auditActor(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case db.ErrTransferAlreadyReversed, db.ErrRefundExceedsTransfer, db.ErrReversalNotReversible,
+			db.ErrFxTransferNotReversible, db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch,
+			db.ErrAccountFrozen, db.ErrAccountClosed:
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// getTransferRequest holds the ID of the transfer in the URI
+type getTransferRequest struct {
+	ID int64 `uri:"id" binding:"required,min=1"`
+}
+
+// getTransfer returns a transfer from or to an account of the authenticated user with its direction and counterparty,
+// admins can get any transfer
+func (server *Server) getTransfer(ctx *gin.Context) {
+	var req getTransferRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	transfer, valid := server.authorizeTransfer(ctx, req.ID)
+
+	if !valid {
+		return
+	}
+
+	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
+
+	details, err := server.store.TransferDetails(ctx, db.TransferDetailsParams{
+		Transfers: []db.Transfer{transfer},
+		Username:  authPayload.Username,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, details[0])
+}
+
+// listTransfersRequest holds the filters and the cursor of the page of the transfers, from is inclusive and to is exclusive.
+// Direction is incoming or outgoing from the view of the account
+type listTransfersRequest struct {
+	AccountID             int64     `form:"account_id" binding:"required,min=1"`
+	From                  time.Time `form:"from"`
+	To                    time.Time `form:"to" binding:"omitempty,gtfield=From"`
+	MinAmount             *int64    `form:"min_amount"`
+	MaxAmount             *int64    `form:"max_amount"`
+	Direction             string    `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	CounterpartyAccountID int64     `form:"counterparty_account_id" binding:"omitempty,min=1"`
+	cursorPageRequest
+}
+
+// listTransfersResponse holds a page of the transfers and the cursor of the next page, which is empty on the last page
+type listTransfersResponse struct {
+	Transfers  []db.TransferDetail `json:"transfers"`
+	NextCursor string              `json:"next_cursor"`
+}
+
+// listTransfers returns a page of the transfers from or to an account which match the filters,
+// with their directions and counterparties from the view of the account
+func (server *Server) listTransfers(ctx *gin.Context) {
+	var req listTransfersRequest
+
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	arg := db.ListTransfersPageParams{
+		AccountID:             req.AccountID,
+		From:                  req.From,
+		To:                    req.To,
+		MinAmount:             req.MinAmount,
+		MaxAmount:             req.MaxAmount,
+		Direction:             req.Direction,
+		CounterpartyAccountID: req.CounterpartyAccountID,
+	}
+
+	page, err := req.page(arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	// here we check the authenticated user and account ID is associated or not, admins can list every transfer
+	if _, valid := server.authorizeAccount(ctx, req.AccountID, policy.ReadAccount); !valid {
+		return
+	}
+
+	arg.PageParams = page.Params()
+
+	transfers, err := server.store.ListTransfersPage(ctx, arg)
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	transfers, nextCursor := pagination.Cut(page, transfers, func(transfer db.Transfer) int64 { return transfer.ID })
+
+	details, err := server.store.TransferDetails(ctx, db.TransferDetailsParams{
+		Transfers: transfers,
+		AccountID: req.AccountID,
+	})
+
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, listTransfersResponse{
+		Transfers:  details,
+		NextCursor: nextCursor,
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -60,6 +62,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
 			},
@@ -86,6 +89,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc3.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -112,6 +116,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc3.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user3.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -138,6 +143,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc3.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user3.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -164,6 +170,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{}, sql.ErrConnDone)
 			},
@@ -190,6 +197,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -216,6 +224,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -242,6 +251,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -267,6 +277,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -293,6 +304,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -318,6 +330,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -344,6 +357,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -369,6 +383,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -395,6 +410,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -420,6 +436,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -446,6 +463,7 @@ func TestCreateTransferAPI(t *testing.T) {
 					FromAccountID: acc1.ID,
 					ToAccountID:   acc2.ID,
 					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
 				}
 				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(0)
 			},
@@ -474,6 +492,213 @@ func TestCreateTransferAPI(t *testing.T) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
+		{
+			name: "Admin can't transfer",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorizationWithRole(t, request, tokenMaker, authorizationTypeBearer, user3.Username, util.AdminRole, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "OK with idempotency key",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "transfer-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: acc1.ID,
+					ToAccountID:   acc2.ID,
+					Amount:        amount,
+					Idempotency: &db.IdempotencyParams{
+						Username: user1.Username,
+						Key:      "transfer-key",
+					},
+					Actor: testAuditActor(user1.Username),
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Idempotency key mismatch",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "transfer-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrIdempotencyKeyMismatch)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid idempotency key",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, util.RandomString(256))
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Insufficient funds",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.TransferTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "OK to recipient",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"recipient":       user2.Email,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Eq(user2.Email), gomock.Eq(util.USD)).
+					Times(1).
+					Return(db.Payee{Account: acc2, FullName: user2.FullName, Email: user2.Email}, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: acc1.ID,
+					ToAccountID:   acc2.ID,
+					Amount:        amount,
+					Actor:         testAuditActor(user1.Username),
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Recipient has no account in currency",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"recipient":       user3.Username,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Eq(user3.Username), gomock.Eq(util.USD)).
+					Times(1).
+					Return(db.Payee{}, db.ErrRecipientHasNoAccount)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Recipient and to account",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"to_account_id":   acc2.ID,
+				"recipient":       user2.Username,
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Invalid recipient",
+			body: gin.H{
+				"from_account_id": acc1.ID,
+				"recipient":       "not a user!",
+				"amount":          amount,
+				"currency":        util.USD,
+			},
+			setupAuth: func(t *testing.T, request *http.Request, tokenMaker token.Maker) {
+				addAuthorization(t, request, tokenMaker, authorizationTypeBearer, user1.Username, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ResolvePayee(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -503,3 +728,498 @@ func TestCreateTransferAPI(t *testing.T) {
 		})
 	}
 }
+
+// TestReverseTransferAPI tests reverseTransfer handler with multiple cases
+func TestReverseTransferAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        100,
+		Status:        db.TransferCompleted,
+	}
+
+	testCases := []struct {
+		name          string
+		body          gin.H
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Full reversal",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.ReverseTransferTxParams{
+					TransferID: transfer.ID,
+					Actor:      testAuditActor(user2.Username),
+				}
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Partial refund",
+			body:     gin.H{"amount": 40},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.ReverseTransferTxParams{
+					TransferID: transfer.ID,
+					Amount:     40,
+					Actor:      testAuditActor(user2.Username),
+				}
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Refund exceeds transfer",
+			body:     gin.H{"amount": 400},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ReverseTransferTxResult{}, db.ErrRefundExceedsTransfer)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Already reversed",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(1).Return(db.ReverseTransferTxResult{}, db.ErrTransferAlreadyReversed)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:     "Sender can't reverse",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin reverses",
+			username: "admin_user",
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				//! the admin reverses a transfer of another user, so it is written to the admin audit logs
+				arg := db.ReverseTransferTxParams{
+					TransferID:    transfer.ID,
+					AdminUsername: "admin_user",
+					Actor:         testAuditActor("admin_user"),
+				}
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Transfer not found",
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid amount",
+			body:     gin.H{"amount": -10},
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			var body io.Reader = http.NoBody
+
+			if tt.body != nil {
+				data, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+				body = bytes.NewReader(data)
+			}
+
+			url := fmt.Sprintf("/transfers/%d/reversals", transfer.ID)
+			req, err := http.NewRequest(http.MethodPost, url, body)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestGetTransferAPI tests getTransfer handler with multiple cases
+func TestGetTransferAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	user3, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        100,
+		Status:        db.TransferCompleted,
+	}
+
+	detail := db.TransferDetail{
+		Transfer:  transfer,
+		Direction: db.DirectionIncoming,
+		Counterparty: db.TransferParty{
+			AccountID: acc1.ID,
+			Owner:     user1.Username,
+			FullName:  user1.FullName,
+			Currency:  acc1.Currency,
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		id            int64
+		username      string
+		role          string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Recipient",
+			id:       transfer.ID,
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+
+				arg := db.TransferDetailsParams{
+					Transfers: []db.Transfer{transfer},
+					Username:  user2.Username,
+				}
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.TransferDetail{detail}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got db.TransferDetail
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, detail, got)
+			},
+		},
+		{
+			name:     "Sender",
+			id:       transfer.ID,
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(0)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(1).Return([]db.TransferDetail{detail}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Admin",
+			id:       transfer.ID,
+			username: user3.Username,
+			role:     util.AdminRole,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(1).Return([]db.TransferDetail{detail}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:     "Neither side",
+			id:       transfer.ID,
+			username: user3.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc2.ID)).Times(1).Return(acc2, nil)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Not found",
+			id:       transfer.ID,
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid ID",
+			id:       0,
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Details error",
+			id:       transfer.ID,
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/transfers/%d", tt.id)
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			role := tt.role
+
+			if role == "" {
+				role = util.DepositorRole
+			}
+
+			addAuthorizationWithRole(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, role, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+// TestListTransfersAPI tests listTransfers handler with multiple cases
+func TestListTransfersAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	acc1 := randomAccount(user1.Username)
+	acc2 := randomAccount(user2.Username)
+
+	transfers := make([]db.Transfer, 3)
+	details := make([]db.TransferDetail, len(transfers))
+
+	for i := range transfers {
+		transfers[i] = db.Transfer{
+			ID:            int64(i + 1),
+			FromAccountID: acc1.ID,
+			ToAccountID:   acc2.ID,
+			Amount:        util.RandomMoney(),
+			Status:        db.TransferCompleted,
+		}
+		details[i] = db.TransferDetail{
+			Transfer:     transfers[i],
+			Direction:    db.DirectionOutgoing,
+			Counterparty: db.TransferParty{AccountID: acc2.ID, Owner: user2.Username, FullName: user2.FullName, Currency: acc2.Currency},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         string
+		username      string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "OK",
+			query:    fmt.Sprintf("?account_id=%d&page_size=2&sort=desc&direction=outgoing&counterparty_account_id=%d", acc1.ID, acc2.ID),
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+
+				arg := db.ListTransfersPageParams{
+					AccountID:             acc1.ID,
+					Direction:             db.DirectionOutgoing,
+					CounterpartyAccountID: acc2.ID,
+					PageParams:            db.PageParams{Descending: true, Limit: 3},
+				}
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Transfer{transfers[2], transfers[1], transfers[0]}, nil)
+
+				detailsArg := db.TransferDetailsParams{
+					Transfers: []db.Transfer{transfers[2], transfers[1]},
+					AccountID: acc1.ID,
+				}
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Eq(detailsArg)).Times(1).Return([]db.TransferDetail{details[2], details[1]}, nil)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var got listTransfersResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &got)
+				require.NoError(t, err)
+				require.Equal(t, []db.TransferDetail{details[2], details[1]}, got.Transfers)
+				require.NotEmpty(t, got.NextCursor)
+			},
+		},
+		{
+			name:     "Unauthorized user",
+			query:    fmt.Sprintf("?account_id=%d", acc1.ID),
+			username: user2.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid direction",
+			query:    fmt.Sprintf("?account_id=%d&direction=credit", acc1.ID),
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "No account ID",
+			query:    "?page_size=5",
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Invalid cursor",
+			query:    fmt.Sprintf("?account_id=%d&cursor=random", acc1.ID),
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "Internal error",
+			query:    fmt.Sprintf("?account_id=%d", acc1.ID),
+			username: user1.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(acc1.ID)).Times(1).Return(acc1, nil)
+				store.EXPECT().ListTransfersPage(gomock.Any(), gomock.Any()).Times(1).Return(nil, sql.ErrConnDone)
+				store.EXPECT().TransferDetails(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tt.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/transfers"+tt.query, nil)
+			require.NoError(t, err)
+
+			addAuthorization(t, req, server.tokenMaker, authorizationTypeBearer, tt.username, time.Minute)
+			server.router.ServeHTTP(recorder, req)
+
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
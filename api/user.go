@@ -25,6 +25,7 @@ type userResponse struct {
 	Username          string    `json:"username"`
 	FullName          string    `json:"full_name"`
 	Email             string    `json:"email"`
+	Role              string    `json:"role"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 }
@@ -34,6 +35,7 @@ func newUserResponse(user db.User) userResponse {
 	return userResponse{
 		Username:          user.Username,
 		Email:             user.Email,
+		Role:              user.Role,
 		CreatedAt:         user.CreatedAt,
 		PasswordChangedAt: user.PasswordChangedAt,
 		FullName:          user.FullName,
@@ -128,7 +130,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 
 	// then we create an access token for this logged in user
-	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.AccessTokenDuration)
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
@@ -136,14 +138,14 @@ func (server *Server) loginUser(ctx *gin.Context) {
 	}
 
 	// and then we create refresh token for this logged in user
-	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, server.config.RefreshTokenDuration)
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.RefreshTokenDuration)
 
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	// here we create a new session
+	// here we create a new session, it starts a new family of sessions for refresh token rotation
 	session, err := server.store.CreateSession(ctx, db.CreateSessionParams{
 		ID:           refreshPayload.ID,
 		Username:     user.Username,
@@ -152,6 +154,7 @@ func (server *Server) loginUser(ctx *gin.Context) {
 		ClientIp:     ctx.ClientIP(),
 		IsBlocked:    false,
 		ExpiresAt:    refreshPayload.ExpiredAt,
+		FamilyID:     refreshPayload.ID,
 	})
 
 	if err != nil {
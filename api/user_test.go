@@ -404,6 +404,7 @@ func randomUser(t *testing.T) (db.User, string) {
 		Username:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
 		FullName:       util.RandomString(12),
+		Role:           util.DepositorRole,
 		HashedPassword: hashedPassword,
 	}, password
 }
@@ -420,5 +421,6 @@ func requiredBodyMatchUser(t *testing.T, body *bytes.Buffer, user db.User) {
 	require.Equal(t, user.Username, gotUser.Username)
 	require.Equal(t, user.Email, gotUser.Email)
 	require.Equal(t, user.FullName, gotUser.FullName)
+	require.Equal(t, user.Role, gotUser.Role)
 	require.Empty(t, gotUser.HashedPassword)
 }
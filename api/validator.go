@@ -1,7 +1,10 @@
 package api
 
 import (
+	"github.com/burakkarasel/Bank-App/split"
+	"github.com/burakkarasel/Bank-App/statement"
 	"github.com/burakkarasel/Bank-App/util"
+	"github.com/burakkarasel/Bank-App/val"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -14,3 +17,39 @@ var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
 
 	return false
 }
+
+// validFrequency is a custom validator that checks if a given frequency of a scheduled transfer is valid or not
+var validFrequency validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if frequency, ok := fieldLevel.Field().Interface().(string); ok {
+		return util.IsSupportedFrequency(frequency)
+	}
+
+	return false
+}
+
+// validStatementFormat is a custom validator that checks if statements can be rendered in a given format or not
+var validStatementFormat validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if format, ok := fieldLevel.Field().Interface().(string); ok {
+		return statement.IsSupportedFormat(format)
+	}
+
+	return false
+}
+
+// validSplitRule is a custom validator that checks if bills can be split by a given rule or not
+var validSplitRule validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if rule, ok := fieldLevel.Field().Interface().(string); ok {
+		return split.IsSupportedRule(rule)
+	}
+
+	return false
+}
+
+// validRecipient is a custom validator that checks if a given recipient of a transfer is a valid username or email or not
+var validRecipient validator.Func = func(fieldLevel validator.FieldLevel) bool {
+	if recipient, ok := fieldLevel.Field().Interface().(string); ok {
+		return val.ValidateRecipient(recipient) == nil
+	}
+
+	return false
+}
@@ -3,25 +3,60 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/burakkarasel/Bank-App/api"
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/fx"
 	"github.com/burakkarasel/Bank-App/gapi"
+	"github.com/burakkarasel/Bank-App/hold"
+	"github.com/burakkarasel/Bank-App/paymentrequest"
 	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/reconciliation"
+	"github.com/burakkarasel/Bank-App/scheduler"
+	"github.com/burakkarasel/Bank-App/statement"
 	"github.com/burakkarasel/Bank-App/util"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	_ "github.com/lib/pq"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// bufSize is the buffer size of the in-memory connection between the gateway and the gRPC server
+const bufSize = 1024 * 1024
+
+const (
+	serverModeGin     = "gin"
+	serverModeGrpc    = "grpc"
+	serverModeGateway = "gateway"
+)
+
+// serverRunner starts a server within the wait group and shuts it down when ctx is done,
+// in-flight requests are drained until shutdownCtx is done
+type serverRunner func(ctx, shutdownCtx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store)
+
+// serverRunners holds the runner of each server mode
+var serverRunners = map[string]serverRunner{
+	serverModeGin:     runGinServer,
+	serverModeGrpc:    runGrpcServer,
+	serverModeGateway: runGatewayServer,
+}
+
 func main() {
 	config, err := util.LoadConfig(".") // we pass the location of the file
 
@@ -29,6 +64,17 @@ func main() {
 		log.Fatal("cannot load config:", err)
 	}
 
+	// we validate the server modes before doing anything else
+	if len(config.ServerModes) == 0 {
+		log.Fatal("no server mode is configured")
+	}
+
+	for _, mode := range config.ServerModes {
+		if _, ok := serverRunners[mode]; !ok {
+			log.Fatalf("unknown server mode: %s", mode)
+		}
+	}
+
 	// here we connect to DB if any error occurs program shutsdown
 	conn, err := sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
@@ -38,59 +84,149 @@ func main() {
 	// run db migrations here
 	runDBMigration(config.MigrationURL, config.DBSource)
 
-	store := db.NewStore(conn)
+	// transactions which fail because of deadlocks or serialization failures are retried
+	store := db.NewStoreWithTxRetry(conn, db.TxRetryPolicy{
+		MaxRetries: config.DBTxMaxRetries,
+		BaseDelay:  config.DBTxRetryDelay,
+	})
+
+	// here we load the local fx rates, rates which are already in the DB are updated
+	if config.FxRatesFile != "" {
+		count, err := fx.ImportRatesFile(context.Background(), store, config.FxRatesFile)
+		if err != nil {
+			log.Fatal("cannot import fx rates:", err)
+		}
+
+		log.Printf("%d fx rates imported", count)
+	}
+
+	// ctx is done when we receive an interrupt or terminate signal, that's when servers start shutting down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	waitGroup, ctx := errgroup.WithContext(ctx)
+
+	// the servers and the DB share one deadline, so the whole shutdown takes at most the shutdown timeout
+	shutdownCtx, cancel := shutdownContext(ctx, config.ShutdownTimeout)
+	defer cancel()
+
+	for _, mode := range config.ServerModes {
+		serverRunners[mode](ctx, shutdownCtx, waitGroup, config, store)
+	}
+
+	// scheduled transfers are executed in the background, zero interval disables it
+	if config.SchedulerInterval > 0 {
+		runScheduler(ctx, waitGroup, config, store)
+	}
+
+	// the ledger is reconciled in the background, zero interval disables it
+	if config.ReconcileInterval > 0 {
+		runReconciler(ctx, waitGroup, config, store)
+	}
+
+	// monthly statements are issued in the background, zero interval disables it
+	if config.StatementInterval > 0 {
+		runStatementIssuer(ctx, waitGroup, config, store)
+	}
 
-	/* go runGatewayServer(config, store)
-	runGrpcServer(config, store) */
-	runGinServer(config, store)
+	// expired holds are released in the background, zero interval disables it
+	if config.HoldSweepInterval > 0 {
+		runHoldSweeper(ctx, waitGroup, config, store)
+	}
+
+	// expired payment requests are closed in the background, zero interval disables it
+	if config.PaymentRequestExpiryInterval > 0 {
+		runPaymentRequestExpirer(ctx, waitGroup, config, store)
+	}
+
+	err = waitGroup.Wait()
+
+	// after all servers stop we wait for the open transactions until what is left of the shutdown timeout and close the DB
+	if closeErr := store.Close(shutdownCtx); closeErr != nil {
+		log.Println("cannot close db gracefully:", closeErr)
+	}
+
+	if err != nil {
+		log.Fatal("server error:", err)
+	}
+
+	log.Println("servers stopped gracefully")
 }
 
 // runGinServer runs a gin HTTP server
-func runGinServer(config util.Config, store db.Store) {
+func runGinServer(ctx, shutdownCtx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
 	server, err := api.NewServer(config, store)
 
 	if err != nil {
 		log.Fatal("cannot create server:", err)
 	}
 
+	httpServer := &http.Server{
+		Addr:    config.HTTPServerAddress,
+		Handler: server.Handler(),
+	}
+
 	// and here we start listening our server
-	err = server.Start(config.HTTPServerAddress)
+	waitGroup.Go(func() error {
+		log.Printf("Gin server started at %s", config.HTTPServerAddress)
 
-	if err != nil {
-		log.Fatal("cannot start server:", err)
-	}
+		err := httpServer.ListenAndServe()
+
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println("cannot start Gin server:", err)
+			return err
+		}
+
+		return nil
+	})
 
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Println("shutting down Gin server")
+
+		return shutdownHTTPServer(shutdownCtx, httpServer)
+	})
 }
 
 // runGrpcServer runs a gRPC server
-func runGrpcServer(config util.Config, store db.Store) {
+func runGrpcServer(ctx, shutdownCtx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
 	server, err := gapi.NewServer(config, store)
 	if err != nil {
 		log.Fatal("cannot create server", err)
 	}
 
-	grpcServer := grpc.NewServer()
-
-	pb.RegisterBankAppServer(grpcServer, server)
-	// this command enables CLI to see which rpc's are avaiable and how can we call them
-	reflection.Register(grpcServer)
+	grpcServer := newGrpcServer(server)
 
 	listener, err := net.Listen("tcp", config.GrpcServerAddress)
 	if err != nil {
 		log.Fatal("cannot create listener:", err)
 	}
 
-	log.Printf("gRPC server started at %s", listener.Addr().String())
+	waitGroup.Go(func() error {
+		log.Printf("gRPC server started at %s", listener.Addr().String())
 
-	err = grpcServer.Serve(listener)
+		err := grpcServer.Serve(listener)
 
-	if err != nil {
-		log.Fatal("cannot start server", err)
-	}
+		if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Println("cannot start gRPC server:", err)
+			return err
+		}
+
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Println("shutting down gRPC server")
+
+		stopGrpcServer(shutdownCtx, grpcServer)
+
+		return nil
+	})
 }
 
 // runGatewayServer runs a HTTP server which enables both gRPC and HTTP requests
-func runGatewayServer(config util.Config, store db.Store) {
+func runGatewayServer(ctx, shutdownCtx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
 	server, err := gapi.NewServer(config, store)
 	if err != nil {
 		log.Fatal("cannot create server", err)
@@ -106,17 +242,35 @@ func runGatewayServer(config util.Config, store db.Store) {
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(jsonOption, runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	// gateway requests are sent to an in-memory gRPC server, so they go through the same interceptors as gRPC requests
+	grpcServer := newGrpcServer(server)
+	bufListener := bufconn.Listen(bufSize)
+
+	grpcConn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return bufListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
 
-	err = pb.RegisterBankAppHandlerServer(ctx, grpcMux, server)
+	if err != nil {
+		log.Fatal("cannot dial in-memory gRPC server", err)
+	}
+
+	err = pb.RegisterBankAppHandler(context.Background(), grpcMux, grpcConn)
 
 	if err != nil {
 		log.Fatal("cannot register handler server", err)
 	}
 
+	err = pb.RegisterAdminServiceHandler(context.Background(), grpcMux, grpcConn)
+
+	if err != nil {
+		log.Fatal("cannot register admin handler server", err)
+	}
+
 	// here we register our grpc routes to http routes
 	mux := http.NewServeMux()
 	mux.Handle("/", grpcMux)
@@ -124,17 +278,192 @@ func runGatewayServer(config util.Config, store db.Store) {
 	fs := http.FileServer(http.Dir("./doc/swagger"))
 	mux.Handle("/swagger/", http.StripPrefix("/swagger/", fs))
 
-	listener, err := net.Listen("tcp", config.HTTPServerAddress)
-	if err != nil {
-		log.Fatal("cannot create listener", err)
+	httpServer := &http.Server{
+		Addr:    config.GatewayServerAddress,
+		Handler: mux,
 	}
 
-	log.Printf("HTTP server started at %s", listener.Addr().String())
+	waitGroup.Go(func() error {
+		err := grpcServer.Serve(bufListener)
 
-	err = http.Serve(listener, mux)
+		if err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Println("cannot start in-memory gRPC server:", err)
+			return err
+		}
+
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		log.Printf("HTTP gateway server started at %s", config.GatewayServerAddress)
+
+		err := httpServer.ListenAndServe()
+
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println("cannot start HTTP gateway server:", err)
+			return err
+		}
+
+		return nil
+	})
+
+	waitGroup.Go(func() error {
+		<-ctx.Done()
+		log.Println("shutting down HTTP gateway server")
+
+		// first we drain the HTTP requests, then the in-memory gRPC server which was serving them
+		err := shutdownHTTPServer(shutdownCtx, httpServer)
+		grpcConn.Close()
+		stopGrpcServer(shutdownCtx, grpcServer)
+
+		return err
+	})
+}
+
+// gatewayHeaderMatcher forwards the Idempotency-Key header to the gRPC server as it is, other headers are matched by the default matcher
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "Idempotency-Key") {
+		return strings.ToLower(key), true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// runScheduler runs the executor of the scheduled transfers until ctx is done
+func runScheduler(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
+	executor := scheduler.NewExecutor(store, config.SchedulerInterval)
+
+	waitGroup.Go(func() error {
+		log.Printf("scheduled transfer executor started, checking every %s", config.SchedulerInterval)
+
+		executor.Run(ctx)
+		log.Println("scheduled transfer executor stopped")
+
+		return nil
+	})
+}
+
+// runReconciler runs the reconciler of the ledger until ctx is done
+func runReconciler(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
+	reconciler := reconciliation.NewReconciler(store, config.ReconcileInterval)
+
+	waitGroup.Go(func() error {
+		log.Printf("ledger reconciler started, reconciling every %s", config.ReconcileInterval)
+
+		reconciler.Run(ctx)
+		log.Println("ledger reconciler stopped")
+
+		return nil
+	})
+}
+
+// runStatementIssuer runs the issuer of the monthly statements until ctx is done
+func runStatementIssuer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
+	issuer := statement.NewIssuer(store, config.StatementInterval)
+
+	waitGroup.Go(func() error {
+		log.Printf("monthly statement issuer started, checking every %s", config.StatementInterval)
+
+		issuer.Run(ctx)
+		log.Println("monthly statement issuer stopped")
+
+		return nil
+	})
+}
+
+// runHoldSweeper runs the sweeper of the expired holds until ctx is done
+func runHoldSweeper(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
+	sweeper := hold.NewSweeper(store, config.HoldSweepInterval)
+
+	waitGroup.Go(func() error {
+		log.Printf("hold sweeper started, checking every %s", config.HoldSweepInterval)
+
+		sweeper.Run(ctx)
+		log.Println("hold sweeper stopped")
+
+		return nil
+	})
+}
+
+// runPaymentRequestExpirer runs the expirer of the expired payment requests until ctx is done
+func runPaymentRequestExpirer(ctx context.Context, waitGroup *errgroup.Group, config util.Config, store db.Store) {
+	expirer := paymentrequest.NewExpirer(store, config.PaymentRequestExpiryInterval)
+
+	waitGroup.Go(func() error {
+		log.Printf("payment request expirer started, checking every %s", config.PaymentRequestExpiryInterval)
+
+		expirer.Run(ctx)
+		log.Println("payment request expirer stopped")
+
+		return nil
+	})
+}
+
+// newGrpcServer creates a gRPC server with the auth interceptors and registers our services
+func newGrpcServer(server *gapi.Server) *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(server.UnaryAuthInterceptor),
+		grpc.StreamInterceptor(server.StreamAuthInterceptor),
+	)
+
+	pb.RegisterBankAppServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, server)
+	// this command enables CLI to see which rpc's are avaiable and how can we call them
+	reflection.Register(grpcServer)
+
+	return grpcServer
+}
+
+// shutdownContext returns a context which is done when the timeout passes after ctx is done
+func shutdownContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	shutdownCtx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-shutdownCtx.Done():
+			return
+		}
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			cancel()
+		case <-shutdownCtx.Done():
+		}
+	}()
+
+	return shutdownCtx, cancel
+}
+
+// shutdownHTTPServer waits for the in-flight requests of the server until ctx is done
+func shutdownHTTPServer(ctx context.Context, httpServer *http.Server) error {
+	err := httpServer.Shutdown(ctx)
 
 	if err != nil {
-		log.Fatal("cannot start HTTP gateway server", err)
+		log.Printf("cannot shut down server at %s gracefully: %s", httpServer.Addr, err)
+		return err
+	}
+
+	return nil
+}
+
+// stopGrpcServer waits for the in-flight RPCs of the server until ctx is done, then stops it forcefully
+func stopGrpcServer(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Println("gRPC server didn't stop in time, stopping forcefully")
+		grpcServer.Stop()
 	}
 }
 
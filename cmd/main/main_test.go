@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestServerRunners tests that every server mode has a runner
+func TestServerRunners(t *testing.T) {
+	for _, mode := range []string{serverModeGin, serverModeGrpc, serverModeGateway} {
+		require.Contains(t, serverRunners, mode)
+	}
+
+	require.NotContains(t, serverRunners, "unknown")
+}
+
+// TestShutdownContext tests that the shutdown context is done only when the timeout passes after ctx is done
+func TestShutdownContext(t *testing.T) {
+	ctx, stop := context.WithCancel(context.Background())
+
+	timeout := 50 * time.Millisecond
+	shutdownCtx, cancel := shutdownContext(ctx, timeout)
+	defer cancel()
+
+	//! the timeout doesn't start until ctx is done
+	select {
+	case <-shutdownCtx.Done():
+		t.Fatal("shutdown context is done before ctx")
+	case <-time.After(2 * timeout):
+	}
+
+	start := time.Now()
+	stop()
+
+	<-shutdownCtx.Done()
+	require.GreaterOrEqual(t, time.Since(start), timeout)
+	require.Less(t, time.Since(start), 10*timeout)
+}
+
+// TestShutdownHTTPServer tests that in-flight requests are drained until the shutdown timeout
+func TestShutdownHTTPServer(t *testing.T) {
+	testCases := []struct {
+		name            string
+		requestDuration time.Duration
+		shutdownTimeout time.Duration
+		checkShutdown   func(t *testing.T, err error, rsp *http.Response, rspErr error)
+	}{
+		{
+			name:            "Drained",
+			requestDuration: 50 * time.Millisecond,
+			shutdownTimeout: time.Second,
+			checkShutdown: func(t *testing.T, err error, rsp *http.Response, rspErr error) {
+				require.NoError(t, err)
+
+				//! the in-flight request is completed before the server stops
+				require.NoError(t, rspErr)
+				require.Equal(t, http.StatusOK, rsp.StatusCode)
+			},
+		},
+		{
+			name:            "Timeout",
+			requestDuration: time.Second,
+			shutdownTimeout: 20 * time.Millisecond,
+			checkShutdown: func(t *testing.T, err error, rsp *http.Response, rspErr error) {
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+
+			started := make(chan struct{})
+
+			httpServer := &http.Server{
+				Addr: listener.Addr().String(),
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					close(started)
+					time.Sleep(tc.requestDuration)
+					w.WriteHeader(http.StatusOK)
+				}),
+			}
+
+			go httpServer.Serve(listener)
+
+			type response struct {
+				rsp *http.Response
+				err error
+			}
+
+			responses := make(chan response, 1)
+
+			go func() {
+				rsp, err := http.Get("http://" + listener.Addr().String())
+				if err == nil {
+					rsp.Body.Close()
+				}
+				responses <- response{rsp, err}
+			}()
+
+			<-started
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.shutdownTimeout)
+			defer cancel()
+
+			err = shutdownHTTPServer(ctx, httpServer)
+
+			var res response
+
+			if err == nil {
+				res = <-responses
+			}
+
+			tc.checkShutdown(t, err, res.rsp, res.err)
+			httpServer.Close()
+		})
+	}
+}
+
+// TestStopGrpcServer tests that the gRPC server stops gracefully and is stopped forcefully once the shutdown timeout passes
+func TestStopGrpcServer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		openStream bool
+	}{
+		{
+			name: "Graceful",
+		},
+		{
+			//! the watch stream never ends, so the server can't stop gracefully
+			name:       "Forced",
+			openStream: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			grpcServer := grpc.NewServer()
+			grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+
+			bufListener := bufconn.Listen(bufSize)
+			go grpcServer.Serve(bufListener)
+
+			conn, err := grpc.DialContext(context.Background(), "bufnet",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return bufListener.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			if tc.openStream {
+				stream, err := grpc_health_v1.NewHealthClient(conn).Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+				require.NoError(t, err)
+
+				_, err = stream.Recv()
+				require.NoError(t, err)
+			}
+
+			timeout := 100 * time.Millisecond
+			start := time.Now()
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			stopGrpcServer(shutdownCtx, grpcServer)
+
+			if tc.openStream {
+				require.GreaterOrEqual(t, time.Since(start), timeout)
+			} else {
+				require.Less(t, time.Since(start), timeout)
+			}
+
+			//! the server doesn't accept RPCs anymore
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/reconciliation"
+	"github.com/burakkarasel/Bank-App/util"
+	_ "github.com/lib/pq"
+)
+
+// reconcile checks the invariants of the ledger once and writes the reconciliation report,
+// it exits with a non-zero code if any discrepancy is found
+func main() {
+	config, err := util.LoadConfig(".")
+
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		log.Fatal("cannot connect to db:", err)
+	}
+
+	store := db.NewStore(conn)
+	defer store.Close(context.Background())
+
+	result, err := reconciliation.NewReconciler(store, 0).Reconcile(context.Background())
+
+	if err != nil {
+		log.Fatal("cannot reconcile ledger:", err)
+	}
+
+	report := result.Report
+
+	if report.DiscrepancyCount > 0 {
+		log.Fatalf("reconciliation report [%d] found %d discrepancies in %d accounts, %d transfers and %d currencies",
+			report.ID, report.DiscrepancyCount, report.AccountsChecked, report.TransfersChecked, report.CurrenciesChecked)
+	}
+
+	log.Printf("ledger is reconciled, report [%d] checked %d accounts, %d transfers and %d currencies",
+		report.ID, report.AccountsChecked, report.TransfersChecked, report.CurrenciesChecked)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	_ "github.com/lib/pq"
+)
+
+// verify_audit walks the audit events from the first one and reports the first broken link of the chain,
+// it exits with a non-zero code if the chain is broken
+func main() {
+	config, err := util.LoadConfig(".")
+
+	if err != nil {
+		log.Fatal("cannot load config:", err)
+	}
+
+	conn, err := sql.Open(config.DBDriver, config.DBSource)
+	if err != nil {
+		log.Fatal("cannot connect to db:", err)
+	}
+
+	store := db.NewStore(conn)
+	defer store.Close(context.Background())
+
+	report, err := store.VerifyAuditChain(context.Background())
+
+	if err != nil {
+		log.Fatal("cannot verify audit chain:", err)
+	}
+
+	if report.BrokenEvent != nil {
+		event := report.BrokenEvent
+		log.Fatalf("audit chain is broken at event [%d] after %d valid events: %s (action: %s, target: %s %s, created at: %s)",
+			event.ID, report.Checked, report.Reason, event.Action, event.TargetType, event.TargetID, event.CreatedAt)
+	}
+
+	log.Printf("audit chain is intact, %d events verified", report.Checked)
+}
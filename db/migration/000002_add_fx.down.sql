@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "converted_amount";
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "fx_rate";
+DROP TABLE IF EXISTS fx_quotes CASCADE;
+DROP TABLE IF EXISTS fx_rates CASCADE;
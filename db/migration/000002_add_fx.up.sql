@@ -0,0 +1,32 @@
+CREATE TABLE "fx_rates" (
+  "id" bigserial PRIMARY KEY,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" numeric NOT NULL,
+  "effective_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "fx_rates_rate_positive" CHECK ("rate" > 0)
+);
+
+CREATE TABLE "fx_quotes" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" numeric NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "fx_rates" ADD CONSTRAINT "fx_rates_pair_effective_at_key" UNIQUE ("from_currency", "to_currency", "effective_at");
+
+ALTER TABLE "fx_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfers" ADD COLUMN "fx_rate" numeric;
+
+ALTER TABLE "transfers" ADD COLUMN "converted_amount" bigint;
+
+COMMENT ON COLUMN "transfers"."fx_rate" IS 'rate used for cross-currency transfers';
+
+COMMENT ON COLUMN "transfers"."converted_amount" IS 'amount credited in the currency of the to account';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scheduled_transfer_executions CASCADE;
+DROP TABLE IF EXISTS scheduled_transfers CASCADE;
@@ -0,0 +1,60 @@
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL CHECK ("amount" > 0),
+  "frequency" varchar NOT NULL,
+  "start_at" timestamptz NOT NULL,
+  "scheduled_at" timestamptz NOT NULL,
+  "run_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "max_occurrences" integer,
+  "occurrences" integer NOT NULL DEFAULT 0,
+  "max_retries" integer NOT NULL DEFAULT 0,
+  "retry_interval" bigint NOT NULL DEFAULT 0,
+  "retries" integer NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_executions" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "transfer_id" bigint,
+  "status" varchar NOT NULL,
+  "failure_reason" varchar,
+  "executed_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_executions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("status", "run_at");
+
+CREATE INDEX ON "scheduled_transfer_executions" ("scheduled_transfer_id");
+
+COMMENT ON COLUMN "scheduled_transfers"."frequency" IS 'once, daily, weekly or monthly';
+
+COMMENT ON COLUMN "scheduled_transfers"."start_at" IS 'first occurrence, later occurrences are calculated from it';
+
+COMMENT ON COLUMN "scheduled_transfers"."scheduled_at" IS 'when the current occurrence is scheduled';
+
+COMMENT ON COLUMN "scheduled_transfers"."run_at" IS 'when the current occurrence is attempted next, later than scheduled_at after failures';
+
+COMMENT ON COLUMN "scheduled_transfers"."retry_interval" IS 'seconds to wait before retrying a failed occurrence';
+
+COMMENT ON COLUMN "scheduled_transfers"."retries" IS 'failed attempts of the current occurrence';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, completed, failed or cancelled';
+
+COMMENT ON COLUMN "scheduled_transfer_executions"."status" IS 'succeeded or failed';
@@ -0,0 +1,17 @@
+ALTER TABLE "transfers" ADD COLUMN "status" varchar NOT NULL DEFAULT 'completed';
+
+ALTER TABLE "transfers" ADD COLUMN "refunded_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD COLUMN "reversal_of" bigint;
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversal_of") REFERENCES "transfers" ("id");
+
+ALTER TABLE "transfers" ADD CONSTRAINT "transfers_refunded_amount_check" CHECK ("refunded_amount" >= 0 AND "refunded_amount" <= "amount");
+
+CREATE INDEX ON "transfers" ("reversal_of");
+
+COMMENT ON COLUMN "transfers"."status" IS 'completed, partially_refunded or reversed';
+
+COMMENT ON COLUMN "transfers"."refunded_amount" IS 'total amount of the reversals of the transfer';
+
+COMMENT ON COLUMN "transfers"."reversal_of" IS 'the original transfer if this transfer is a reversal';
@@ -0,0 +1,9 @@
+ALTER TABLE "accounts" ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+ALTER TABLE "accounts" ADD COLUMN "closed_at" timestamptz;
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed';
@@ -0,0 +1,19 @@
+ALTER TABLE "sessions" ADD COLUMN "family_id" uuid;
+
+UPDATE "sessions" SET "family_id" = "id";
+
+ALTER TABLE "sessions" ALTER COLUMN "family_id" SET NOT NULL;
+
+ALTER TABLE "sessions" ADD COLUMN "parent_id" uuid;
+
+ALTER TABLE "sessions" ADD COLUMN "used_at" timestamptz;
+
+ALTER TABLE "sessions" ADD FOREIGN KEY ("parent_id") REFERENCES "sessions" ("id");
+
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "sessions" ("username");
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the session created at login, shared by every rotation of it';
+
+COMMENT ON COLUMN "sessions"."used_at" IS 'when the refresh token is rotated, it can not be used again';
@@ -0,0 +1,3 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'depositor';
+
+COMMENT ON COLUMN "users"."role" IS 'depositor or admin';
@@ -0,0 +1,19 @@
+CREATE TABLE "admin_audit_logs" (
+  "id" bigserial PRIMARY KEY,
+  "admin_username" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "reason" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "admin_audit_logs" ADD FOREIGN KEY ("admin_username") REFERENCES "users" ("username");
+
+CREATE INDEX ON "admin_audit_logs" ("admin_username");
+
+CREATE INDEX ON "admin_audit_logs" ("target_type", "target_id");
+
+COMMENT ON COLUMN "admin_audit_logs"."target_type" IS 'user, account or session';
+
+COMMENT ON COLUMN "admin_audit_logs"."reason" IS 'required for actions which change data';
@@ -0,0 +1,36 @@
+CREATE TABLE "audit_events" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "channel" varchar NOT NULL,
+  "client_ip" varchar NOT NULL DEFAULT '',
+  "user_agent" varchar NOT NULL DEFAULT '',
+  "action" varchar NOT NULL,
+  "target_type" varchar NOT NULL,
+  "target_id" varchar NOT NULL,
+  "details" text NOT NULL,
+  "prev_hash" varchar NOT NULL,
+  "hash" varchar UNIQUE NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_events" ("target_type", "target_id");
+
+COMMENT ON COLUMN "audit_events"."channel" IS 'http, grpc, gateway or scheduler';
+
+COMMENT ON COLUMN "audit_events"."details" IS 'result of the mutation as JSON';
+
+COMMENT ON COLUMN "audit_events"."prev_hash" IS 'hash of the previous event, empty for the first one';
+
+COMMENT ON COLUMN "audit_events"."hash" IS 'sha256 of the event with prev_hash';
+
+CREATE FUNCTION reject_audit_event_change() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_events_no_update_or_delete" BEFORE UPDATE OR DELETE ON "audit_events"
+  FOR EACH ROW EXECUTE FUNCTION reject_audit_event_change();
+
+CREATE TRIGGER "audit_events_no_truncate" BEFORE TRUNCATE ON "audit_events"
+  FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_event_change();
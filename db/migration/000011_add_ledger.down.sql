@@ -0,0 +1,21 @@
+DROP TRIGGER IF EXISTS "entries_balanced" ON "entries";
+
+DROP FUNCTION IF EXISTS check_journal_balanced;
+
+DELETE FROM "entries" WHERE "account_id" IN (SELECT "id" FROM "accounts" WHERE "system_purpose" IS NOT NULL);
+
+DELETE FROM "accounts" WHERE "system_purpose" IS NOT NULL;
+
+DELETE FROM "users" WHERE "username" = 'bank_system';
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "journal_id";
+
+DROP TABLE IF EXISTS journal_transactions CASCADE;
+
+DROP INDEX IF EXISTS "system_purpose_currency_key";
+
+DROP INDEX IF EXISTS "owner_currency_key";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "system_purpose";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
@@ -0,0 +1,61 @@
+CREATE TABLE "journal_transactions" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "journal_transactions"."kind" IS 'transfer, fx_transfer, reversal, deposit, withdrawal or adjustment';
+
+ALTER TABLE "entries" ADD COLUMN "journal_id" bigint;
+
+ALTER TABLE "entries" ADD FOREIGN KEY ("journal_id") REFERENCES "journal_transactions" ("id");
+
+CREATE INDEX ON "entries" ("journal_id");
+
+COMMENT ON COLUMN "entries"."journal_id" IS 'null for the entries which were written before the ledger';
+
+ALTER TABLE "accounts" ADD COLUMN "system_purpose" varchar;
+
+COMMENT ON COLUMN "accounts"."system_purpose" IS 'cash_in, cash_out, fees, fx or adjustment for system accounts, null for customer accounts';
+
+-- system accounts of the same purpose can't share a currency, but they share their owner
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed' AND "system_purpose" IS NULL;
+
+CREATE UNIQUE INDEX "system_purpose_currency_key" ON "accounts" ("system_purpose", "currency") WHERE "system_purpose" IS NOT NULL;
+
+-- system user can't log in, since it has no password
+INSERT INTO "users" ("username", "hashed_password", "full_name", "email")
+VALUES ('bank_system', '', 'Cactus Bank', 'system@cactus.bank');
+
+INSERT INTO "accounts" ("owner", "balance", "currency", "system_purpose")
+SELECT 'bank_system', 0, currency, purpose
+FROM unnest(ARRAY['USD', 'EUR', 'CAD']) AS currency
+CROSS JOIN unnest(ARRAY['cash_in', 'cash_out', 'fees', 'fx', 'adjustment']) AS purpose;
+
+-- entries of a journal transaction must sum to zero in each currency when the transaction commits
+CREATE FUNCTION check_journal_balanced() RETURNS trigger AS $$
+BEGIN
+  IF NEW.journal_id IS NULL THEN
+    RAISE EXCEPTION 'entry % must belong to a journal transaction', NEW.id;
+  END IF;
+
+  IF EXISTS (
+    SELECT 1
+    FROM entries
+    JOIN accounts ON accounts.id = entries.account_id
+    WHERE entries.journal_id = NEW.journal_id
+    GROUP BY accounts.currency
+    HAVING sum(entries.amount) <> 0
+  ) THEN
+    RAISE EXCEPTION 'journal transaction % is not balanced', NEW.journal_id;
+  END IF;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER "entries_balanced" AFTER INSERT OR UPDATE ON "entries"
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE FUNCTION check_journal_balanced();
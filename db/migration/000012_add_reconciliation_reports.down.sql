@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS reconciliation_reports CASCADE;
+
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "journal_id";
@@ -0,0 +1,18 @@
+ALTER TABLE "transfers" ADD COLUMN "journal_id" bigint;
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("journal_id") REFERENCES "journal_transactions" ("id");
+
+COMMENT ON COLUMN "transfers"."journal_id" IS 'journal transaction of the entries, null for the transfers which were made before the ledger';
+
+CREATE TABLE "reconciliation_reports" (
+  "id" bigserial PRIMARY KEY,
+  "accounts_checked" bigint NOT NULL,
+  "transfers_checked" bigint NOT NULL,
+  "currencies_checked" bigint NOT NULL,
+  "discrepancy_count" bigint NOT NULL,
+  "discrepancies" jsonb NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+COMMENT ON COLUMN "reconciliation_reports"."discrepancies" IS 'invariants which don''t hold, empty if the ledger is reconciled';
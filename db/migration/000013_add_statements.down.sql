@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS statements CASCADE;
+
+DROP INDEX IF EXISTS "transfers_journal_id_idx";
@@ -0,0 +1,22 @@
+CREATE TABLE "statements" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "period_start" timestamptz NOT NULL,
+  "period_end" timestamptz NOT NULL,
+  "opening_balance" bigint NOT NULL,
+  "closing_balance" bigint NOT NULL,
+  "file_name" varchar NOT NULL,
+  "content" bytea NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "statements" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+CREATE UNIQUE INDEX ON "statements" ("account_id", "period_start");
+
+COMMENT ON COLUMN "statements"."period_end" IS 'exclusive, the first moment of the next period';
+
+COMMENT ON COLUMN "statements"."content" IS 'rendered PDF of the statement';
+
+-- statements find the counterparties of the entries through the transfers of their journal transactions
+CREATE INDEX ON "transfers" ("journal_id");
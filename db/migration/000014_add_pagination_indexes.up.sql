@@ -0,0 +1,8 @@
+-- list queries page through the rows of an owner or an account by their IDs
+CREATE INDEX ON "accounts" ("owner", "id");
+
+CREATE INDEX ON "entries" ("account_id", "id");
+
+CREATE INDEX ON "transfers" ("from_account_id", "id");
+
+CREATE INDEX ON "transfers" ("to_account_id", "id");
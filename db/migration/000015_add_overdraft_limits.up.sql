@@ -0,0 +1,4 @@
+-- accounts can go below zero down to their overdraft limit, which is zero unless an admin sets it
+ALTER TABLE "accounts" ADD COLUMN "overdraft_limit" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_overdraft_limit_check" CHECK ("overdraft_limit" >= 0);
@@ -0,0 +1,7 @@
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "available_balance";
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "accounts_held_amount_check";
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "held_amount";
+
+DROP TABLE IF EXISTS holds CASCADE;
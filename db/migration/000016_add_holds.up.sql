@@ -0,0 +1,38 @@
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "holds_amount_check" CHECK ("amount" > 0 AND "captured_amount" BETWEEN 0 AND "amount")
+);
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+CREATE INDEX ON "holds" ("from_account_id", "id");
+
+CREATE INDEX ON "holds" ("to_account_id", "id");
+
+-- expiry sweeper only looks for the pending holds
+CREATE INDEX ON "holds" ("expires_at") WHERE "status" = 'pending';
+
+COMMENT ON COLUMN "holds"."status" IS 'pending, captured, voided or expired';
+
+COMMENT ON COLUMN "holds"."captured_amount" IS 'amount which is transferred on capture, the rest of the hold is released';
+
+-- held amount is the sum of the pending holds of the account, it is reserved but still in the ledger balance.
+-- available balance is what the account can spend, it is kept by the DB so it never drifts from the balance
+ALTER TABLE "accounts" ADD COLUMN "held_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "accounts" ADD CONSTRAINT "accounts_held_amount_check" CHECK ("held_amount" >= 0);
+
+ALTER TABLE "accounts" ADD COLUMN "available_balance" bigint NOT NULL GENERATED ALWAYS AS ("balance" - "held_amount") STORED;
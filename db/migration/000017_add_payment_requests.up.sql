@@ -0,0 +1,49 @@
+CREATE TABLE "payment_requests" (
+  "id" bigserial PRIMARY KEY,
+  "requester" varchar NOT NULL,
+  "payer" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "transfer_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "payment_requests_amount_check" CHECK ("amount" > 0),
+  CONSTRAINT "payment_requests_payer_check" CHECK ("payer" <> "requester")
+);
+
+CREATE TABLE "payment_request_events" (
+  "id" bigserial PRIMARY KEY,
+  "payment_request_id" bigint NOT NULL,
+  "status" varchar NOT NULL,
+  "actor" varchar,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "payment_request_events" ADD FOREIGN KEY ("payment_request_id") REFERENCES "payment_requests" ("id");
+
+CREATE INDEX ON "payment_requests" ("requester", "id");
+
+CREATE INDEX ON "payment_requests" ("payer", "id");
+
+-- expirer only looks for the pending requests
+CREATE INDEX ON "payment_requests" ("expires_at") WHERE "status" = 'pending';
+
+CREATE INDEX ON "payment_request_events" ("payment_request_id", "id");
+
+COMMENT ON COLUMN "payment_requests"."requester" IS 'user who asks for the money, it is paid into to_account_id';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted, declined, cancelled or expired';
+
+COMMENT ON COLUMN "payment_request_events"."actor" IS 'user who changed the status, null when the request expired';
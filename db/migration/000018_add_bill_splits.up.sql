@@ -0,0 +1,28 @@
+CREATE TABLE "bill_splits" (
+  "id" bigserial PRIMARY KEY,
+  "initiator" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "total_amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "rule" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CONSTRAINT "bill_splits_total_amount_check" CHECK ("total_amount" > 0)
+);
+
+ALTER TABLE "bill_splits" ADD FOREIGN KEY ("initiator") REFERENCES "users" ("username");
+
+ALTER TABLE "bill_splits" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+CREATE INDEX ON "bill_splits" ("initiator", "id");
+
+COMMENT ON COLUMN "bill_splits"."rule" IS 'equal, exact or percentage';
+
+COMMENT ON COLUMN "bill_splits"."to_account_id" IS 'account of the initiator which the payment requests of the split are paid into';
+
+-- each participant except the initiator is asked for its share with a payment request of the split
+ALTER TABLE "payment_requests" ADD COLUMN "split_id" bigint;
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("split_id") REFERENCES "bill_splits" ("id");
+
+CREATE INDEX ON "payment_requests" ("split_id") WHERE "split_id" IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE "accounts" ADD COLUMN "frozen_by" varchar;
+
+ALTER TABLE "accounts" ADD FOREIGN KEY ("frozen_by") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "accounts"."frozen_by" IS 'user who froze the account, null unless it is frozen';
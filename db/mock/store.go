@@ -7,6 +7,7 @@ package mockdb
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -36,258 +37,1875 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AcceptPaymentRequestTx mocks base method.
+func (m *MockStore) AcceptPaymentRequestTx(arg0 context.Context, arg1 db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptPaymentRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AcceptPaymentRequestTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptPaymentRequestTx indicates an expected call of AcceptPaymentRequestTx.
+func (mr *MockStoreMockRecorder) AcceptPaymentRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptPaymentRequestTx", reflect.TypeOf((*MockStore)(nil).AcceptPaymentRequestTx), arg0, arg1)
+}
+
 // AddAccountBalance mocks base method.
 func (m *MockStore) AddAccountBalance(arg0 context.Context, arg1 db.AddAccountBalanceParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddAccountBalance", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "AddAccountBalance", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountBalance indicates an expected call of AddAccountBalance.
+func (mr *MockStoreMockRecorder) AddAccountBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), arg0, arg1)
+}
+
+// AddAccountHeldAmount mocks base method.
+func (m *MockStore) AddAccountHeldAmount(arg0 context.Context, arg1 db.AddAccountHeldAmountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountHeldAmount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountHeldAmount indicates an expected call of AddAccountHeldAmount.
+func (mr *MockStoreMockRecorder) AddAccountHeldAmount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountHeldAmount", reflect.TypeOf((*MockStore)(nil).AddAccountHeldAmount), arg0, arg1)
+}
+
+// AddTransferRefund mocks base method.
+func (m *MockStore) AddTransferRefund(arg0 context.Context, arg1 db.AddTransferRefundParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransferRefund", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransferRefund indicates an expected call of AddTransferRefund.
+func (mr *MockStoreMockRecorder) AddTransferRefund(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferRefund", reflect.TypeOf((*MockStore)(nil).AddTransferRefund), arg0, arg1)
+}
+
+// AdjustBalanceTx mocks base method.
+func (m *MockStore) AdjustBalanceTx(arg0 context.Context, arg1 db.AdjustBalanceTxParams) (db.AdjustBalanceTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustBalanceTx", arg0, arg1)
+	ret0, _ := ret[0].(db.AdjustBalanceTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustBalanceTx indicates an expected call of AdjustBalanceTx.
+func (mr *MockStoreMockRecorder) AdjustBalanceTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustBalanceTx", reflect.TypeOf((*MockStore)(nil).AdjustBalanceTx), arg0, arg1)
+}
+
+// BlockOtherSessions mocks base method.
+func (m *MockStore) BlockOtherSessions(arg0 context.Context, arg1 db.BlockOtherSessionsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOtherSessions", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockOtherSessions indicates an expected call of BlockOtherSessions.
+func (mr *MockStoreMockRecorder) BlockOtherSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOtherSessions", reflect.TypeOf((*MockStore)(nil).BlockOtherSessions), arg0, arg1)
+}
+
+// BlockSession mocks base method.
+func (m *MockStore) BlockSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSession indicates an expected call of BlockSession.
+func (mr *MockStoreMockRecorder) BlockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSession", reflect.TypeOf((*MockStore)(nil).BlockSession), arg0, arg1)
+}
+
+// BlockSessionFamily mocks base method.
+func (m *MockStore) BlockSessionFamily(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockSessionFamily", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockSessionFamily indicates an expected call of BlockSessionFamily.
+func (mr *MockStoreMockRecorder) BlockSessionFamily(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockSessionFamily", reflect.TypeOf((*MockStore)(nil).BlockSessionFamily), arg0, arg1)
+}
+
+// CancelPaymentRequestTx mocks base method.
+func (m *MockStore) CancelPaymentRequestTx(arg0 context.Context, arg1 db.ClosePaymentRequestTxParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPaymentRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelPaymentRequestTx indicates an expected call of CancelPaymentRequestTx.
+func (mr *MockStoreMockRecorder) CancelPaymentRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPaymentRequestTx", reflect.TypeOf((*MockStore)(nil).CancelPaymentRequestTx), arg0, arg1)
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockStore) CancelScheduledTransfer(arg0 context.Context, arg1 int64) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockStoreMockRecorder) CancelScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockStore)(nil).CancelScheduledTransfer), arg0, arg1)
+}
+
+// CaptureHoldTx mocks base method.
+func (m *MockStore) CaptureHoldTx(arg0 context.Context, arg1 db.CaptureHoldTxParams) (db.CaptureHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CaptureHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHoldTx indicates an expected call of CaptureHoldTx.
+func (mr *MockStoreMockRecorder) CaptureHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHoldTx", reflect.TypeOf((*MockStore)(nil).CaptureHoldTx), arg0, arg1)
+}
+
+// Close mocks base method.
+func (m *MockStore) Close(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockStoreMockRecorder) Close(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockStore)(nil).Close), arg0)
+}
+
+// CloseAccount mocks base method.
+func (m *MockStore) CloseAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccount indicates an expected call of CloseAccount.
+func (mr *MockStoreMockRecorder) CloseAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockStore)(nil).CloseAccount), arg0, arg1)
+}
+
+// CloseAccountTx mocks base method.
+func (m *MockStore) CloseAccountTx(arg0 context.Context, arg1 db.CloseAccountTxParams) (db.CloseAccountTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccountTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CloseAccountTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAccountTx indicates an expected call of CloseAccountTx.
+func (mr *MockStoreMockRecorder) CloseAccountTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccountTx", reflect.TypeOf((*MockStore)(nil).CloseAccountTx), arg0, arg1)
+}
+
+// CloseHold mocks base method.
+func (m *MockStore) CloseHold(arg0 context.Context, arg1 db.CloseHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseHold indicates an expected call of CloseHold.
+func (mr *MockStoreMockRecorder) CloseHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseHold", reflect.TypeOf((*MockStore)(nil).CloseHold), arg0, arg1)
+}
+
+// CountAccounts mocks base method.
+func (m *MockStore) CountAccounts(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAccounts", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAccounts indicates an expected call of CountAccounts.
+func (mr *MockStoreMockRecorder) CountAccounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAccounts", reflect.TypeOf((*MockStore)(nil).CountAccounts), arg0)
+}
+
+// CountJournaledTransfers mocks base method.
+func (m *MockStore) CountJournaledTransfers(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountJournaledTransfers", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountJournaledTransfers indicates an expected call of CountJournaledTransfers.
+func (mr *MockStoreMockRecorder) CountJournaledTransfers(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountJournaledTransfers", reflect.TypeOf((*MockStore)(nil).CountJournaledTransfers), arg0)
+}
+
+// CreateAccount mocks base method.
+func (m *MockStore) CreateAccount(arg0 context.Context, arg1 db.CreateAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockStoreMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), arg0, arg1)
+}
+
+// CreateAdminAuditLog mocks base method.
+func (m *MockStore) CreateAdminAuditLog(arg0 context.Context, arg1 db.CreateAdminAuditLogParams) (db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdminAuditLog", arg0, arg1)
+	ret0, _ := ret[0].(db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdminAuditLog indicates an expected call of CreateAdminAuditLog.
+func (mr *MockStoreMockRecorder) CreateAdminAuditLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdminAuditLog", reflect.TypeOf((*MockStore)(nil).CreateAdminAuditLog), arg0, arg1)
+}
+
+// CreateAuditEvent mocks base method.
+func (m *MockStore) CreateAuditEvent(arg0 context.Context, arg1 db.CreateAuditEventParams) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuditEvent indicates an expected call of CreateAuditEvent.
+func (mr *MockStoreMockRecorder) CreateAuditEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditEvent", reflect.TypeOf((*MockStore)(nil).CreateAuditEvent), arg0, arg1)
+}
+
+// CreateBillSplit mocks base method.
+func (m *MockStore) CreateBillSplit(arg0 context.Context, arg1 db.CreateBillSplitParams) (db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBillSplit", arg0, arg1)
+	ret0, _ := ret[0].(db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBillSplit indicates an expected call of CreateBillSplit.
+func (mr *MockStoreMockRecorder) CreateBillSplit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBillSplit", reflect.TypeOf((*MockStore)(nil).CreateBillSplit), arg0, arg1)
+}
+
+// CreateBillSplitTx mocks base method.
+func (m *MockStore) CreateBillSplitTx(arg0 context.Context, arg1 db.CreateBillSplitTxParams) (db.BillSplitDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBillSplitTx", arg0, arg1)
+	ret0, _ := ret[0].(db.BillSplitDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBillSplitTx indicates an expected call of CreateBillSplitTx.
+func (mr *MockStoreMockRecorder) CreateBillSplitTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBillSplitTx", reflect.TypeOf((*MockStore)(nil).CreateBillSplitTx), arg0, arg1)
+}
+
+// CreateEntry mocks base method.
+func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+}
+
+// CreateFxQuote mocks base method.
+func (m *MockStore) CreateFxQuote(arg0 context.Context, arg1 db.CreateFxQuoteParams) (db.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFxQuote", arg0, arg1)
+	ret0, _ := ret[0].(db.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFxQuote indicates an expected call of CreateFxQuote.
+func (mr *MockStoreMockRecorder) CreateFxQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFxQuote", reflect.TypeOf((*MockStore)(nil).CreateFxQuote), arg0, arg1)
+}
+
+// CreateFxTransfer mocks base method.
+func (m *MockStore) CreateFxTransfer(arg0 context.Context, arg1 db.CreateFxTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFxTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFxTransfer indicates an expected call of CreateFxTransfer.
+func (mr *MockStoreMockRecorder) CreateFxTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFxTransfer", reflect.TypeOf((*MockStore)(nil).CreateFxTransfer), arg0, arg1)
+}
+
+// CreateHold mocks base method.
+func (m *MockStore) CreateHold(arg0 context.Context, arg1 db.CreateHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockStoreMockRecorder) CreateHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockStore)(nil).CreateHold), arg0, arg1)
+}
+
+// CreateHoldTx mocks base method.
+func (m *MockStore) CreateHoldTx(arg0 context.Context, arg1 db.CreateHoldTxParams) (db.CreateHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHoldTx indicates an expected call of CreateHoldTx.
+func (mr *MockStoreMockRecorder) CreateHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoldTx", reflect.TypeOf((*MockStore)(nil).CreateHoldTx), arg0, arg1)
+}
+
+// CreateIdempotencyKey mocks base method.
+func (m *MockStore) CreateIdempotencyKey(arg0 context.Context, arg1 db.CreateIdempotencyKeyParams) (db.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(db.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIdempotencyKey indicates an expected call of CreateIdempotencyKey.
+func (mr *MockStoreMockRecorder) CreateIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKey", reflect.TypeOf((*MockStore)(nil).CreateIdempotencyKey), arg0, arg1)
+}
+
+// CreateJournalTransaction mocks base method.
+func (m *MockStore) CreateJournalTransaction(arg0 context.Context, arg1 string) (db.JournalTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournalTransaction", arg0, arg1)
+	ret0, _ := ret[0].(db.JournalTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJournalTransaction indicates an expected call of CreateJournalTransaction.
+func (mr *MockStoreMockRecorder) CreateJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournalTransaction", reflect.TypeOf((*MockStore)(nil).CreateJournalTransaction), arg0, arg1)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockStore) CreatePaymentRequest(arg0 context.Context, arg1 db.CreatePaymentRequestParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockStoreMockRecorder) CreatePaymentRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequest), arg0, arg1)
+}
+
+// CreatePaymentRequestEvent mocks base method.
+func (m *MockStore) CreatePaymentRequestEvent(arg0 context.Context, arg1 db.CreatePaymentRequestEventParams) (db.PaymentRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequestEvent", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequestEvent indicates an expected call of CreatePaymentRequestEvent.
+func (mr *MockStoreMockRecorder) CreatePaymentRequestEvent(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequestEvent", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequestEvent), arg0, arg1)
+}
+
+// CreatePaymentRequestTx mocks base method.
+func (m *MockStore) CreatePaymentRequestTx(arg0 context.Context, arg1 db.CreatePaymentRequestTxParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequestTx indicates an expected call of CreatePaymentRequestTx.
+func (mr *MockStoreMockRecorder) CreatePaymentRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).CreatePaymentRequestTx), arg0, arg1)
+}
+
+// CreateReconciliationReport mocks base method.
+func (m *MockStore) CreateReconciliationReport(arg0 context.Context, arg1 db.CreateReconciliationReportParams) (db.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReconciliationReport", arg0, arg1)
+	ret0, _ := ret[0].(db.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReconciliationReport indicates an expected call of CreateReconciliationReport.
+func (mr *MockStoreMockRecorder) CreateReconciliationReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReconciliationReport", reflect.TypeOf((*MockStore)(nil).CreateReconciliationReport), arg0, arg1)
+}
+
+// CreateReversalTransfer mocks base method.
+func (m *MockStore) CreateReversalTransfer(arg0 context.Context, arg1 db.CreateReversalTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReversalTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReversalTransfer indicates an expected call of CreateReversalTransfer.
+func (mr *MockStoreMockRecorder) CreateReversalTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReversalTransfer", reflect.TypeOf((*MockStore)(nil).CreateReversalTransfer), arg0, arg1)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockStore) CreateScheduledTransfer(arg0 context.Context, arg1 db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockStoreMockRecorder) CreateScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransfer), arg0, arg1)
+}
+
+// CreateScheduledTransferExecution mocks base method.
+func (m *MockStore) CreateScheduledTransferExecution(arg0 context.Context, arg1 db.CreateScheduledTransferExecutionParams) (db.ScheduledTransferExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransferExecution", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransferExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransferExecution indicates an expected call of CreateScheduledTransferExecution.
+func (mr *MockStoreMockRecorder) CreateScheduledTransferExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransferExecution", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransferExecution), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateStatement mocks base method.
+func (m *MockStore) CreateStatement(arg0 context.Context, arg1 db.CreateStatementParams) (db.CreateStatementRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStatement", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateStatementRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStatement indicates an expected call of CreateStatement.
+func (mr *MockStoreMockRecorder) CreateStatement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStatement", reflect.TypeOf((*MockStore)(nil).CreateStatement), arg0, arg1)
+}
+
+// CreateTransfer mocks base method.
+func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+}
+
+// CreateUser mocks base method.
+func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+}
+
+// DeclinePaymentRequestTx mocks base method.
+func (m *MockStore) DeclinePaymentRequestTx(arg0 context.Context, arg1 db.ClosePaymentRequestTxParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclinePaymentRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclinePaymentRequestTx indicates an expected call of DeclinePaymentRequestTx.
+func (mr *MockStoreMockRecorder) DeclinePaymentRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclinePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).DeclinePaymentRequestTx), arg0, arg1)
+}
+
+// DeferScheduledTransferRun mocks base method.
+func (m *MockStore) DeferScheduledTransferRun(arg0 context.Context, arg1 db.DeferScheduledTransferRunParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeferScheduledTransferRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeferScheduledTransferRun indicates an expected call of DeferScheduledTransferRun.
+func (mr *MockStoreMockRecorder) DeferScheduledTransferRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeferScheduledTransferRun", reflect.TypeOf((*MockStore)(nil).DeferScheduledTransferRun), arg0, arg1)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+}
+
+// EntryTx mocks base method.
+func (m *MockStore) EntryTx(arg0 context.Context, arg1 db.EntryTxParams) (db.EntryTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EntryTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EntryTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EntryTx indicates an expected call of EntryTx.
+func (mr *MockStoreMockRecorder) EntryTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntryTx", reflect.TypeOf((*MockStore)(nil).EntryTx), arg0, arg1)
+}
+
+// ExecuteScheduledTransferTx mocks base method.
+func (m *MockStore) ExecuteScheduledTransferTx(arg0 context.Context, arg1 time.Time) (db.ExecuteScheduledTransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteScheduledTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ExecuteScheduledTransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteScheduledTransferTx indicates an expected call of ExecuteScheduledTransferTx.
+func (mr *MockStoreMockRecorder) ExecuteScheduledTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduledTransferTx", reflect.TypeOf((*MockStore)(nil).ExecuteScheduledTransferTx), arg0, arg1)
+}
+
+// ExpireHoldTx mocks base method.
+func (m *MockStore) ExpireHoldTx(arg0 context.Context, arg1 time.Time) (db.ReleaseHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReleaseHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHoldTx indicates an expected call of ExpireHoldTx.
+func (mr *MockStoreMockRecorder) ExpireHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHoldTx", reflect.TypeOf((*MockStore)(nil).ExpireHoldTx), arg0, arg1)
+}
+
+// ExpirePaymentRequestTx mocks base method.
+func (m *MockStore) ExpirePaymentRequestTx(arg0 context.Context, arg1 time.Time) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePaymentRequestTx", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePaymentRequestTx indicates an expected call of ExpirePaymentRequestTx.
+func (mr *MockStoreMockRecorder) ExpirePaymentRequestTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePaymentRequestTx", reflect.TypeOf((*MockStore)(nil).ExpirePaymentRequestTx), arg0, arg1)
+}
+
+// FreezeAccount mocks base method.
+func (m *MockStore) FreezeAccount(arg0 context.Context, arg1 db.FreezeAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccount indicates an expected call of FreezeAccount.
+func (mr *MockStoreMockRecorder) FreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccount", reflect.TypeOf((*MockStore)(nil).FreezeAccount), arg0, arg1)
+}
+
+// FreezeAccountTx mocks base method.
+func (m *MockStore) FreezeAccountTx(arg0 context.Context, arg1 db.FreezeAccountTxParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FreezeAccountTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FreezeAccountTx indicates an expected call of FreezeAccountTx.
+func (mr *MockStoreMockRecorder) FreezeAccountTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeAccountTx", reflect.TypeOf((*MockStore)(nil).FreezeAccountTx), arg0, arg1)
+}
+
+// FxTransferTx mocks base method.
+func (m *MockStore) FxTransferTx(arg0 context.Context, arg1 db.FxTransferTxParams) (db.TransferTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FxTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FxTransferTx indicates an expected call of FxTransferTx.
+func (mr *MockStoreMockRecorder) FxTransferTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FxTransferTx", reflect.TypeOf((*MockStore)(nil).FxTransferTx), arg0, arg1)
+}
+
+// GetAccount mocks base method.
+func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+}
+
+// GetAccountBalanceAt mocks base method.
+func (m *MockStore) GetAccountBalanceAt(arg0 context.Context, arg1 db.GetAccountBalanceAtParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceAt", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceAt indicates an expected call of GetAccountBalanceAt.
+func (mr *MockStoreMockRecorder) GetAccountBalanceAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceAt", reflect.TypeOf((*MockStore)(nil).GetAccountBalanceAt), arg0, arg1)
+}
+
+// GetAccountForUpdate mocks base method.
+func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
+func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+}
+
+// GetBillSplit mocks base method.
+func (m *MockStore) GetBillSplit(arg0 context.Context, arg1 int64) (db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillSplit", arg0, arg1)
+	ret0, _ := ret[0].(db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillSplit indicates an expected call of GetBillSplit.
+func (mr *MockStoreMockRecorder) GetBillSplit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillSplit", reflect.TypeOf((*MockStore)(nil).GetBillSplit), arg0, arg1)
+}
+
+// GetDueScheduledTransferForUpdate mocks base method.
+func (m *MockStore) GetDueScheduledTransferForUpdate(arg0 context.Context, arg1 time.Time) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduledTransferForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduledTransferForUpdate indicates an expected call of GetDueScheduledTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetDueScheduledTransferForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduledTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetDueScheduledTransferForUpdate), arg0, arg1)
+}
+
+// GetEntry mocks base method.
+func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+}
+
+// GetExpiredHoldForUpdate mocks base method.
+func (m *MockStore) GetExpiredHoldForUpdate(arg0 context.Context, arg1 time.Time) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredHoldForUpdate indicates an expected call of GetExpiredHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetExpiredHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetExpiredHoldForUpdate), arg0, arg1)
+}
+
+// GetExpiredPaymentRequestForUpdate mocks base method.
+func (m *MockStore) GetExpiredPaymentRequestForUpdate(arg0 context.Context, arg1 time.Time) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredPaymentRequestForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredPaymentRequestForUpdate indicates an expected call of GetExpiredPaymentRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetExpiredPaymentRequestForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredPaymentRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetExpiredPaymentRequestForUpdate), arg0, arg1)
+}
+
+// GetFxQuote mocks base method.
+func (m *MockStore) GetFxQuote(arg0 context.Context, arg1 uuid.UUID) (db.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFxQuote", arg0, arg1)
+	ret0, _ := ret[0].(db.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFxQuote indicates an expected call of GetFxQuote.
+func (mr *MockStoreMockRecorder) GetFxQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFxQuote", reflect.TypeOf((*MockStore)(nil).GetFxQuote), arg0, arg1)
+}
+
+// GetFxRate mocks base method.
+func (m *MockStore) GetFxRate(arg0 context.Context, arg1 db.GetFxRateParams) (db.FxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFxRate", arg0, arg1)
+	ret0, _ := ret[0].(db.FxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFxRate indicates an expected call of GetFxRate.
+func (mr *MockStoreMockRecorder) GetFxRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFxRate", reflect.TypeOf((*MockStore)(nil).GetFxRate), arg0, arg1)
+}
+
+// GetHold mocks base method.
+func (m *MockStore) GetHold(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockStoreMockRecorder) GetHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockStore)(nil).GetHold), arg0, arg1)
+}
+
+// GetHoldForUpdate mocks base method.
+func (m *MockStore) GetHoldForUpdate(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldForUpdate indicates an expected call of GetHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetHoldForUpdate), arg0, arg1)
+}
+
+// GetIdempotencyKeyForUpdate mocks base method.
+func (m *MockStore) GetIdempotencyKeyForUpdate(arg0 context.Context, arg1 db.GetIdempotencyKeyForUpdateParams) (db.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKeyForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKeyForUpdate indicates an expected call of GetIdempotencyKeyForUpdate.
+func (mr *MockStoreMockRecorder) GetIdempotencyKeyForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKeyForUpdate", reflect.TypeOf((*MockStore)(nil).GetIdempotencyKeyForUpdate), arg0, arg1)
+}
+
+// GetJournalTransaction mocks base method.
+func (m *MockStore) GetJournalTransaction(arg0 context.Context, arg1 int64) (db.JournalTransaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalTransaction", arg0, arg1)
+	ret0, _ := ret[0].(db.JournalTransaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalTransaction indicates an expected call of GetJournalTransaction.
+func (mr *MockStoreMockRecorder) GetJournalTransaction(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalTransaction", reflect.TypeOf((*MockStore)(nil).GetJournalTransaction), arg0, arg1)
+}
+
+// GetLastAuditEvent mocks base method.
+func (m *MockStore) GetLastAuditEvent(arg0 context.Context) (db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastAuditEvent", arg0)
+	ret0, _ := ret[0].(db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastAuditEvent indicates an expected call of GetLastAuditEvent.
+func (mr *MockStoreMockRecorder) GetLastAuditEvent(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAuditEvent", reflect.TypeOf((*MockStore)(nil).GetLastAuditEvent), arg0)
+}
+
+// GetLatestReconciliationReport mocks base method.
+func (m *MockStore) GetLatestReconciliationReport(arg0 context.Context) (db.ReconciliationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestReconciliationReport", arg0)
+	ret0, _ := ret[0].(db.ReconciliationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestReconciliationReport indicates an expected call of GetLatestReconciliationReport.
+func (mr *MockStoreMockRecorder) GetLatestReconciliationReport(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReconciliationReport", reflect.TypeOf((*MockStore)(nil).GetLatestReconciliationReport), arg0)
+}
+
+// GetOwnerAccount mocks base method.
+func (m *MockStore) GetOwnerAccount(arg0 context.Context, arg1 db.GetOwnerAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnerAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnerAccount indicates an expected call of GetOwnerAccount.
+func (mr *MockStoreMockRecorder) GetOwnerAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnerAccount", reflect.TypeOf((*MockStore)(nil).GetOwnerAccount), arg0, arg1)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockStore) GetPaymentRequest(arg0 context.Context, arg1 int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockStoreMockRecorder) GetPaymentRequest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockStore)(nil).GetPaymentRequest), arg0, arg1)
+}
+
+// GetPaymentRequestForUpdate mocks base method.
+func (m *MockStore) GetPaymentRequestForUpdate(arg0 context.Context, arg1 int64) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequestForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequestForUpdate indicates an expected call of GetPaymentRequestForUpdate.
+func (mr *MockStoreMockRecorder) GetPaymentRequestForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequestForUpdate", reflect.TypeOf((*MockStore)(nil).GetPaymentRequestForUpdate), arg0, arg1)
+}
+
+// GetScheduledTransfer mocks base method.
+func (m *MockStore) GetScheduledTransfer(arg0 context.Context, arg1 int64) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTransfer indicates an expected call of GetScheduledTransfer.
+func (mr *MockStoreMockRecorder) GetScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTransfer", reflect.TypeOf((*MockStore)(nil).GetScheduledTransfer), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSessionForUpdate mocks base method.
+func (m *MockStore) GetSessionForUpdate(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionForUpdate indicates an expected call of GetSessionForUpdate.
+func (mr *MockStoreMockRecorder) GetSessionForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionForUpdate", reflect.TypeOf((*MockStore)(nil).GetSessionForUpdate), arg0, arg1)
+}
+
+// GetStatement mocks base method.
+func (m *MockStore) GetStatement(arg0 context.Context, arg1 db.GetStatementParams) (db.Statement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", arg0, arg1)
+	ret0, _ := ret[0].(db.Statement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockStoreMockRecorder) GetStatement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockStore)(nil).GetStatement), arg0, arg1)
+}
+
+// GetSystemAccount mocks base method.
+func (m *MockStore) GetSystemAccount(arg0 context.Context, arg1 db.GetSystemAccountParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemAccount indicates an expected call of GetSystemAccount.
+func (mr *MockStoreMockRecorder) GetSystemAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemAccount", reflect.TypeOf((*MockStore)(nil).GetSystemAccount), arg0, arg1)
+}
+
+// GetTransfer mocks base method.
+func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+}
+
+// GetTransferForUpdate mocks base method.
+func (m *MockStore) GetTransferForUpdate(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferForUpdate indicates an expected call of GetTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferForUpdate), arg0, arg1)
+}
+
+// GetUser mocks base method.
+func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+}
+
+// GetUserByUsernameOrEmail mocks base method.
+func (m *MockStore) GetUserByUsernameOrEmail(arg0 context.Context, arg1 string) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByUsernameOrEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByUsernameOrEmail indicates an expected call of GetUserByUsernameOrEmail.
+func (mr *MockStoreMockRecorder) GetUserByUsernameOrEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsernameOrEmail", reflect.TypeOf((*MockStore)(nil).GetUserByUsernameOrEmail), arg0, arg1)
+}
+
+// ListAccountBalanceMismatches mocks base method.
+func (m *MockStore) ListAccountBalanceMismatches(arg0 context.Context) ([]db.ListAccountBalanceMismatchesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountBalanceMismatches", arg0)
+	ret0, _ := ret[0].([]db.ListAccountBalanceMismatchesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountBalanceMismatches indicates an expected call of ListAccountBalanceMismatches.
+func (mr *MockStoreMockRecorder) ListAccountBalanceMismatches(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountBalanceMismatches", reflect.TypeOf((*MockStore)(nil).ListAccountBalanceMismatches), arg0)
+}
+
+// ListAccounts mocks base method.
+func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+}
+
+// ListAccountsPage mocks base method.
+func (m *MockStore) ListAccountsPage(arg0 context.Context, arg1 db.ListAccountsPageParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsPage", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsPage indicates an expected call of ListAccountsPage.
+func (mr *MockStoreMockRecorder) ListAccountsPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsPage", reflect.TypeOf((*MockStore)(nil).ListAccountsPage), arg0, arg1)
+}
+
+// ListAccountsPageAsc mocks base method.
+func (m *MockStore) ListAccountsPageAsc(arg0 context.Context, arg1 db.ListAccountsPageAscParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsPageAsc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsPageAsc indicates an expected call of ListAccountsPageAsc.
+func (mr *MockStoreMockRecorder) ListAccountsPageAsc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsPageAsc", reflect.TypeOf((*MockStore)(nil).ListAccountsPageAsc), arg0, arg1)
+}
+
+// ListAccountsPageDesc mocks base method.
+func (m *MockStore) ListAccountsPageDesc(arg0 context.Context, arg1 db.ListAccountsPageDescParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsPageDesc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsPageDesc indicates an expected call of ListAccountsPageDesc.
+func (mr *MockStoreMockRecorder) ListAccountsPageDesc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsPageDesc", reflect.TypeOf((*MockStore)(nil).ListAccountsPageDesc), arg0, arg1)
+}
+
+// ListAccountsWithoutStatement mocks base method.
+func (m *MockStore) ListAccountsWithoutStatement(arg0 context.Context, arg1 db.ListAccountsWithoutStatementParams) ([]db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithoutStatement", arg0, arg1)
+	ret0, _ := ret[0].([]db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithoutStatement indicates an expected call of ListAccountsWithoutStatement.
+func (mr *MockStoreMockRecorder) ListAccountsWithoutStatement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithoutStatement", reflect.TypeOf((*MockStore)(nil).ListAccountsWithoutStatement), arg0, arg1)
+}
+
+// ListActiveSessions mocks base method.
+func (m *MockStore) ListActiveSessions(arg0 context.Context, arg1 db.ListActiveSessionsParams) ([]db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSessions", arg0, arg1)
+	ret0, _ := ret[0].([]db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSessions indicates an expected call of ListActiveSessions.
+func (mr *MockStoreMockRecorder) ListActiveSessions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSessions", reflect.TypeOf((*MockStore)(nil).ListActiveSessions), arg0, arg1)
+}
+
+// ListAdminAuditLogs mocks base method.
+func (m *MockStore) ListAdminAuditLogs(arg0 context.Context, arg1 db.ListAdminAuditLogsParams) ([]db.AdminAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdminAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]db.AdminAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdminAuditLogs indicates an expected call of ListAdminAuditLogs.
+func (mr *MockStoreMockRecorder) ListAdminAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdminAuditLogs", reflect.TypeOf((*MockStore)(nil).ListAdminAuditLogs), arg0, arg1)
+}
+
+// ListAuditEventsAfter mocks base method.
+func (m *MockStore) ListAuditEventsAfter(arg0 context.Context, arg1 db.ListAuditEventsAfterParams) ([]db.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEventsAfter", arg0, arg1)
+	ret0, _ := ret[0].([]db.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEventsAfter indicates an expected call of ListAuditEventsAfter.
+func (mr *MockStoreMockRecorder) ListAuditEventsAfter(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEventsAfter", reflect.TypeOf((*MockStore)(nil).ListAuditEventsAfter), arg0, arg1)
+}
+
+// ListBillSplitsPage mocks base method.
+func (m *MockStore) ListBillSplitsPage(arg0 context.Context, arg1 db.ListBillSplitsPageParams) ([]db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillSplitsPage", arg0, arg1)
+	ret0, _ := ret[0].([]db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillSplitsPage indicates an expected call of ListBillSplitsPage.
+func (mr *MockStoreMockRecorder) ListBillSplitsPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillSplitsPage", reflect.TypeOf((*MockStore)(nil).ListBillSplitsPage), arg0, arg1)
+}
+
+// ListBillSplitsPageAsc mocks base method.
+func (m *MockStore) ListBillSplitsPageAsc(arg0 context.Context, arg1 db.ListBillSplitsPageAscParams) ([]db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillSplitsPageAsc", arg0, arg1)
+	ret0, _ := ret[0].([]db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillSplitsPageAsc indicates an expected call of ListBillSplitsPageAsc.
+func (mr *MockStoreMockRecorder) ListBillSplitsPageAsc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillSplitsPageAsc", reflect.TypeOf((*MockStore)(nil).ListBillSplitsPageAsc), arg0, arg1)
+}
+
+// ListBillSplitsPageDesc mocks base method.
+func (m *MockStore) ListBillSplitsPageDesc(arg0 context.Context, arg1 db.ListBillSplitsPageDescParams) ([]db.BillSplit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillSplitsPageDesc", arg0, arg1)
+	ret0, _ := ret[0].([]db.BillSplit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillSplitsPageDesc indicates an expected call of ListBillSplitsPageDesc.
+func (mr *MockStoreMockRecorder) ListBillSplitsPageDesc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillSplitsPageDesc", reflect.TypeOf((*MockStore)(nil).ListBillSplitsPageDesc), arg0, arg1)
+}
+
+// ListCurrencyNetBalances mocks base method.
+func (m *MockStore) ListCurrencyNetBalances(arg0 context.Context) ([]db.ListCurrencyNetBalancesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCurrencyNetBalances", arg0)
+	ret0, _ := ret[0].([]db.ListCurrencyNetBalancesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCurrencyNetBalances indicates an expected call of ListCurrencyNetBalances.
+func (mr *MockStoreMockRecorder) ListCurrencyNetBalances(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCurrencyNetBalances", reflect.TypeOf((*MockStore)(nil).ListCurrencyNetBalances), arg0)
+}
+
+// ListEntries mocks base method.
+func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
+}
+
+// ListEntriesByJournal mocks base method.
+func (m *MockStore) ListEntriesByJournal(arg0 context.Context, arg1 *int64) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesByJournal", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByJournal indicates an expected call of ListEntriesByJournal.
+func (mr *MockStoreMockRecorder) ListEntriesByJournal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByJournal", reflect.TypeOf((*MockStore)(nil).ListEntriesByJournal), arg0, arg1)
+}
+
+// ListEntriesByOwner mocks base method.
+func (m *MockStore) ListEntriesByOwner(arg0 context.Context, arg1 db.ListEntriesByOwnerParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesByOwner", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesByOwner indicates an expected call of ListEntriesByOwner.
+func (mr *MockStoreMockRecorder) ListEntriesByOwner(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesByOwner", reflect.TypeOf((*MockStore)(nil).ListEntriesByOwner), arg0, arg1)
+}
+
+// ListEntriesPage mocks base method.
+func (m *MockStore) ListEntriesPage(arg0 context.Context, arg1 db.ListEntriesPageParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesPage", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesPage indicates an expected call of ListEntriesPage.
+func (mr *MockStoreMockRecorder) ListEntriesPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesPage", reflect.TypeOf((*MockStore)(nil).ListEntriesPage), arg0, arg1)
+}
+
+// ListEntriesPageAsc mocks base method.
+func (m *MockStore) ListEntriesPageAsc(arg0 context.Context, arg1 db.ListEntriesPageAscParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesPageAsc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesPageAsc indicates an expected call of ListEntriesPageAsc.
+func (mr *MockStoreMockRecorder) ListEntriesPageAsc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesPageAsc", reflect.TypeOf((*MockStore)(nil).ListEntriesPageAsc), arg0, arg1)
+}
+
+// ListEntriesPageDesc mocks base method.
+func (m *MockStore) ListEntriesPageDesc(arg0 context.Context, arg1 db.ListEntriesPageDescParams) ([]db.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntriesPageDesc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntriesPageDesc indicates an expected call of ListEntriesPageDesc.
+func (mr *MockStoreMockRecorder) ListEntriesPageDesc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntriesPageDesc", reflect.TypeOf((*MockStore)(nil).ListEntriesPageDesc), arg0, arg1)
+}
+
+// ListPaymentRequestEvents mocks base method.
+func (m *MockStore) ListPaymentRequestEvents(arg0 context.Context, arg1 int64) ([]db.PaymentRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequestEvents", arg0, arg1)
+	ret0, _ := ret[0].([]db.PaymentRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequestEvents indicates an expected call of ListPaymentRequestEvents.
+func (mr *MockStoreMockRecorder) ListPaymentRequestEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequestEvents", reflect.TypeOf((*MockStore)(nil).ListPaymentRequestEvents), arg0, arg1)
+}
+
+// ListPaymentRequestsPage mocks base method.
+func (m *MockStore) ListPaymentRequestsPage(arg0 context.Context, arg1 db.ListPaymentRequestsPageParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequestsPage", arg0, arg1)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequestsPage indicates an expected call of ListPaymentRequestsPage.
+func (mr *MockStoreMockRecorder) ListPaymentRequestsPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequestsPage", reflect.TypeOf((*MockStore)(nil).ListPaymentRequestsPage), arg0, arg1)
+}
+
+// ListPaymentRequestsPageAsc mocks base method.
+func (m *MockStore) ListPaymentRequestsPageAsc(arg0 context.Context, arg1 db.ListPaymentRequestsPageAscParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequestsPageAsc", arg0, arg1)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequestsPageAsc indicates an expected call of ListPaymentRequestsPageAsc.
+func (mr *MockStoreMockRecorder) ListPaymentRequestsPageAsc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequestsPageAsc", reflect.TypeOf((*MockStore)(nil).ListPaymentRequestsPageAsc), arg0, arg1)
+}
+
+// ListPaymentRequestsPageDesc mocks base method.
+func (m *MockStore) ListPaymentRequestsPageDesc(arg0 context.Context, arg1 db.ListPaymentRequestsPageDescParams) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaymentRequestsPageDesc", arg0, arg1)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaymentRequestsPageDesc indicates an expected call of ListPaymentRequestsPageDesc.
+func (mr *MockStoreMockRecorder) ListPaymentRequestsPageDesc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaymentRequestsPageDesc", reflect.TypeOf((*MockStore)(nil).ListPaymentRequestsPageDesc), arg0, arg1)
+}
+
+// ListScheduledTransferExecutions mocks base method.
+func (m *MockStore) ListScheduledTransferExecutions(arg0 context.Context, arg1 db.ListScheduledTransferExecutionsParams) ([]db.ScheduledTransferExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransferExecutions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ScheduledTransferExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransferExecutions indicates an expected call of ListScheduledTransferExecutions.
+func (mr *MockStoreMockRecorder) ListScheduledTransferExecutions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransferExecutions", reflect.TypeOf((*MockStore)(nil).ListScheduledTransferExecutions), arg0, arg1)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockStore) ListScheduledTransfers(arg0 context.Context, arg1 db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockStoreMockRecorder) ListScheduledTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockStore)(nil).ListScheduledTransfers), arg0, arg1)
+}
+
+// ListSplitPaymentRequests mocks base method.
+func (m *MockStore) ListSplitPaymentRequests(arg0 context.Context, arg1 *int64) ([]db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSplitPaymentRequests", arg0, arg1)
+	ret0, _ := ret[0].([]db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSplitPaymentRequests indicates an expected call of ListSplitPaymentRequests.
+func (mr *MockStoreMockRecorder) ListSplitPaymentRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSplitPaymentRequests", reflect.TypeOf((*MockStore)(nil).ListSplitPaymentRequests), arg0, arg1)
+}
+
+// ListStatementEntries mocks base method.
+func (m *MockStore) ListStatementEntries(arg0 context.Context, arg1 db.ListStatementEntriesParams) ([]db.ListStatementEntriesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatementEntries", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListStatementEntriesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatementEntries indicates an expected call of ListStatementEntries.
+func (mr *MockStoreMockRecorder) ListStatementEntries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatementEntries", reflect.TypeOf((*MockStore)(nil).ListStatementEntries), arg0, arg1)
+}
+
+// ListStatements mocks base method.
+func (m *MockStore) ListStatements(arg0 context.Context, arg1 db.ListStatementsParams) ([]db.ListStatementsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatements", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListStatementsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatements indicates an expected call of ListStatements.
+func (mr *MockStoreMockRecorder) ListStatements(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatements", reflect.TypeOf((*MockStore)(nil).ListStatements), arg0, arg1)
+}
+
+// ListTransferEntryMismatches mocks base method.
+func (m *MockStore) ListTransferEntryMismatches(arg0 context.Context) ([]db.ListTransferEntryMismatchesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferEntryMismatches", arg0)
+	ret0, _ := ret[0].([]db.ListTransferEntryMismatchesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddAccountBalance indicates an expected call of AddAccountBalance.
-func (mr *MockStoreMockRecorder) AddAccountBalance(arg0, arg1 interface{}) *gomock.Call {
+// ListTransferEntryMismatches indicates an expected call of ListTransferEntryMismatches.
+func (mr *MockStoreMockRecorder) ListTransferEntryMismatches(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockStore)(nil).AddAccountBalance), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferEntryMismatches", reflect.TypeOf((*MockStore)(nil).ListTransferEntryMismatches), arg0)
 }
 
-// CreateAccount mocks base method.
-func (m *MockStore) CreateAccount(arg0 context.Context, arg1 db.CreateAccountParams) (db.Account, error) {
+// ListTransferParties mocks base method.
+func (m *MockStore) ListTransferParties(arg0 context.Context, arg1 []int64) ([]db.ListTransferPartiesRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ListTransferParties", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTransferPartiesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockStoreMockRecorder) CreateAccount(arg0, arg1 interface{}) *gomock.Call {
+// ListTransferParties indicates an expected call of ListTransferParties.
+func (mr *MockStoreMockRecorder) ListTransferParties(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockStore)(nil).CreateAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferParties", reflect.TypeOf((*MockStore)(nil).ListTransferParties), arg0, arg1)
 }
 
-// CreateEntry mocks base method.
-func (m *MockStore) CreateEntry(arg0 context.Context, arg1 db.CreateEntryParams) (db.Entry, error) {
+// ListTransferReversals mocks base method.
+func (m *MockStore) ListTransferReversals(arg0 context.Context, arg1 *int64) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ListTransferReversals", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEntry indicates an expected call of CreateEntry.
-func (mr *MockStoreMockRecorder) CreateEntry(arg0, arg1 interface{}) *gomock.Call {
+// ListTransferReversals indicates an expected call of ListTransferReversals.
+func (mr *MockStoreMockRecorder) ListTransferReversals(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockStore)(nil).CreateEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferReversals", reflect.TypeOf((*MockStore)(nil).ListTransferReversals), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+// ListTransfers mocks base method.
+func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// ListTransfers indicates an expected call of ListTransfers.
+func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
 }
 
-// CreateTransfer mocks base method.
-func (m *MockStore) CreateTransfer(arg0 context.Context, arg1 db.CreateTransferParams) (db.Transfer, error) {
+// ListTransfersPage mocks base method.
+func (m *MockStore) ListTransfersPage(arg0 context.Context, arg1 db.ListTransfersPageParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "ListTransfersPage", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTransfer indicates an expected call of CreateTransfer.
-func (mr *MockStoreMockRecorder) CreateTransfer(arg0, arg1 interface{}) *gomock.Call {
+// ListTransfersPage indicates an expected call of ListTransfersPage.
+func (mr *MockStoreMockRecorder) ListTransfersPage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockStore)(nil).CreateTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersPage", reflect.TypeOf((*MockStore)(nil).ListTransfersPage), arg0, arg1)
 }
 
-// CreateUser mocks base method.
-func (m *MockStore) CreateUser(arg0 context.Context, arg1 db.CreateUserParams) (db.User, error) {
+// ListTransfersPageAsc mocks base method.
+func (m *MockStore) ListTransfersPageAsc(arg0 context.Context, arg1 db.ListTransfersPageAscParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "ListTransfersPageAsc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockStoreMockRecorder) CreateUser(arg0, arg1 interface{}) *gomock.Call {
+// ListTransfersPageAsc indicates an expected call of ListTransfersPageAsc.
+func (mr *MockStoreMockRecorder) ListTransfersPageAsc(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockStore)(nil).CreateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersPageAsc", reflect.TypeOf((*MockStore)(nil).ListTransfersPageAsc), arg0, arg1)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockStore) DeleteAccount(arg0 context.Context, arg1 int64) error {
+// ListTransfersPageDesc mocks base method.
+func (m *MockStore) ListTransfersPageDesc(arg0 context.Context, arg1 db.ListTransfersPageDescParams) ([]db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListTransfersPageDesc", arg0, arg1)
+	ret0, _ := ret[0].([]db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransfersPageDesc indicates an expected call of ListTransfersPageDesc.
+func (mr *MockStoreMockRecorder) ListTransfersPageDesc(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfersPageDesc", reflect.TypeOf((*MockStore)(nil).ListTransfersPageDesc), arg0, arg1)
+}
+
+// ListUnbalancedJournals mocks base method.
+func (m *MockStore) ListUnbalancedJournals(arg0 context.Context) ([]db.ListUnbalancedJournalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnbalancedJournals", arg0)
+	ret0, _ := ret[0].([]db.ListUnbalancedJournalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnbalancedJournals indicates an expected call of ListUnbalancedJournals.
+func (mr *MockStoreMockRecorder) ListUnbalancedJournals(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnbalancedJournals", reflect.TypeOf((*MockStore)(nil).ListUnbalancedJournals), arg0)
+}
+
+// LockAuditChain mocks base method.
+func (m *MockStore) LockAuditChain(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAuditChain", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockStoreMockRecorder) DeleteAccount(arg0, arg1 interface{}) *gomock.Call {
+// LockAuditChain indicates an expected call of LockAuditChain.
+func (mr *MockStoreMockRecorder) LockAuditChain(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockStore)(nil).DeleteAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAuditChain", reflect.TypeOf((*MockStore)(nil).LockAuditChain), arg0)
 }
 
-// EntryTx mocks base method.
-func (m *MockStore) EntryTx(arg0 context.Context, arg1 db.EntryTxParams) (db.EntryTxResult, error) {
+// MarkSessionUsed mocks base method.
+func (m *MockStore) MarkSessionUsed(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EntryTx", arg0, arg1)
-	ret0, _ := ret[0].(db.EntryTxResult)
+	ret := m.ctrl.Call(m, "MarkSessionUsed", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EntryTx indicates an expected call of EntryTx.
-func (mr *MockStoreMockRecorder) EntryTx(arg0, arg1 interface{}) *gomock.Call {
+// MarkSessionUsed indicates an expected call of MarkSessionUsed.
+func (mr *MockStoreMockRecorder) MarkSessionUsed(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntryTx", reflect.TypeOf((*MockStore)(nil).EntryTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSessionUsed", reflect.TypeOf((*MockStore)(nil).MarkSessionUsed), arg0, arg1)
 }
 
-// GetAccount mocks base method.
-func (m *MockStore) GetAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ReconcileTx mocks base method.
+func (m *MockStore) ReconcileTx(arg0 context.Context) (db.ReconcileTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ReconcileTx", arg0)
+	ret0, _ := ret[0].(db.ReconcileTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockStoreMockRecorder) GetAccount(arg0, arg1 interface{}) *gomock.Call {
+// ReconcileTx indicates an expected call of ReconcileTx.
+func (mr *MockStoreMockRecorder) ReconcileTx(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockStore)(nil).GetAccount), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileTx", reflect.TypeOf((*MockStore)(nil).ReconcileTx), arg0)
 }
 
-// GetAccountForUpdate mocks base method.
-func (m *MockStore) GetAccountForUpdate(arg0 context.Context, arg1 int64) (db.Account, error) {
+// ResolvePayee mocks base method.
+func (m *MockStore) ResolvePayee(arg0 context.Context, arg1, arg2 string) (db.Payee, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(db.Account)
+	ret := m.ctrl.Call(m, "ResolvePayee", arg0, arg1, arg2)
+	ret0, _ := ret[0].(db.Payee)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountForUpdate indicates an expected call of GetAccountForUpdate.
-func (mr *MockStoreMockRecorder) GetAccountForUpdate(arg0, arg1 interface{}) *gomock.Call {
+// ResolvePayee indicates an expected call of ResolvePayee.
+func (mr *MockStoreMockRecorder) ResolvePayee(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountForUpdate", reflect.TypeOf((*MockStore)(nil).GetAccountForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePayee", reflect.TypeOf((*MockStore)(nil).ResolvePayee), arg0, arg1, arg2)
 }
 
-// GetEntry mocks base method.
-func (m *MockStore) GetEntry(arg0 context.Context, arg1 int64) (db.Entry, error) {
+// ReverseTransferTx mocks base method.
+func (m *MockStore) ReverseTransferTx(arg0 context.Context, arg1 db.ReverseTransferTxParams) (db.ReverseTransferTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntry", arg0, arg1)
-	ret0, _ := ret[0].(db.Entry)
+	ret := m.ctrl.Call(m, "ReverseTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReverseTransferTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEntry indicates an expected call of GetEntry.
-func (mr *MockStoreMockRecorder) GetEntry(arg0, arg1 interface{}) *gomock.Call {
+// ReverseTransferTx indicates an expected call of ReverseTransferTx.
+func (mr *MockStoreMockRecorder) ReverseTransferTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockStore)(nil).GetEntry), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransferTx", reflect.TypeOf((*MockStore)(nil).ReverseTransferTx), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// RotateSessionTx mocks base method.
+func (m *MockStore) RotateSessionTx(arg0 context.Context, arg1 db.RotateSessionTxParams) (db.RotateSessionTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "RotateSessionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RotateSessionTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// RotateSessionTx indicates an expected call of RotateSessionTx.
+func (mr *MockStoreMockRecorder) RotateSessionTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSessionTx", reflect.TypeOf((*MockStore)(nil).RotateSessionTx), arg0, arg1)
 }
 
-// GetTransfer mocks base method.
-func (m *MockStore) GetTransfer(arg0 context.Context, arg1 int64) (db.Transfer, error) {
+// SearchUsers mocks base method.
+func (m *MockStore) SearchUsers(arg0 context.Context, arg1 db.SearchUsersParams) ([]db.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransfer", arg0, arg1)
-	ret0, _ := ret[0].(db.Transfer)
+	ret := m.ctrl.Call(m, "SearchUsers", arg0, arg1)
+	ret0, _ := ret[0].([]db.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransfer indicates an expected call of GetTransfer.
-func (mr *MockStoreMockRecorder) GetTransfer(arg0, arg1 interface{}) *gomock.Call {
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockStoreMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockStore)(nil).GetTransfer), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockStore)(nil).SearchUsers), arg0, arg1)
 }
 
-// GetUser mocks base method.
-func (m *MockStore) GetUser(arg0 context.Context, arg1 string) (db.User, error) {
+// SetAccountOverdraftLimit mocks base method.
+func (m *MockStore) SetAccountOverdraftLimit(arg0 context.Context, arg1 db.SetAccountOverdraftLimitParams) (db.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(db.User)
+	ret := m.ctrl.Call(m, "SetAccountOverdraftLimit", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStoreMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
+// SetAccountOverdraftLimit indicates an expected call of SetAccountOverdraftLimit.
+func (mr *MockStoreMockRecorder) SetAccountOverdraftLimit(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockStore)(nil).GetUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountOverdraftLimit", reflect.TypeOf((*MockStore)(nil).SetAccountOverdraftLimit), arg0, arg1)
 }
 
-// ListAccounts mocks base method.
-func (m *MockStore) ListAccounts(arg0 context.Context, arg1 db.ListAccountsParams) ([]db.Account, error) {
+// SetOverdraftLimitTx mocks base method.
+func (m *MockStore) SetOverdraftLimitTx(arg0 context.Context, arg1 db.SetOverdraftLimitTxParams) (db.SetOverdraftLimitTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAccounts", arg0, arg1)
-	ret0, _ := ret[0].([]db.Account)
+	ret := m.ctrl.Call(m, "SetOverdraftLimitTx", arg0, arg1)
+	ret0, _ := ret[0].(db.SetOverdraftLimitTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAccounts indicates an expected call of ListAccounts.
-func (mr *MockStoreMockRecorder) ListAccounts(arg0, arg1 interface{}) *gomock.Call {
+// SetOverdraftLimitTx indicates an expected call of SetOverdraftLimitTx.
+func (mr *MockStoreMockRecorder) SetOverdraftLimitTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockStore)(nil).ListAccounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverdraftLimitTx", reflect.TypeOf((*MockStore)(nil).SetOverdraftLimitTx), arg0, arg1)
 }
 
-// ListEntries mocks base method.
-func (m *MockStore) ListEntries(arg0 context.Context, arg1 db.ListEntriesParams) ([]db.Entry, error) {
+// SetTransferJournal mocks base method.
+func (m *MockStore) SetTransferJournal(arg0 context.Context, arg1 db.SetTransferJournalParams) (db.Transfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEntries", arg0, arg1)
-	ret0, _ := ret[0].([]db.Entry)
+	ret := m.ctrl.Call(m, "SetTransferJournal", arg0, arg1)
+	ret0, _ := ret[0].(db.Transfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEntries indicates an expected call of ListEntries.
-func (mr *MockStoreMockRecorder) ListEntries(arg0, arg1 interface{}) *gomock.Call {
+// SetTransferJournal indicates an expected call of SetTransferJournal.
+func (mr *MockStoreMockRecorder) SetTransferJournal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockStore)(nil).ListEntries), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransferJournal", reflect.TypeOf((*MockStore)(nil).SetTransferJournal), arg0, arg1)
 }
 
-// ListTransfers mocks base method.
-func (m *MockStore) ListTransfers(arg0 context.Context, arg1 db.ListTransfersParams) ([]db.Transfer, error) {
+// TransferDetails mocks base method.
+func (m *MockStore) TransferDetails(arg0 context.Context, arg1 db.TransferDetailsParams) ([]db.TransferDetail, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTransfers", arg0, arg1)
-	ret0, _ := ret[0].([]db.Transfer)
+	ret := m.ctrl.Call(m, "TransferDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.TransferDetail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTransfers indicates an expected call of ListTransfers.
-func (mr *MockStoreMockRecorder) ListTransfers(arg0, arg1 interface{}) *gomock.Call {
+// TransferDetails indicates an expected call of TransferDetails.
+func (mr *MockStoreMockRecorder) TransferDetails(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransfers", reflect.TypeOf((*MockStore)(nil).ListTransfers), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferDetails", reflect.TypeOf((*MockStore)(nil).TransferDetails), arg0, arg1)
 }
 
 // TransferTx mocks base method.
@@ -305,6 +1923,66 @@ func (mr *MockStoreMockRecorder) TransferTx(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferTx", reflect.TypeOf((*MockStore)(nil).TransferTx), arg0, arg1)
 }
 
+// UnblockSession mocks base method.
+func (m *MockStore) UnblockSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockSession indicates an expected call of UnblockSession.
+func (mr *MockStoreMockRecorder) UnblockSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockSession", reflect.TypeOf((*MockStore)(nil).UnblockSession), arg0, arg1)
+}
+
+// UnblockSessionTx mocks base method.
+func (m *MockStore) UnblockSessionTx(arg0 context.Context, arg1 db.UnblockSessionTxParams) (db.UnblockSessionTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockSessionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UnblockSessionTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnblockSessionTx indicates an expected call of UnblockSessionTx.
+func (mr *MockStoreMockRecorder) UnblockSessionTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockSessionTx", reflect.TypeOf((*MockStore)(nil).UnblockSessionTx), arg0, arg1)
+}
+
+// UnfreezeAccount mocks base method.
+func (m *MockStore) UnfreezeAccount(arg0 context.Context, arg1 int64) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccount", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccount indicates an expected call of UnfreezeAccount.
+func (mr *MockStoreMockRecorder) UnfreezeAccount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccount", reflect.TypeOf((*MockStore)(nil).UnfreezeAccount), arg0, arg1)
+}
+
+// UnfreezeAccountTx mocks base method.
+func (m *MockStore) UnfreezeAccountTx(arg0 context.Context, arg1 db.UnfreezeAccountTxParams) (db.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfreezeAccountTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfreezeAccountTx indicates an expected call of UnfreezeAccountTx.
+func (mr *MockStoreMockRecorder) UnfreezeAccountTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfreezeAccountTx", reflect.TypeOf((*MockStore)(nil).UnfreezeAccountTx), arg0, arg1)
+}
+
 // UpdateAccount mocks base method.
 func (m *MockStore) UpdateAccount(arg0 context.Context, arg1 db.UpdateAccountParams) (db.Account, error) {
 	m.ctrl.T.Helper()
@@ -319,3 +1997,136 @@ func (mr *MockStoreMockRecorder) UpdateAccount(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockStore)(nil).UpdateAccount), arg0, arg1)
 }
+
+// UpdateIdempotencyKeyResponse mocks base method.
+func (m *MockStore) UpdateIdempotencyKeyResponse(arg0 context.Context, arg1 db.UpdateIdempotencyKeyResponseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIdempotencyKeyResponse", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateIdempotencyKeyResponse indicates an expected call of UpdateIdempotencyKeyResponse.
+func (mr *MockStoreMockRecorder) UpdateIdempotencyKeyResponse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIdempotencyKeyResponse", reflect.TypeOf((*MockStore)(nil).UpdateIdempotencyKeyResponse), arg0, arg1)
+}
+
+// UpdatePaymentRequestStatus mocks base method.
+func (m *MockStore) UpdatePaymentRequestStatus(arg0 context.Context, arg1 db.UpdatePaymentRequestStatusParams) (db.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaymentRequestStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePaymentRequestStatus indicates an expected call of UpdatePaymentRequestStatus.
+func (mr *MockStoreMockRecorder) UpdatePaymentRequestStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaymentRequestStatus", reflect.TypeOf((*MockStore)(nil).UpdatePaymentRequestStatus), arg0, arg1)
+}
+
+// UpdateScheduledTransfer mocks base method.
+func (m *MockStore) UpdateScheduledTransfer(arg0 context.Context, arg1 db.UpdateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockStoreMockRecorder) UpdateScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).UpdateScheduledTransfer), arg0, arg1)
+}
+
+// UpdateScheduledTransferRun mocks base method.
+func (m *MockStore) UpdateScheduledTransferRun(arg0 context.Context, arg1 db.UpdateScheduledTransferRunParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransferRun", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransferRun indicates an expected call of UpdateScheduledTransferRun.
+func (mr *MockStoreMockRecorder) UpdateScheduledTransferRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransferRun", reflect.TypeOf((*MockStore)(nil).UpdateScheduledTransferRun), arg0, arg1)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockStore) UpdateUserRole(arg0 context.Context, arg1 db.UpdateUserRoleParams) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", arg0, arg1)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockStoreMockRecorder) UpdateUserRole(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockStore)(nil).UpdateUserRole), arg0, arg1)
+}
+
+// UpsertFxRate mocks base method.
+func (m *MockStore) UpsertFxRate(arg0 context.Context, arg1 db.UpsertFxRateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFxRate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFxRate indicates an expected call of UpsertFxRate.
+func (mr *MockStoreMockRecorder) UpsertFxRate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFxRate", reflect.TypeOf((*MockStore)(nil).UpsertFxRate), arg0, arg1)
+}
+
+// UseFxQuote mocks base method.
+func (m *MockStore) UseFxQuote(arg0 context.Context, arg1 uuid.UUID) (db.FxQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseFxQuote", arg0, arg1)
+	ret0, _ := ret[0].(db.FxQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseFxQuote indicates an expected call of UseFxQuote.
+func (mr *MockStoreMockRecorder) UseFxQuote(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseFxQuote", reflect.TypeOf((*MockStore)(nil).UseFxQuote), arg0, arg1)
+}
+
+// VerifyAuditChain mocks base method.
+func (m *MockStore) VerifyAuditChain(arg0 context.Context) (db.AuditChainReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditChain", arg0)
+	ret0, _ := ret[0].(db.AuditChainReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAuditChain indicates an expected call of VerifyAuditChain.
+func (mr *MockStoreMockRecorder) VerifyAuditChain(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditChain", reflect.TypeOf((*MockStore)(nil).VerifyAuditChain), arg0)
+}
+
+// VoidHoldTx mocks base method.
+func (m *MockStore) VoidHoldTx(arg0 context.Context, arg1 db.VoidHoldTxParams) (db.ReleaseHoldTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReleaseHoldTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHoldTx indicates an expected call of VoidHoldTx.
+func (mr *MockStoreMockRecorder) VoidHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHoldTx", reflect.TypeOf((*MockStore)(nil).VoidHoldTx), arg0, arg1)
+}
@@ -13,6 +13,12 @@ FROM accounts
 WHERE id = $1
 LIMIT 1;
 
+-- name: GetSystemAccount :one
+SELECT *
+FROM accounts
+WHERE system_purpose = sqlc.arg(system_purpose)::varchar AND currency = sqlc.arg(currency)
+LIMIT 1;
+
 -- name: GetAccountForUpdate :one
 SELECT *
 FROM accounts
@@ -20,6 +26,11 @@ WHERE id = $1
 LIMIT 1
 FOR NO KEY UPDATE;
 
+-- name: GetOwnerAccount :one
+SELECT *
+FROM accounts
+WHERE owner = $1 AND currency = $2 AND status <> 'closed' AND system_purpose IS NULL
+LIMIT 1;
 
 -- name: ListAccounts :many
 SELECT *
@@ -43,4 +54,58 @@ RETURNING *;
 
 -- name: DeleteAccount :exec
 DELETE FROM accounts
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: FreezeAccount :one
+UPDATE accounts
+SET status = 'frozen', frozen_by = sqlc.arg(frozen_by)
+WHERE id = sqlc.arg(id) AND status = 'active'
+RETURNING *;
+
+-- name: UnfreezeAccount :one
+UPDATE accounts
+SET status = 'active', frozen_by = NULL
+WHERE id = $1 AND status = 'frozen'
+RETURNING *;
+
+-- name: SetAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = sqlc.arg(overdraft_limit)
+WHERE id = sqlc.arg(id) AND system_purpose IS NULL
+RETURNING *;
+
+-- name: CloseAccount :one
+UPDATE accounts
+SET status = 'closed', closed_at = now()
+WHERE id = $1 AND status <> 'closed'
+RETURNING *;
+
+-- name: ListAccountsPageAsc :many
+SELECT *
+FROM accounts
+WHERE owner = sqlc.arg(owner)
+    AND id > sqlc.arg(after_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+    AND balance BETWEEN sqlc.arg(min_balance) AND sqlc.arg(max_balance)
+    AND (sqlc.arg(currency)::varchar = '' OR currency = sqlc.arg(currency))
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListAccountsPageDesc :many
+SELECT *
+FROM accounts
+WHERE owner = sqlc.arg(owner)
+    AND id < sqlc.arg(after_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+    AND balance BETWEEN sqlc.arg(min_balance) AND sqlc.arg(max_balance)
+    AND (sqlc.arg(currency)::varchar = '' OR currency = sqlc.arg(currency))
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: AddAccountHeldAmount :one
+UPDATE accounts
+SET held_amount = held_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,16 @@
+-- name: CreateAdminAuditLog :one
+INSERT INTO admin_audit_logs (
+    admin_username,
+    action,
+    target_type,
+    target_id,
+    reason
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListAdminAuditLogs :many
+SELECT * FROM admin_audit_logs
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2;
@@ -0,0 +1,30 @@
+-- name: LockAuditChain :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_events'));
+
+-- name: GetLastAuditEvent :one
+SELECT * FROM audit_events
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    actor,
+    channel,
+    client_ip,
+    user_agent,
+    action,
+    target_type,
+    target_id,
+    details,
+    prev_hash,
+    hash,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING *;
+
+-- name: ListAuditEventsAfter :many
+SELECT * FROM audit_events
+WHERE id > $1
+ORDER BY id
+LIMIT $2;
@@ -0,0 +1,33 @@
+-- name: CreateBillSplit :one
+INSERT INTO bill_splits(
+    initiator,
+    to_account_id,
+    total_amount,
+    currency,
+    memo,
+    rule
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetBillSplit :one
+SELECT *
+FROM bill_splits
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListBillSplitsPageAsc :many
+SELECT *
+FROM bill_splits
+WHERE initiator = sqlc.arg(initiator)
+    AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListBillSplitsPageDesc :many
+SELECT *
+FROM bill_splits
+WHERE initiator = sqlc.arg(initiator)
+    AND id < sqlc.arg(after_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
@@ -1,9 +1,10 @@
 -- name: CreateEntry :one
 INSERT INTO entries(
     account_id,
-    amount
+    amount,
+    journal_id
 ) VALUES (
-    $1, $2
+    $1, $2, $3
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -19,3 +20,89 @@ WHERE account_id = $1
 ORDER BY id
 LIMIT $2
 OFFSET $3;
+
+
+-- name: ListEntriesByOwner :many
+SELECT entries.*
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+WHERE accounts.owner = $1
+ORDER BY entries.id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListEntriesByJournal :many
+SELECT *
+FROM entries
+WHERE journal_id = $1
+ORDER BY id;
+
+-- name: GetAccountBalanceAt :one
+SELECT COALESCE(sum(amount), 0)::bigint AS balance
+FROM entries
+WHERE account_id = $1 AND created_at < sqlc.arg(at);
+
+-- name: ListStatementEntries :many
+SELECT
+    entries.*,
+    COALESCE(journal_transactions.kind, '')::varchar AS journal_kind,
+    COALESCE(users.full_name, '')::varchar AS counterparty
+FROM entries
+LEFT JOIN journal_transactions ON journal_transactions.id = entries.journal_id
+LEFT JOIN transfers ON transfers.journal_id = entries.journal_id
+LEFT JOIN accounts AS counterparty_accounts ON counterparty_accounts.id = (
+    CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id ELSE transfers.from_account_id END
+)
+LEFT JOIN users ON users.username = counterparty_accounts.owner
+WHERE entries.account_id = sqlc.arg(account_id)
+    AND entries.created_at >= sqlc.arg(from_time)
+    AND entries.created_at < sqlc.arg(to_time)
+    AND entries.id > sqlc.arg(after_id)
+ORDER BY entries.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListEntriesPageAsc :many
+SELECT entries.*
+FROM entries
+WHERE entries.account_id = sqlc.arg(account_id)
+    AND entries.id > sqlc.arg(after_id)
+    AND entries.created_at >= sqlc.arg(from_time)
+    AND entries.created_at < sqlc.arg(to_time)
+    AND entries.amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'credit' AND entries.amount > 0)
+        OR (sqlc.arg(direction) = 'debit' AND entries.amount < 0)
+    )
+    AND (sqlc.arg(counterparty_account_id)::bigint = 0 OR EXISTS (
+        SELECT 1 FROM transfers
+        WHERE transfers.journal_id = entries.journal_id AND (
+            (transfers.from_account_id = entries.account_id AND transfers.to_account_id = sqlc.arg(counterparty_account_id))
+            OR (transfers.to_account_id = entries.account_id AND transfers.from_account_id = sqlc.arg(counterparty_account_id))
+        )
+    ))
+ORDER BY entries.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListEntriesPageDesc :many
+SELECT entries.*
+FROM entries
+WHERE entries.account_id = sqlc.arg(account_id)
+    AND entries.id < sqlc.arg(after_id)
+    AND entries.created_at >= sqlc.arg(from_time)
+    AND entries.created_at < sqlc.arg(to_time)
+    AND entries.amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'credit' AND entries.amount > 0)
+        OR (sqlc.arg(direction) = 'debit' AND entries.amount < 0)
+    )
+    AND (sqlc.arg(counterparty_account_id)::bigint = 0 OR EXISTS (
+        SELECT 1 FROM transfers
+        WHERE transfers.journal_id = entries.journal_id AND (
+            (transfers.from_account_id = entries.account_id AND transfers.to_account_id = sqlc.arg(counterparty_account_id))
+            OR (transfers.to_account_id = entries.account_id AND transfers.from_account_id = sqlc.arg(counterparty_account_id))
+        )
+    ))
+ORDER BY entries.id DESC
+LIMIT sqlc.arg(limit_count);
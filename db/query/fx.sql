@@ -0,0 +1,45 @@
+-- name: UpsertFxRate :exec
+INSERT INTO fx_rates(
+    from_currency,
+    to_currency,
+    rate,
+    effective_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (from_currency, to_currency, effective_at)
+DO UPDATE SET rate = EXCLUDED.rate;
+
+-- name: GetFxRate :one
+SELECT *
+FROM fx_rates
+WHERE from_currency = sqlc.arg(from_currency)
+AND to_currency = sqlc.arg(to_currency)
+AND effective_at <= sqlc.arg(at)
+ORDER BY effective_at DESC
+LIMIT 1;
+
+-- name: CreateFxQuote :one
+INSERT INTO fx_quotes(
+    id,
+    username,
+    from_currency,
+    to_currency,
+    rate,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetFxQuote :one
+SELECT *
+FROM fx_quotes
+WHERE id = $1
+LIMIT 1;
+
+-- name: UseFxQuote :one
+UPDATE fx_quotes
+SET used_at = now()
+WHERE id = $1
+AND used_at IS NULL
+AND expires_at > now()
+RETURNING *;
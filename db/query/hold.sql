@@ -0,0 +1,40 @@
+-- name: CreateHold :one
+INSERT INTO holds(
+    from_account_id,
+    to_account_id,
+    amount,
+    expires_at
+) VALUES (
+    $1, $2, $3, now() + sqlc.arg(ttl_seconds)::bigint * interval '1 second'
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT *
+FROM holds
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetExpiredHoldForUpdate :one
+SELECT *
+FROM holds
+WHERE status = 'pending' AND expires_at <= sqlc.arg(now)
+ORDER BY expires_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED;
+
+-- name: CloseHold :one
+UPDATE holds
+SET
+    status = sqlc.arg(status),
+    captured_amount = sqlc.arg(captured_amount),
+    transfer_id = sqlc.narg(transfer_id),
+    closed_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,92 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests(
+    requester,
+    payer,
+    to_account_id,
+    amount,
+    currency,
+    memo,
+    split_id,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, sqlc.narg(split_id), now() + sqlc.arg(ttl_seconds)::bigint * interval '1 second'
+) RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT *
+FROM payment_requests
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT *
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetExpiredPaymentRequestForUpdate :one
+SELECT *
+FROM payment_requests
+WHERE status = 'pending' AND expires_at <= sqlc.arg(now)
+ORDER BY expires_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED;
+
+-- name: UpdatePaymentRequestStatus :one
+UPDATE payment_requests
+SET
+    status = sqlc.arg(status),
+    transfer_id = sqlc.narg(transfer_id),
+    updated_at = now()
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: ListPaymentRequestsPageAsc :many
+SELECT *
+FROM payment_requests
+WHERE (requester = sqlc.arg(username) OR payer = sqlc.arg(username))
+    AND id > sqlc.arg(after_id)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'incoming' AND payer = sqlc.arg(username))
+        OR (sqlc.arg(direction) = 'outgoing' AND requester = sqlc.arg(username))
+    )
+    AND (sqlc.arg(status)::varchar = '' OR status = sqlc.arg(status))
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListPaymentRequestsPageDesc :many
+SELECT *
+FROM payment_requests
+WHERE (requester = sqlc.arg(username) OR payer = sqlc.arg(username))
+    AND id < sqlc.arg(after_id)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'incoming' AND payer = sqlc.arg(username))
+        OR (sqlc.arg(direction) = 'outgoing' AND requester = sqlc.arg(username))
+    )
+    AND (sqlc.arg(status)::varchar = '' OR status = sqlc.arg(status))
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListSplitPaymentRequests :many
+SELECT *
+FROM payment_requests
+WHERE split_id = $1
+ORDER BY id;
+
+-- name: CreatePaymentRequestEvent :one
+INSERT INTO payment_request_events(
+    payment_request_id,
+    status,
+    actor
+) VALUES (
+    $1, $2, $3
+) RETURNING *;
+
+-- name: ListPaymentRequestEvents :many
+SELECT *
+FROM payment_request_events
+WHERE payment_request_id = $1
+ORDER BY id;
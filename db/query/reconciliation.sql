@@ -0,0 +1,92 @@
+-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (
+    accounts_checked,
+    transfers_checked,
+    currencies_checked,
+    discrepancy_count,
+    discrepancies,
+    started_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetLatestReconciliationReport :one
+SELECT * FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CountAccounts :one
+SELECT count(*) FROM accounts;
+
+-- name: ListAccountBalanceMismatches :many
+SELECT
+    accounts.id,
+    accounts.balance,
+    COALESCE(sum(entries.amount), 0)::bigint AS entry_total
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+GROUP BY accounts.id
+HAVING accounts.balance <> COALESCE(sum(entries.amount), 0)
+ORDER BY accounts.id;
+
+-- name: CountJournaledTransfers :one
+SELECT count(*) FROM transfers
+WHERE journal_id IS NOT NULL;
+
+-- name: ListTransferEntryMismatches :many
+SELECT
+    transfers.id,
+    (CASE WHEN transfers.fx_rate IS NULL THEN 2 ELSE 4 END)::bigint AS expected_entry_count,
+    (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+    )::bigint AS entry_count
+FROM transfers
+WHERE transfers.journal_id IS NOT NULL AND (
+    (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+    ) <> CASE WHEN transfers.fx_rate IS NULL THEN 2 ELSE 4 END
+    OR (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+            AND entries.account_id = transfers.from_account_id
+            AND entries.amount = -transfers.amount
+    ) <> 1
+    OR (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+            AND entries.account_id = transfers.to_account_id
+            AND entries.amount = COALESCE(transfers.converted_amount, transfers.amount)
+    ) <> 1
+)
+ORDER BY transfers.id;
+
+-- name: ListUnbalancedJournals :many
+SELECT
+    entries.journal_id,
+    accounts.currency,
+    sum(entries.amount)::bigint AS total
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+WHERE entries.journal_id IS NOT NULL
+GROUP BY entries.journal_id, accounts.currency
+HAVING sum(entries.amount) <> 0
+ORDER BY entries.journal_id;
+
+-- name: ListCurrencyNetBalances :many
+-- money enters and leaves the ledger only through the system accounts, so the balances of all accounts net to zero
+-- in each currency once the entries which were written before the ledger are taken out
+SELECT
+    accounts.currency,
+    (sum(accounts.balance) - COALESCE(sum(legacy_entries.entry_total), 0))::bigint AS net_balance
+FROM accounts
+LEFT JOIN (
+    SELECT account_id, sum(amount) AS entry_total
+    FROM entries
+    WHERE journal_id IS NULL
+        AND created_at < COALESCE((SELECT min(created_at) FROM journal_transactions), 'infinity')
+    GROUP BY account_id
+) AS legacy_entries ON legacy_entries.account_id = accounts.id
+GROUP BY accounts.currency
+ORDER BY accounts.currency;
@@ -0,0 +1,90 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers(
+    owner,
+    from_account_id,
+    to_account_id,
+    amount,
+    frequency,
+    start_at,
+    scheduled_at,
+    run_at,
+    end_at,
+    max_occurrences,
+    max_retries,
+    retry_interval
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $6, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT *
+FROM scheduled_transfers
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListScheduledTransfers :many
+SELECT *
+FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    amount = COALESCE(sqlc.narg(amount), amount),
+    end_at = COALESCE(sqlc.narg(end_at), end_at),
+    max_occurrences = COALESCE(sqlc.narg(max_occurrences), max_occurrences),
+    max_retries = COALESCE(sqlc.narg(max_retries), max_retries),
+    retry_interval = COALESCE(sqlc.narg(retry_interval), retry_interval)
+WHERE id = sqlc.arg(id) AND status = 'active'
+RETURNING *;
+
+-- name: CancelScheduledTransfer :one
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE id = $1 AND status = 'active'
+RETURNING *;
+
+-- name: GetDueScheduledTransferForUpdate :one
+SELECT *
+FROM scheduled_transfers
+WHERE status = 'active' AND run_at <= sqlc.arg(now)
+ORDER BY run_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED;
+
+-- name: UpdateScheduledTransferRun :one
+UPDATE scheduled_transfers
+SET
+    scheduled_at = $2,
+    run_at = $3,
+    occurrences = $4,
+    retries = $5,
+    status = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeferScheduledTransferRun :exec
+UPDATE scheduled_transfers
+SET run_at = $2
+WHERE id = $1 AND status = 'active';
+
+-- name: CreateScheduledTransferExecution :one
+INSERT INTO scheduled_transfer_executions(
+    scheduled_transfer_id,
+    transfer_id,
+    status,
+    failure_reason
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListScheduledTransferExecutions :many
+SELECT *
+FROM scheduled_transfer_executions
+WHERE scheduled_transfer_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
@@ -6,12 +6,55 @@ INSERT INTO sessions (
     user_agent,
     client_ip,
     is_blocked,
-    expires_at
+    expires_at,
+    family_id,
+    parent_id
 )
 VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: GetSession :one
 SELECT * FROM sessions
-WHERE id = $1 LIMIT 1;
\ No newline at end of file
+WHERE id = $1 LIMIT 1;
+
+-- name: GetSessionForUpdate :one
+SELECT * FROM sessions
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListActiveSessions :many
+SELECT * FROM sessions
+WHERE username = $1 AND is_blocked = false AND used_at IS NULL AND expires_at > now()
+ORDER BY created_at DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: BlockSession :one
+UPDATE sessions
+SET is_blocked = true
+WHERE id = $1
+RETURNING *;
+
+-- name: BlockOtherSessions :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE username = sqlc.arg(username) AND id <> sqlc.arg(id) AND is_blocked = false;
+
+
+-- name: MarkSessionUsed :one
+UPDATE sessions
+SET used_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: BlockSessionFamily :execrows
+UPDATE sessions
+SET is_blocked = true
+WHERE family_id = $1;
+
+-- name: UnblockSession :one
+UPDATE sessions
+SET is_blocked = false
+WHERE id = $1 AND is_blocked = true
+RETURNING *;
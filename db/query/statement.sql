@@ -0,0 +1,41 @@
+-- name: CreateStatement :one
+INSERT INTO statements (
+    account_id,
+    period_start,
+    period_end,
+    opening_balance,
+    closing_balance,
+    file_name,
+    content
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (account_id, period_start) DO NOTHING
+RETURNING id, account_id, period_start, period_end, opening_balance, closing_balance, file_name, created_at;
+
+-- name: GetStatement :one
+SELECT * FROM statements
+WHERE id = $1 AND account_id = $2
+LIMIT 1;
+
+-- name: ListStatements :many
+SELECT id, account_id, period_start, period_end, opening_balance, closing_balance, file_name, created_at
+FROM statements
+WHERE account_id = $1
+ORDER BY period_start DESC
+LIMIT $2
+OFFSET $3;
+
+-- name: ListAccountsWithoutStatement :many
+SELECT * FROM accounts
+WHERE accounts.id > sqlc.arg(after_id)
+    AND accounts.system_purpose IS NULL
+    AND accounts.created_at < sqlc.arg(period_end)::timestamptz
+    AND (accounts.closed_at IS NULL OR accounts.closed_at >= sqlc.arg(period_start)::timestamptz)
+    AND NOT EXISTS (
+        SELECT 1 FROM statements
+        WHERE statements.account_id = accounts.id
+            AND statements.period_start = sqlc.arg(period_start)::timestamptz
+    )
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
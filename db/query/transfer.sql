@@ -19,4 +19,103 @@ FROM transfers
 WHERE from_account_id = $1 OR to_account_id = $2
 ORDER BY id
 LIMIT $3
-OFFSET $4;
\ No newline at end of file
+OFFSET $4;
+
+-- name: CreateFxTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    fx_rate,
+    converted_amount
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetTransferForUpdate :one
+SELECT *
+FROM transfers
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: CreateReversalTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    reversal_of
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: AddTransferRefund :one
+UPDATE transfers
+SET
+    refunded_amount = refunded_amount + sqlc.arg(amount),
+    status = CASE
+        WHEN refunded_amount + sqlc.arg(amount) >= amount THEN 'reversed'
+        ELSE 'partially_refunded'
+    END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ListTransferReversals :many
+SELECT *
+FROM transfers
+WHERE reversal_of = $1
+ORDER BY id;
+
+-- name: SetTransferJournal :one
+UPDATE transfers
+SET journal_id = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: ListTransfersPageAsc :many
+SELECT *
+FROM transfers
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+    AND id > sqlc.arg(after_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+    AND amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'outgoing' AND from_account_id = sqlc.arg(account_id))
+        OR (sqlc.arg(direction) = 'incoming' AND to_account_id = sqlc.arg(account_id))
+    )
+    AND (
+        sqlc.arg(counterparty_account_id)::bigint = 0
+        OR (from_account_id = sqlc.arg(account_id) AND to_account_id = sqlc.arg(counterparty_account_id))
+        OR (to_account_id = sqlc.arg(account_id) AND from_account_id = sqlc.arg(counterparty_account_id))
+    )
+ORDER BY id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListTransfersPageDesc :many
+SELECT *
+FROM transfers
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+    AND id < sqlc.arg(after_id)
+    AND created_at >= sqlc.arg(from_time)
+    AND created_at < sqlc.arg(to_time)
+    AND amount BETWEEN sqlc.arg(min_amount) AND sqlc.arg(max_amount)
+    AND (
+        sqlc.arg(direction)::varchar = ''
+        OR (sqlc.arg(direction) = 'outgoing' AND from_account_id = sqlc.arg(account_id))
+        OR (sqlc.arg(direction) = 'incoming' AND to_account_id = sqlc.arg(account_id))
+    )
+    AND (
+        sqlc.arg(counterparty_account_id)::bigint = 0
+        OR (from_account_id = sqlc.arg(account_id) AND to_account_id = sqlc.arg(counterparty_account_id))
+        OR (to_account_id = sqlc.arg(account_id) AND from_account_id = sqlc.arg(counterparty_account_id))
+    )
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListTransferParties :many
+SELECT accounts.id, accounts.owner, accounts.currency, users.full_name
+FROM accounts
+JOIN users ON users.username = accounts.owner
+WHERE accounts.id = ANY(sqlc.arg(account_ids)::bigint[]);
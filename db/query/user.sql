@@ -11,4 +11,23 @@ VALUES (
 
 -- name: GetUser :one
 SELECT * FROM users
-WHERE username = $1 LIMIT 1;
\ No newline at end of file
+WHERE username = $1 LIMIT 1;
+
+-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2
+WHERE username = $1
+RETURNING *;
+
+
+-- name: SearchUsers :many
+SELECT * FROM users
+WHERE email ILIKE '%' || sqlc.arg(email)::text || '%'
+ORDER BY username
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
+
+-- name: GetUserByUsernameOrEmail :one
+SELECT * FROM users
+WHERE username = sqlc.arg(recipient) OR email = sqlc.arg(recipient)
+LIMIT 1;
@@ -7,13 +7,14 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 `
 
 type AddAccountBalanceParams struct {
@@ -30,6 +31,72 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
+const addAccountHeldAmount = `-- name: AddAccountHeldAmount :one
+UPDATE accounts
+SET held_amount = held_amount + $1
+WHERE id = $2
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+`
+
+type AddAccountHeldAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, addAccountHeldAmount, arg.Amount, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
+const closeAccount = `-- name: CloseAccount :one
+UPDATE accounts
+SET status = 'closed', closed_at = now()
+WHERE id = $1 AND status <> 'closed'
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+`
+
+func (q *Queries) CloseAccount(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, closeAccount, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
 	)
 	return i, err
 }
@@ -41,7 +108,7 @@ INSERT INTO accounts(
     currency
 ) VALUES (
     $1, $2, $3
-) RETURNING id, owner, balance, currency, created_at
+) RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 `
 
 type CreateAccountParams struct {
@@ -59,6 +126,13 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
 	)
 	return i, err
 }
@@ -73,8 +147,40 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const freezeAccount = `-- name: FreezeAccount :one
+UPDATE accounts
+SET status = 'frozen', frozen_by = $1
+WHERE id = $2 AND status = 'active'
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+`
+
+type FreezeAccountParams struct {
+	FrozenBy *string `json:"frozen_by"`
+	ID       int64   `json:"id"`
+}
+
+func (q *Queries) FreezeAccount(ctx context.Context, arg FreezeAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, freezeAccount, arg.FrozenBy, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 FROM accounts
 WHERE id = $1
 LIMIT 1
@@ -89,12 +195,19 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
 	)
 	return i, err
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 FROM accounts
 WHERE id = $1
 LIMIT 1
@@ -110,12 +223,83 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
+const getOwnerAccount = `-- name: GetOwnerAccount :one
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+FROM accounts
+WHERE owner = $1 AND currency = $2 AND status <> 'closed' AND system_purpose IS NULL
+LIMIT 1
+`
+
+type GetOwnerAccountParams struct {
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+}
+
+func (q *Queries) GetOwnerAccount(ctx context.Context, arg GetOwnerAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getOwnerAccount, arg.Owner, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
+const getSystemAccount = `-- name: GetSystemAccount :one
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+FROM accounts
+WHERE system_purpose = $1::varchar AND currency = $2
+LIMIT 1
+`
+
+type GetSystemAccountParams struct {
+	SystemPurpose string `json:"system_purpose"`
+	Currency      string `json:"currency"`
+}
+
+func (q *Queries) GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getSystemAccount, arg.SystemPurpose, arg.Currency)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 FROM accounts
 WHERE owner = $1
 ORDER BY id
@@ -144,6 +328,151 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.SystemPurpose,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.AvailableBalance,
+			&i.FrozenBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsPageAsc = `-- name: ListAccountsPageAsc :many
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+FROM accounts
+WHERE owner = $1
+    AND id > $2
+    AND created_at >= $3
+    AND created_at < $4
+    AND balance BETWEEN $5 AND $6
+    AND ($7::varchar = '' OR currency = $7)
+ORDER BY id
+LIMIT $8
+`
+
+type ListAccountsPageAscParams struct {
+	Owner      string    `json:"owner"`
+	AfterID    int64     `json:"after_id"`
+	FromTime   time.Time `json:"from_time"`
+	ToTime     time.Time `json:"to_time"`
+	MinBalance int64     `json:"min_balance"`
+	MaxBalance int64     `json:"max_balance"`
+	Currency   string    `json:"currency"`
+	LimitCount int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListAccountsPageAsc(ctx context.Context, arg ListAccountsPageAscParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsPageAsc,
+		arg.Owner,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.Currency,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.SystemPurpose,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.AvailableBalance,
+			&i.FrozenBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsPageDesc = `-- name: ListAccountsPageDesc :many
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+FROM accounts
+WHERE owner = $1
+    AND id < $2
+    AND created_at >= $3
+    AND created_at < $4
+    AND balance BETWEEN $5 AND $6
+    AND ($7::varchar = '' OR currency = $7)
+ORDER BY id DESC
+LIMIT $8
+`
+
+type ListAccountsPageDescParams struct {
+	Owner      string    `json:"owner"`
+	AfterID    int64     `json:"after_id"`
+	FromTime   time.Time `json:"from_time"`
+	ToTime     time.Time `json:"to_time"`
+	MinBalance int64     `json:"min_balance"`
+	MaxBalance int64     `json:"max_balance"`
+	Currency   string    `json:"currency"`
+	LimitCount int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListAccountsPageDesc(ctx context.Context, arg ListAccountsPageDescParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsPageDesc,
+		arg.Owner,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinBalance,
+		arg.MaxBalance,
+		arg.Currency,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.SystemPurpose,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.AvailableBalance,
+			&i.FrozenBy,
 		); err != nil {
 			return nil, err
 		}
@@ -158,11 +487,70 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
+const setAccountOverdraftLimit = `-- name: SetAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $1
+WHERE id = $2 AND system_purpose IS NULL
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+`
+
+type SetAccountOverdraftLimitParams struct {
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	ID             int64 `json:"id"`
+}
+
+func (q *Queries) SetAccountOverdraftLimit(ctx context.Context, arg SetAccountOverdraftLimitParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, setAccountOverdraftLimit, arg.OverdraftLimit, arg.ID)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
+const unfreezeAccount = `-- name: UnfreezeAccount :one
+UPDATE accounts
+SET status = 'active', frozen_by = NULL
+WHERE id = $1 AND status = 'frozen'
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
+`
+
+func (q *Queries) UnfreezeAccount(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, unfreezeAccount, id)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
+	)
+	return i, err
+}
+
 const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2 
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by
 `
 
 type UpdateAccountParams struct {
@@ -179,6 +567,13 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.Status,
+		&i.ClosedAt,
+		&i.SystemPurpose,
+		&i.OverdraftLimit,
+		&i.HeldAmount,
+		&i.AvailableBalance,
+		&i.FrozenBy,
 	)
 	return i, err
 }
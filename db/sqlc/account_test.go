@@ -14,9 +14,10 @@ import (
 func createRandomAccount(t *testing.T) Account {
 	user := createRandomUser(t)
 
+	//! accounts have enough money for the transfers of the tests, which can't overdraw them
 	arg := CreateAccountParams{
 		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
+		Balance:  util.RandomInt(100, 1000),
 		Currency: util.RandomCurrency(),
 	}
 
@@ -31,6 +32,8 @@ func createRandomAccount(t *testing.T) Account {
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
+	require.Equal(t, AccountActive, account.Status)
+	require.Nil(t, account.ClosedAt)
 
 	return account
 }
@@ -108,3 +111,52 @@ func TestListAccounts(t *testing.T) {
 		require.Equal(t, lastAccount.Owner, acc.Owner)
 	}
 }
+
+// TestFreezeAccount tests FreezeAccount and UnfreezeAccount funcs, only active accounts can be frozen
+func TestFreezeAccount(t *testing.T) {
+	account := createRandomAccount(t)
+
+	arg := FreezeAccountParams{
+		ID:       account.ID,
+		FrozenBy: &account.Owner,
+	}
+
+	frozen, err := testQueries.FreezeAccount(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, AccountFrozen, frozen.Status)
+	require.Equal(t, &account.Owner, frozen.FrozenBy)
+
+	_, err = testQueries.FreezeAccount(context.Background(), arg)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	active, err := testQueries.UnfreezeAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountActive, active.Status)
+	require.Nil(t, active.FrozenBy)
+
+	_, err = testQueries.UnfreezeAccount(context.Background(), account.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestCloseAccount tests CloseAccount func, the owner can open an account with the same currency after closing it
+func TestCloseAccount(t *testing.T) {
+	account := createRandomAccount(t)
+
+	closed, err := testQueries.CloseAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountClosed, closed.Status)
+	require.NotNil(t, closed.ClosedAt)
+
+	_, err = testQueries.CloseAccount(context.Background(), account.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	_, err = testQueries.FreezeAccount(context.Background(), FreezeAccountParams{ID: account.ID})
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+
+	reopened, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account.Owner,
+		Currency: account.Currency,
+	})
+	require.NoError(t, err)
+	require.Equal(t, AccountActive, reopened.Status)
+}
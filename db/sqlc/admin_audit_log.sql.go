@@ -0,0 +1,94 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: admin_audit_log.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createAdminAuditLog = `-- name: CreateAdminAuditLog :one
+INSERT INTO admin_audit_logs (
+    admin_username,
+    action,
+    target_type,
+    target_id,
+    reason
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, admin_username, action, target_type, target_id, reason, created_at
+`
+
+type CreateAdminAuditLogParams struct {
+	AdminUsername string `json:"admin_username"`
+	Action        string `json:"action"`
+	TargetType    string `json:"target_type"`
+	TargetID      string `json:"target_id"`
+	Reason        string `json:"reason"`
+}
+
+func (q *Queries) CreateAdminAuditLog(ctx context.Context, arg CreateAdminAuditLogParams) (AdminAuditLog, error) {
+	row := q.db.QueryRowContext(ctx, createAdminAuditLog,
+		arg.AdminUsername,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Reason,
+	)
+	var i AdminAuditLog
+	err := row.Scan(
+		&i.ID,
+		&i.AdminUsername,
+		&i.Action,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAdminAuditLogs = `-- name: ListAdminAuditLogs :many
+SELECT id, admin_username, action, target_type, target_id, reason, created_at FROM admin_audit_logs
+ORDER BY id DESC
+LIMIT $1
+OFFSET $2
+`
+
+type ListAdminAuditLogsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListAdminAuditLogs(ctx context.Context, arg ListAdminAuditLogsParams) ([]AdminAuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, listAdminAuditLogs, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AdminAuditLog{}
+	for rows.Next() {
+		var i AdminAuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.AdminUsername,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomAdminAuditLog creates an audit log of a new admin for a new account
+func createRandomAdminAuditLog(t *testing.T) AdminAuditLog {
+	admin := createRandomUser(t)
+	acc := createRandomAccount(t)
+
+	arg := CreateAdminAuditLogParams{
+		AdminUsername: admin.Username,
+		Action:        AdminActionListAccountEntries,
+		TargetType:    AuditTargetAccount,
+		TargetID:      strconv.FormatInt(acc.ID, 10),
+		Reason:        util.RandomString(12),
+	}
+
+	log, err := testQueries.CreateAdminAuditLog(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.NotZero(t, log.ID)
+	require.Equal(t, arg.AdminUsername, log.AdminUsername)
+	require.Equal(t, arg.Action, log.Action)
+	require.Equal(t, arg.TargetType, log.TargetType)
+	require.Equal(t, arg.TargetID, log.TargetID)
+	require.Equal(t, arg.Reason, log.Reason)
+	require.NotZero(t, log.CreatedAt)
+
+	return log
+}
+
+// TestCreateAdminAuditLog tests CreateAdminAuditLog func
+func TestCreateAdminAuditLog(t *testing.T) {
+	createRandomAdminAuditLog(t)
+}
+
+// TestListAdminAuditLogs tests ListAdminAuditLogs func, the latest logs are listed first
+func TestListAdminAuditLogs(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		createRandomAdminAuditLog(t)
+	}
+
+	logs, err := testQueries.ListAdminAuditLogs(context.Background(), ListAdminAuditLogsParams{
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, logs, 5)
+
+	for i := 1; i < len(logs); i++ {
+		require.Greater(t, logs[i-1].ID, logs[i].ID)
+	}
+}
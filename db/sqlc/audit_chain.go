@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// channels which the mutations are initiated through
+const (
+	AuditChannelHTTP      = "http"
+	AuditChannelGRPC      = "grpc"
+	AuditChannelGateway   = "gateway"
+	AuditChannelScheduler = "scheduler"
+	AuditChannelSweeper   = "sweeper"
+)
+
+// actions of the audit events which are written with the mutations
+const (
+	AuditEventTransfer          = "transfer"
+	AuditEventFxTransfer        = "fx_transfer"
+	AuditEventReverseTransfer   = "reverse_transfer"
+	AuditEventScheduledTransfer = "scheduled_transfer"
+	AuditEventEntry             = "entry"
+	AuditEventFreezeAccount     = "freeze_account"
+	AuditEventUnfreezeAccount   = "unfreeze_account"
+	AuditEventCloseAccount      = "close_account"
+	AuditEventAdjustBalance     = "adjust_balance"
+	AuditEventSetOverdraftLimit = "set_overdraft_limit"
+	AuditEventUnblockSession    = "unblock_session"
+	AuditEventCreateHold        = "create_hold"
+	AuditEventCaptureHold       = "capture_hold"
+	AuditEventVoidHold          = "void_hold"
+	AuditEventExpireHold        = "expire_hold"
+
+	AuditEventAcceptPaymentRequest = "accept_payment_request"
+)
+
+// auditVerifyBatchSize is how many audit events are read at once while the chain is verified
+const auditVerifyBatchSize = 1000
+
+// * AuditActor identifies who initiated a mutation, from which client and through which channel
+type AuditActor struct {
+	Username  string
+	Channel   string
+	ClientIP  string
+	UserAgent string
+}
+
+// * AuditChainReport holds the result of the verification of the audit chain,
+// * BrokenEvent is the first event which breaks the chain and nil if the chain is intact
+type AuditChainReport struct {
+	Checked     int64       `json:"checked"`
+	BrokenEvent *AuditEvent `json:"broken_event"`
+	Reason      string      `json:"reason"`
+}
+
+// * auditEventContent is the part of an audit event which is hashed, so its fields must not be reordered
+type auditEventContent struct {
+	PrevHash   string `json:"prev_hash"`
+	Actor      string `json:"actor"`
+	Channel    string `json:"channel"`
+	ClientIP   string `json:"client_ip"`
+	UserAgent  string `json:"user_agent"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Details    string `json:"details"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// * hashAuditEvent returns the hash of the event which chains it to the previous one with PrevHash
+func hashAuditEvent(event AuditEvent) (string, error) {
+	content, err := json.Marshal(auditEventContent{
+		PrevHash:   event.PrevHash,
+		Actor:      event.Actor,
+		Channel:    event.Channel,
+		ClientIP:   event.ClientIp,
+		UserAgent:  event.UserAgent,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Details:    event.Details,
+		CreatedAt:  event.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// * appendAuditEvent writes the audit event of a mutation with the given queries, so it is committed or
+// * rolled back with the mutation. The chain is locked until the transaction ends, so events are chained
+// * in the order they are committed
+func appendAuditEvent(ctx context.Context, q *Queries, actor *AuditActor, action, targetType, targetID string, details interface{}) error {
+	if actor == nil {
+		actor = &AuditActor{}
+	}
+
+	detailsJSON, err := json.Marshal(details)
+
+	if err != nil {
+		return err
+	}
+
+	if err := q.LockAuditChain(ctx); err != nil {
+		return err
+	}
+
+	event := AuditEvent{
+		Actor:      actor.Username,
+		Channel:    actor.Channel,
+		ClientIp:   actor.ClientIP,
+		UserAgent:  actor.UserAgent,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Details:    string(detailsJSON),
+		// postgres keeps microseconds, so we hash what is read back later
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	last, err := q.GetLastAuditEvent(ctx)
+
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	event.PrevHash = last.Hash
+	event.Hash, err = hashAuditEvent(event)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateAuditEvent(ctx, CreateAuditEventParams{
+		Actor:      event.Actor,
+		Channel:    event.Channel,
+		ClientIp:   event.ClientIp,
+		UserAgent:  event.UserAgent,
+		Action:     event.Action,
+		TargetType: event.TargetType,
+		TargetID:   event.TargetID,
+		Details:    event.Details,
+		PrevHash:   event.PrevHash,
+		Hash:       event.Hash,
+		CreatedAt:  event.CreatedAt,
+	})
+
+	return err
+}
+
+// * VerifyAuditChain walks the audit events from the first one and checks that each event is chained to the
+// * previous one and its hash matches its content. It stops at the first broken link and reports it
+func (store *SQLStore) VerifyAuditChain(ctx context.Context) (AuditChainReport, error) {
+	var report AuditChainReport
+	var prevHash string
+	var lastID int64
+
+	for {
+		events, err := store.ListAuditEventsAfter(ctx, ListAuditEventsAfterParams{
+			ID:    lastID,
+			Limit: auditVerifyBatchSize,
+		})
+
+		if err != nil {
+			return report, err
+		}
+
+		for i := range events {
+			event := events[i]
+
+			hash, err := hashAuditEvent(event)
+
+			if err != nil {
+				return report, err
+			}
+
+			switch {
+			case event.PrevHash != prevHash:
+				report.BrokenEvent = &event
+				report.Reason = "previous hash doesn't match the hash of the previous event"
+			case event.Hash != hash:
+				report.BrokenEvent = &event
+				report.Reason = "hash doesn't match the content of the event"
+			}
+
+			if report.BrokenEvent != nil {
+				return report, nil
+			}
+
+			report.Checked++
+			prevHash = event.Hash
+			lastID = event.ID
+		}
+
+		if len(events) < auditVerifyBatchSize {
+			return report, nil
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashAuditEvent(t *testing.T) {
+	event := AuditEvent{
+		Actor:      util.RandomOwner(),
+		Channel:    AuditChannelHTTP,
+		ClientIp:   "127.0.0.1",
+		UserAgent:  util.RandomString(12),
+		Action:     AuditEventTransfer,
+		TargetType: AuditTargetTransfer,
+		TargetID:   "1",
+		Details:    `{"amount":10}`,
+		PrevHash:   util.RandomString(64),
+		CreatedAt:  time.Now().Truncate(time.Microsecond),
+	}
+
+	hash, err := hashAuditEvent(event)
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	//! hash is the same for the same content
+	sameHash, err := hashAuditEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	//! hash doesn't depend on the time zone which the time is read in
+	event.CreatedAt = event.CreatedAt.In(time.FixedZone("test", 3*60*60))
+	sameHash, err = hashAuditEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	//! stored hash and ID aren't part of the content
+	event.Hash = util.RandomString(64)
+	event.ID = util.RandomInt(1, 1000)
+	sameHash, err = hashAuditEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, hash, sameHash)
+
+	//! any change of the content or the previous hash changes the hash
+	testCases := []struct {
+		name   string
+		tamper func(event *AuditEvent)
+	}{
+		{name: "previous hash", tamper: func(event *AuditEvent) { event.PrevHash = util.RandomString(64) }},
+		{name: "actor", tamper: func(event *AuditEvent) { event.Actor = util.RandomOwner() }},
+		{name: "channel", tamper: func(event *AuditEvent) { event.Channel = AuditChannelGateway }},
+		{name: "client ip", tamper: func(event *AuditEvent) { event.ClientIp = "10.0.0.1" }},
+		{name: "user agent", tamper: func(event *AuditEvent) { event.UserAgent = util.RandomString(13) }},
+		{name: "action", tamper: func(event *AuditEvent) { event.Action = AuditEventReverseTransfer }},
+		{name: "target type", tamper: func(event *AuditEvent) { event.TargetType = AuditTargetAccount }},
+		{name: "target id", tamper: func(event *AuditEvent) { event.TargetID = "2" }},
+		{name: "details", tamper: func(event *AuditEvent) { event.Details = `{"amount":1000}` }},
+		{name: "created at", tamper: func(event *AuditEvent) { event.CreatedAt = event.CreatedAt.Add(time.Microsecond) }},
+		//! fields are separated, so content can't be moved from one field to the next
+		{name: "shifted content", tamper: func(event *AuditEvent) {
+			event.Actor += event.Channel
+			event.Channel = ""
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := event
+			tc.tamper(&tampered)
+
+			tamperedHash, err := hashAuditEvent(tampered)
+			require.NoError(t, err)
+			require.NotEqual(t, hash, tamperedHash)
+		})
+	}
+}
+
+func TestTransferTxAppendsAuditEvent(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	actor := &AuditActor{
+		Username:  acc1.Owner,
+		Channel:   AuditChannelGRPC,
+		ClientIP:  "127.0.0.1",
+		UserAgent: util.RandomString(12),
+	}
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        10,
+		Actor:         actor,
+	})
+	require.NoError(t, err)
+
+	event, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, actor.Username, event.Actor)
+	require.Equal(t, actor.Channel, event.Channel)
+	require.Equal(t, actor.ClientIP, event.ClientIp)
+	require.Equal(t, actor.UserAgent, event.UserAgent)
+	require.Equal(t, AuditEventTransfer, event.Action)
+	require.Equal(t, AuditTargetTransfer, event.TargetType)
+	require.Equal(t, strconv.FormatInt(result.Transfer.ID, 10), event.TargetID)
+	require.NotEmpty(t, event.Details)
+
+	hash, err := hashAuditEvent(event)
+	require.NoError(t, err)
+	require.Equal(t, hash, event.Hash)
+
+	//! failed transfers don't append audit events
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID + 1000000,
+		Amount:        10,
+		Actor:         actor,
+	})
+	require.Error(t, err)
+
+	last, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, event.ID, last.ID)
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	store := NewStore(testDB)
+
+	_, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: createRandomAccount(t).ID,
+		Amount:    10,
+	})
+	require.NoError(t, err)
+
+	report, err := store.VerifyAuditChain(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, report.BrokenEvent)
+	require.Empty(t, report.Reason)
+	require.Positive(t, report.Checked)
+
+	//! events can't be changed once they are written
+	_, err = testDB.ExecContext(context.Background(), "UPDATE audit_events SET details = '{}' WHERE id = (SELECT max(id) FROM audit_events)")
+	require.Error(t, err)
+}
+
+// tamperAuditEvent sets a column of the audit event while the trigger which rejects the changes is disabled,
+// the column is set back to its original value when the test ends, so the chain stays intact for the other tests
+func tamperAuditEvent(t *testing.T, id int64, column, value, original string) {
+	setColumn := func(value string) error {
+		tx, err := testDB.BeginTx(context.Background(), nil)
+
+		if err != nil {
+			return err
+		}
+
+		defer tx.Rollback()
+
+		_, err = tx.ExecContext(context.Background(), `ALTER TABLE audit_events DISABLE TRIGGER audit_events_no_update_or_delete`)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(context.Background(), fmt.Sprintf(`UPDATE audit_events SET %s = $1 WHERE id = $2`, column), value, id)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(context.Background(), `ALTER TABLE audit_events ENABLE TRIGGER audit_events_no_update_or_delete`)
+
+		if err != nil {
+			return err
+		}
+
+		return tx.Commit()
+	}
+
+	require.NoError(t, setColumn(value))
+
+	t.Cleanup(func() {
+		require.NoError(t, setColumn(original))
+	})
+}
+
+func TestVerifyAuditChainTampered(t *testing.T) {
+	store := NewStore(testDB)
+
+	_, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: createRandomAccount(t).ID,
+		Amount:    10,
+	})
+	require.NoError(t, err)
+
+	event, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		column   string
+		value    string
+		original string
+		reason   string
+	}{
+		{
+			name:     "Changed field",
+			column:   "details",
+			value:    `{"amount":1000}`,
+			original: event.Details,
+			reason:   "hash doesn't match the content of the event",
+		},
+		{
+			name:     "Broken link",
+			column:   "prev_hash",
+			value:    util.RandomString(64),
+			original: event.PrevHash,
+			reason:   "previous hash doesn't match the hash of the previous event",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tamperAuditEvent(t, event.ID, tc.column, tc.value, tc.original)
+
+			report, err := store.VerifyAuditChain(context.Background())
+			require.NoError(t, err)
+			require.NotNil(t, report.BrokenEvent)
+			require.Equal(t, event.ID, report.BrokenEvent.ID)
+			require.Equal(t, tc.reason, report.Reason)
+		})
+	}
+
+	//! chain is intact again once the events are set back
+	report, err := store.VerifyAuditChain(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, report.BrokenEvent)
+}
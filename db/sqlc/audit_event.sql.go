@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: audit_event.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :one
+INSERT INTO audit_events (
+    actor,
+    channel,
+    client_ip,
+    user_agent,
+    action,
+    target_type,
+    target_id,
+    details,
+    prev_hash,
+    hash,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id, actor, channel, client_ip, user_agent, action, target_type, target_id, details, prev_hash, hash, created_at
+`
+
+type CreateAuditEventParams struct {
+	Actor      string    `json:"actor"`
+	Channel    string    `json:"channel"`
+	ClientIp   string    `json:"client_ip"`
+	UserAgent  string    `json:"user_agent"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"target_type"`
+	TargetID   string    `json:"target_id"`
+	Details    string    `json:"details"`
+	PrevHash   string    `json:"prev_hash"`
+	Hash       string    `json:"hash"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error) {
+	row := q.db.QueryRowContext(ctx, createAuditEvent,
+		arg.Actor,
+		arg.Channel,
+		arg.ClientIp,
+		arg.UserAgent,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Details,
+		arg.PrevHash,
+		arg.Hash,
+		arg.CreatedAt,
+	)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.Actor,
+		&i.Channel,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.Action,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Details,
+		&i.PrevHash,
+		&i.Hash,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLastAuditEvent = `-- name: GetLastAuditEvent :one
+SELECT id, actor, channel, client_ip, user_agent, action, target_type, target_id, details, prev_hash, hash, created_at FROM audit_events
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLastAuditEvent(ctx context.Context) (AuditEvent, error) {
+	row := q.db.QueryRowContext(ctx, getLastAuditEvent)
+	var i AuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.Actor,
+		&i.Channel,
+		&i.ClientIp,
+		&i.UserAgent,
+		&i.Action,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Details,
+		&i.PrevHash,
+		&i.Hash,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAuditEventsAfter = `-- name: ListAuditEventsAfter :many
+SELECT id, actor, channel, client_ip, user_agent, action, target_type, target_id, details, prev_hash, hash, created_at FROM audit_events
+WHERE id > $1
+ORDER BY id
+LIMIT $2
+`
+
+type ListAuditEventsAfterParams struct {
+	ID    int64 `json:"id"`
+	Limit int32 `json:"limit"`
+}
+
+func (q *Queries) ListAuditEventsAfter(ctx context.Context, arg ListAuditEventsAfterParams) ([]AuditEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEventsAfter, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditEvent{}
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Actor,
+			&i.Channel,
+			&i.ClientIp,
+			&i.UserAgent,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Details,
+			&i.PrevHash,
+			&i.Hash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAuditChain = `-- name: LockAuditChain :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_events'))
+`
+
+func (q *Queries) LockAuditChain(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, lockAuditChain)
+	return err
+}
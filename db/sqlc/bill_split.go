@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+var ErrParticipantNotFound = errors.New("participant of the split not found")
+var ErrSplitSharesMismatch = errors.New("shares of the split must add up to its total amount")
+var ErrSplitWithoutPayers = errors.New("split needs at least one other participant with a share to pay")
+
+// statuses of the settlement of a bill split, it is open while any of its payment requests is pending
+// and settled once all of them are accepted. Incomplete splits have requests which are declined, cancelled or expired
+const (
+	BillSplitOpen       = "open"
+	BillSplitSettled    = "settled"
+	BillSplitIncomplete = "incomplete"
+)
+
+// * BillSplitShare is the amount which a participant of a split pays
+type BillSplitShare struct {
+	Username string `json:"username"`
+	Amount   int64  `json:"amount"`
+}
+
+// * CreateBillSplitTxParams hold the input values for splitting a bill of the initiator between the participants.
+// * Shares must add up to the total amount, the share of the initiator isn't requested since the initiator paid the bill
+type CreateBillSplitTxParams struct {
+	Initiator   string           `json:"initiator"`
+	TotalAmount int64            `json:"total_amount"`
+	Currency    string           `json:"currency"`
+	Memo        string           `json:"memo"`
+	Rule        string           `json:"rule"`
+	Shares      []BillSplitShare `json:"shares"`
+	ExpiresIn   time.Duration    `json:"expires_in"`
+}
+
+// * BillSplitProgress holds how much of a bill split is paid into the account of the initiator.
+// * Outstanding amount is the sum of the pending payment requests
+type BillSplitProgress struct {
+	Status            string `json:"status"`
+	Requests          int    `json:"requests"`
+	Accepted          int    `json:"accepted"`
+	PaidAmount        int64  `json:"paid_amount"`
+	OutstandingAmount int64  `json:"outstanding_amount"`
+}
+
+// * BillSplitDetail holds a bill split with its payment requests and its settlement progress
+type BillSplitDetail struct {
+	BillSplit       BillSplit         `json:"bill_split"`
+	PaymentRequests []PaymentRequest  `json:"payment_requests"`
+	Progress        BillSplitProgress `json:"progress"`
+}
+
+// * CreateBillSplitTx creates a bill split and a linked payment request for the share of each participant
+// * except the initiator within a single database transaction, the requests are paid into the initiator's account
+// * in the currency
+func (store *SQLStore) CreateBillSplitTx(ctx context.Context, arg CreateBillSplitTxParams) (BillSplitDetail, error) {
+	var result BillSplitDetail
+
+	var sum int64
+
+	for _, share := range arg.Shares {
+		sum += share.Amount
+	}
+
+	if sum != arg.TotalAmount {
+		return result, ErrSplitSharesMismatch
+	}
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		toAccount, err := q.GetOwnerAccount(ctx, GetOwnerAccountParams{
+			Owner:    arg.Initiator,
+			Currency: arg.Currency,
+		})
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrRequesterHasNoAccount
+			}
+			return err
+		}
+
+		split, err := q.CreateBillSplit(ctx, CreateBillSplitParams{
+			Initiator:   arg.Initiator,
+			ToAccountID: toAccount.ID,
+			TotalAmount: arg.TotalAmount,
+			Currency:    arg.Currency,
+			Memo:        arg.Memo,
+			Rule:        arg.Rule,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// retried transactions start over, so the requests of the failed run aren't kept
+		requests := []PaymentRequest{}
+
+		for _, share := range arg.Shares {
+			// participants whose share is rounded down to nothing have nothing to pay
+			if share.Username == arg.Initiator || share.Amount == 0 {
+				continue
+			}
+
+			if _, err := q.GetUser(ctx, share.Username); err != nil {
+				if err == sql.ErrNoRows {
+					return ErrParticipantNotFound
+				}
+				return err
+			}
+
+			request, err := newPaymentRequest(ctx, q, CreatePaymentRequestParams{
+				Requester:   arg.Initiator,
+				Payer:       share.Username,
+				ToAccountID: toAccount.ID,
+				Amount:      share.Amount,
+				Currency:    arg.Currency,
+				Memo:        arg.Memo,
+				SplitID:     &split.ID,
+				TtlSeconds:  int64(arg.ExpiresIn / time.Second),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			requests = append(requests, request)
+		}
+
+		if len(requests) == 0 {
+			return ErrSplitWithoutPayers
+		}
+
+		result = NewBillSplitDetail(split, requests)
+
+		return nil
+	})
+
+	return result, err
+}
+
+// * NewBillSplitDetail returns the bill split with its payment requests and the progress of its settlement
+func NewBillSplitDetail(split BillSplit, requests []PaymentRequest) BillSplitDetail {
+	progress := BillSplitProgress{
+		Status:   BillSplitSettled,
+		Requests: len(requests),
+	}
+
+	unpaid := false
+
+	for _, request := range requests {
+		switch request.Status {
+		case PaymentRequestAccepted:
+			progress.Accepted++
+			progress.PaidAmount += request.Amount
+		case PaymentRequestPending:
+			progress.OutstandingAmount += request.Amount
+			progress.Status = BillSplitOpen
+		default:
+			unpaid = true
+		}
+	}
+
+	if unpaid && progress.Status != BillSplitOpen {
+		progress.Status = BillSplitIncomplete
+	}
+
+	return BillSplitDetail{
+		BillSplit:       split,
+		PaymentRequests: requests,
+		Progress:        progress,
+	}
+}
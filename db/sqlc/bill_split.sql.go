@@ -0,0 +1,172 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: bill_split.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createBillSplit = `-- name: CreateBillSplit :one
+INSERT INTO bill_splits(
+    initiator,
+    to_account_id,
+    total_amount,
+    currency,
+    memo,
+    rule
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, initiator, to_account_id, total_amount, currency, memo, rule, created_at
+`
+
+type CreateBillSplitParams struct {
+	Initiator   string `json:"initiator"`
+	ToAccountID int64  `json:"to_account_id"`
+	TotalAmount int64  `json:"total_amount"`
+	Currency    string `json:"currency"`
+	Memo        string `json:"memo"`
+	Rule        string `json:"rule"`
+}
+
+func (q *Queries) CreateBillSplit(ctx context.Context, arg CreateBillSplitParams) (BillSplit, error) {
+	row := q.db.QueryRowContext(ctx, createBillSplit,
+		arg.Initiator,
+		arg.ToAccountID,
+		arg.TotalAmount,
+		arg.Currency,
+		arg.Memo,
+		arg.Rule,
+	)
+	var i BillSplit
+	err := row.Scan(
+		&i.ID,
+		&i.Initiator,
+		&i.ToAccountID,
+		&i.TotalAmount,
+		&i.Currency,
+		&i.Memo,
+		&i.Rule,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBillSplit = `-- name: GetBillSplit :one
+SELECT id, initiator, to_account_id, total_amount, currency, memo, rule, created_at
+FROM bill_splits
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetBillSplit(ctx context.Context, id int64) (BillSplit, error) {
+	row := q.db.QueryRowContext(ctx, getBillSplit, id)
+	var i BillSplit
+	err := row.Scan(
+		&i.ID,
+		&i.Initiator,
+		&i.ToAccountID,
+		&i.TotalAmount,
+		&i.Currency,
+		&i.Memo,
+		&i.Rule,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listBillSplitsPageAsc = `-- name: ListBillSplitsPageAsc :many
+SELECT id, initiator, to_account_id, total_amount, currency, memo, rule, created_at
+FROM bill_splits
+WHERE initiator = $1
+    AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type ListBillSplitsPageAscParams struct {
+	Initiator  string `json:"initiator"`
+	AfterID    int64  `json:"after_id"`
+	LimitCount int32  `json:"limit_count"`
+}
+
+func (q *Queries) ListBillSplitsPageAsc(ctx context.Context, arg ListBillSplitsPageAscParams) ([]BillSplit, error) {
+	rows, err := q.db.QueryContext(ctx, listBillSplitsPageAsc, arg.Initiator, arg.AfterID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BillSplit{}
+	for rows.Next() {
+		var i BillSplit
+		if err := rows.Scan(
+			&i.ID,
+			&i.Initiator,
+			&i.ToAccountID,
+			&i.TotalAmount,
+			&i.Currency,
+			&i.Memo,
+			&i.Rule,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBillSplitsPageDesc = `-- name: ListBillSplitsPageDesc :many
+SELECT id, initiator, to_account_id, total_amount, currency, memo, rule, created_at
+FROM bill_splits
+WHERE initiator = $1
+    AND id < $2
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListBillSplitsPageDescParams struct {
+	Initiator  string `json:"initiator"`
+	AfterID    int64  `json:"after_id"`
+	LimitCount int32  `json:"limit_count"`
+}
+
+func (q *Queries) ListBillSplitsPageDesc(ctx context.Context, arg ListBillSplitsPageDescParams) ([]BillSplit, error) {
+	rows, err := q.db.QueryContext(ctx, listBillSplitsPageDesc, arg.Initiator, arg.AfterID, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BillSplit{}
+	for rows.Next() {
+		var i BillSplit
+		if err := rows.Scan(
+			&i.ID,
+			&i.Initiator,
+			&i.ToAccountID,
+			&i.TotalAmount,
+			&i.Currency,
+			&i.Memo,
+			&i.Rule,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
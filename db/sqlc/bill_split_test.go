@@ -0,0 +1,216 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateBillSplitTx tests that a bill split requests the shares of the other participants and tracks their payments
+func TestCreateBillSplitTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	initiator := createAccountWithBalance(t, 0)
+	payer1 := createAccountWithBalance(t, 500)
+	payer2 := createAccountWithBalance(t, 500)
+
+	detail, err := store.CreateBillSplitTx(context.Background(), CreateBillSplitTxParams{
+		Initiator:   initiator.Owner,
+		TotalAmount: 1000,
+		Currency:    util.USD,
+		Memo:        util.RandomString(10),
+		Rule:        "equal",
+		Shares: []BillSplitShare{
+			{Username: initiator.Owner, Amount: 334},
+			{Username: payer1.Owner, Amount: 333},
+			{Username: payer2.Owner, Amount: 333},
+		},
+		ExpiresIn: time.Hour,
+	})
+	require.NoError(t, err)
+
+	require.NotZero(t, detail.BillSplit.ID)
+	require.Equal(t, initiator.ID, detail.BillSplit.ToAccountID)
+	require.Equal(t, int64(1000), detail.BillSplit.TotalAmount)
+
+	//! the initiator isn't asked for its own share
+	require.Len(t, detail.PaymentRequests, 2)
+
+	for i, payer := range []Account{payer1, payer2} {
+		request := detail.PaymentRequests[i]
+
+		require.Equal(t, payer.Owner, request.Payer)
+		require.Equal(t, initiator.ID, request.ToAccountID)
+		require.Equal(t, int64(333), request.Amount)
+		require.Equal(t, detail.BillSplit.ID, *request.SplitID)
+	}
+
+	require.Equal(t, BillSplitProgress{Status: BillSplitOpen, Requests: 2, OutstandingAmount: 666}, detail.Progress)
+
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: detail.PaymentRequests[0].ID,
+	})
+	require.NoError(t, err)
+
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: detail.PaymentRequests[1].ID,
+	})
+	require.NoError(t, err)
+
+	requests, err := store.ListSplitPaymentRequests(context.Background(), &detail.BillSplit.ID)
+	require.NoError(t, err)
+
+	progress := NewBillSplitDetail(detail.BillSplit, requests).Progress
+	require.Equal(t, BillSplitProgress{Status: BillSplitSettled, Requests: 2, Accepted: 2, PaidAmount: 666}, progress)
+
+	requireBalance(t, initiator.ID, 666)
+}
+
+// TestCreateBillSplitTxInvalid tests that a bill split is created either with all of its payment requests or not at all
+func TestCreateBillSplitTxInvalid(t *testing.T) {
+	store := NewStore(testDB)
+
+	initiator := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 500)
+
+	testCases := []struct {
+		name        string
+		currency    string
+		shares      []BillSplitShare
+		expectedErr error
+	}{
+		{
+			name:        "shares mismatch",
+			currency:    util.USD,
+			shares:      []BillSplitShare{{Username: payer.Owner, Amount: 999}},
+			expectedErr: ErrSplitSharesMismatch,
+		},
+		{
+			name:        "participant not found",
+			currency:    util.USD,
+			shares:      []BillSplitShare{{Username: payer.Owner, Amount: 500}, {Username: util.RandomOwner(), Amount: 500}},
+			expectedErr: ErrParticipantNotFound,
+		},
+		{
+			name:        "without payers",
+			currency:    util.USD,
+			shares:      []BillSplitShare{{Username: initiator.Owner, Amount: 1000}, {Username: payer.Owner, Amount: 0}},
+			expectedErr: ErrSplitWithoutPayers,
+		},
+		{
+			name:        "initiator has no account",
+			currency:    util.EUR,
+			shares:      []BillSplitShare{{Username: payer.Owner, Amount: 1000}},
+			expectedErr: ErrRequesterHasNoAccount,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := store.CreateBillSplitTx(context.Background(), CreateBillSplitTxParams{
+				Initiator:   initiator.Owner,
+				TotalAmount: 1000,
+				Currency:    tc.currency,
+				Rule:        "exact",
+				Shares:      tc.shares,
+				ExpiresIn:   time.Hour,
+			})
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+
+	//! the payment request of the payer is rolled back with the split
+	requests, err := store.ListPaymentRequestsPage(context.Background(), ListPaymentRequestsPageParams{
+		Username:   payer.Owner,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	splits, err := store.ListBillSplitsPage(context.Background(), ListBillSplitsPageParams{
+		Initiator:  initiator.Owner,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Empty(t, splits)
+}
+
+// TestNewBillSplitDetail tests the settlement status of bill splits by the statuses of their payment requests
+func TestNewBillSplitDetail(t *testing.T) {
+	testCases := []struct {
+		name     string
+		statuses []string
+		expected BillSplitProgress
+	}{
+		{
+			name:     "open",
+			statuses: []string{PaymentRequestAccepted, PaymentRequestPending, PaymentRequestDeclined},
+			expected: BillSplitProgress{Status: BillSplitOpen, Requests: 3, Accepted: 1, PaidAmount: 10, OutstandingAmount: 10},
+		},
+		{
+			name:     "settled",
+			statuses: []string{PaymentRequestAccepted, PaymentRequestAccepted},
+			expected: BillSplitProgress{Status: BillSplitSettled, Requests: 2, Accepted: 2, PaidAmount: 20},
+		},
+		{
+			name:     "incomplete",
+			statuses: []string{PaymentRequestAccepted, PaymentRequestExpired},
+			expected: BillSplitProgress{Status: BillSplitIncomplete, Requests: 2, Accepted: 1, PaidAmount: 10},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := make([]PaymentRequest, len(tc.statuses))
+
+			for i, status := range tc.statuses {
+				requests[i] = PaymentRequest{Amount: 10, Status: status}
+			}
+
+			require.Equal(t, tc.expected, NewBillSplitDetail(BillSplit{}, requests).Progress)
+		})
+	}
+}
+
+// TestListBillSplitsPage tests that the bill splits of the initiator are listed in both directions
+func TestListBillSplitsPage(t *testing.T) {
+	store := NewStore(testDB)
+
+	initiator := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 0)
+
+	ids := make([]int64, 2)
+
+	for i := range ids {
+		detail, err := store.CreateBillSplitTx(context.Background(), CreateBillSplitTxParams{
+			Initiator:   initiator.Owner,
+			TotalAmount: 100,
+			Currency:    util.USD,
+			Rule:        "exact",
+			Shares:      []BillSplitShare{{Username: payer.Owner, Amount: 100}},
+			ExpiresIn:   time.Hour,
+		})
+		require.NoError(t, err)
+
+		ids[i] = detail.BillSplit.ID
+	}
+
+	splits, err := store.ListBillSplitsPage(context.Background(), ListBillSplitsPageParams{
+		Initiator:  initiator.Owner,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, splits, 2)
+	require.Equal(t, ids[0], splits[0].ID)
+
+	splits, err = store.ListBillSplitsPage(context.Background(), ListBillSplitsPageParams{
+		Initiator:  initiator.Owner,
+		PageParams: PageParams{Descending: true, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, splits, 1)
+	require.Equal(t, ids[1], splits[0].ID)
+}
@@ -0,0 +1,190 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTransferTxConcurrentInsufficientFunds tests that concurrent transfers from the same account can't overdraw it together
+func TestTransferTxConcurrentInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	//! 20 transfers of 10 are sent at once, only the money of 10 of them is in the account
+	errs := runConcurrently(20, func(i int) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   recipient.ID,
+			Amount:        10,
+		})
+		return err
+	})
+
+	require.Equal(t, 10, countSucceeded(t, errs))
+
+	requireBalance(t, account.ID, 0)
+	requireBalance(t, recipient.ID, 100)
+}
+
+// TestTransferTxConcurrentOverdraft tests that concurrent transfers take the account down to its overdraft limit and no further
+func TestTransferTxConcurrentOverdraft(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 50)
+	recipient := createAccountWithBalance(t, 0)
+
+	_, err := testQueries.SetAccountOverdraftLimit(context.Background(), SetAccountOverdraftLimitParams{
+		ID:             account.ID,
+		OverdraftLimit: 50,
+	})
+	require.NoError(t, err)
+
+	errs := runConcurrently(20, func(i int) error {
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   recipient.ID,
+			Amount:        10,
+		})
+		return err
+	})
+
+	require.Equal(t, 10, countSucceeded(t, errs))
+
+	requireBalance(t, account.ID, -50)
+	requireBalance(t, recipient.ID, 100)
+
+	//! deposits to an overdrawn account are accepted and pay the debt back
+	_, err = store.EntryTx(context.Background(), EntryTxParams{AccountID: account.ID, Amount: 20})
+	require.NoError(t, err)
+
+	requireBalance(t, account.ID, -30)
+}
+
+// TestConcurrentTransfersAndWithdrawals tests that transfers and withdrawals which race for the same balance
+// are checked one after the other
+func TestConcurrentTransfersAndWithdrawals(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	errs := runConcurrently(20, func(i int) error {
+		if i%2 == 0 {
+			_, err := store.EntryTx(context.Background(), EntryTxParams{AccountID: account.ID, Amount: -10})
+			return err
+		}
+
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   recipient.ID,
+			Amount:        10,
+		})
+		return err
+	})
+
+	require.Equal(t, 10, countSucceeded(t, errs))
+
+	requireBalance(t, account.ID, 0)
+}
+
+// TestTransferTxConcurrentBothWays tests that transfers between two accounts in both directions don't deadlock,
+// keep the total of the balances and never overdraw either account
+func TestTransferTxConcurrentBothWays(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createAccountWithBalance(t, 100)
+	acc2 := createAccountWithBalance(t, 100)
+
+	errs := runConcurrently(40, func(i int) error {
+		from, to := acc1, acc2
+
+		if i%2 == 1 {
+			from, to = acc2, acc1
+		}
+
+		result, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        30,
+		})
+
+		if err == nil && result.FromAccount.Balance < 0 {
+			t.Errorf("account %d is overdrawn: %d", from.ID, result.FromAccount.Balance)
+		}
+
+		return err
+	})
+
+	countSucceeded(t, errs)
+
+	updated1, err := testQueries.GetAccount(context.Background(), acc1.ID)
+	require.NoError(t, err)
+
+	updated2, err := testQueries.GetAccount(context.Background(), acc2.ID)
+	require.NoError(t, err)
+
+	require.Equal(t, int64(200), updated1.Balance+updated2.Balance)
+	require.GreaterOrEqual(t, updated1.Balance, int64(0))
+	require.GreaterOrEqual(t, updated2.Balance, int64(0))
+}
+
+// createAccountWithBalance creates a USD account with the given balance
+func createAccountWithBalance(t *testing.T, balance int64) Account {
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+
+	return account
+}
+
+// runConcurrently runs fn n times at once and returns the errors of the runs
+func runConcurrently(n int, fn func(i int) error) []error {
+	results := make(chan error, n)
+
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			results <- fn(i)
+		}(i)
+	}
+
+	errs := make([]error, n)
+
+	for i := range errs {
+		errs[i] = <-results
+	}
+
+	return errs
+}
+
+// countSucceeded returns how many runs succeeded, the others must have failed only because of the funds
+func countSucceeded(t *testing.T, errs []error) int {
+	succeeded := 0
+
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+
+		require.ErrorIs(t, err, ErrInsufficientFunds)
+	}
+
+	return succeeded
+}
+
+// requireBalance checks the balance of the account in the DB
+func requireBalance(t *testing.T, accountID int64, balance int64) {
+	account, err := testQueries.GetAccount(context.Background(), accountID)
+	require.NoError(t, err)
+	require.Equal(t, balance, account.Balance)
+}
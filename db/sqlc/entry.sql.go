@@ -7,36 +7,58 @@ package db
 
 import (
 	"context"
+	"time"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries(
     account_id,
-    amount
+    amount,
+    journal_id
 ) VALUES (
-    $1, $2
-) RETURNING id, account_id, amount, created_at
+    $1, $2, $3
+) RETURNING id, account_id, amount, created_at, journal_id
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID int64  `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	JournalID *int64 `json:"journal_id"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount, arg.JournalID)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.JournalID,
 	)
 	return i, err
 }
 
+const getAccountBalanceAt = `-- name: GetAccountBalanceAt :one
+SELECT COALESCE(sum(amount), 0)::bigint AS balance
+FROM entries
+WHERE account_id = $1 AND created_at < $2
+`
+
+type GetAccountBalanceAtParams struct {
+	AccountID int64     `json:"account_id"`
+	At        time.Time `json:"at"`
+}
+
+func (q *Queries) GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getAccountBalanceAt, arg.AccountID, arg.At)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at
+SELECT id, account_id, amount, created_at, journal_id
 FROM entries
 WHERE id = $1
 LIMIT 1
@@ -50,12 +72,13 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.JournalID,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at
+SELECT id, account_id, amount, created_at, journal_id
 FROM entries
 WHERE account_id = $1
 ORDER BY id
@@ -83,6 +106,313 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesByJournal = `-- name: ListEntriesByJournal :many
+SELECT id, account_id, amount, created_at, journal_id
+FROM entries
+WHERE journal_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListEntriesByJournal(ctx context.Context, journalID *int64) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesByJournal, journalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesByOwner = `-- name: ListEntriesByOwner :many
+SELECT entries.id, entries.account_id, entries.amount, entries.created_at, entries.journal_id
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+WHERE accounts.owner = $1
+ORDER BY entries.id
+LIMIT $2
+OFFSET $3
+`
+
+type ListEntriesByOwnerParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListEntriesByOwner(ctx context.Context, arg ListEntriesByOwnerParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesByOwner, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesPageAsc = `-- name: ListEntriesPageAsc :many
+SELECT entries.id, entries.account_id, entries.amount, entries.created_at, entries.journal_id
+FROM entries
+WHERE entries.account_id = $1
+    AND entries.id > $2
+    AND entries.created_at >= $3
+    AND entries.created_at < $4
+    AND entries.amount BETWEEN $5 AND $6
+    AND (
+        $7::varchar = ''
+        OR ($7 = 'credit' AND entries.amount > 0)
+        OR ($7 = 'debit' AND entries.amount < 0)
+    )
+    AND ($8::bigint = 0 OR EXISTS (
+        SELECT 1 FROM transfers
+        WHERE transfers.journal_id = entries.journal_id AND (
+            (transfers.from_account_id = entries.account_id AND transfers.to_account_id = $8)
+            OR (transfers.to_account_id = entries.account_id AND transfers.from_account_id = $8)
+        )
+    ))
+ORDER BY entries.id
+LIMIT $9
+`
+
+type ListEntriesPageAscParams struct {
+	AccountID             int64     `json:"account_id"`
+	AfterID               int64     `json:"after_id"`
+	FromTime              time.Time `json:"from_time"`
+	ToTime                time.Time `json:"to_time"`
+	MinAmount             int64     `json:"min_amount"`
+	MaxAmount             int64     `json:"max_amount"`
+	Direction             string    `json:"direction"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id"`
+	LimitCount            int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListEntriesPageAsc(ctx context.Context, arg ListEntriesPageAscParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesPageAsc,
+		arg.AccountID,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEntriesPageDesc = `-- name: ListEntriesPageDesc :many
+SELECT entries.id, entries.account_id, entries.amount, entries.created_at, entries.journal_id
+FROM entries
+WHERE entries.account_id = $1
+    AND entries.id < $2
+    AND entries.created_at >= $3
+    AND entries.created_at < $4
+    AND entries.amount BETWEEN $5 AND $6
+    AND (
+        $7::varchar = ''
+        OR ($7 = 'credit' AND entries.amount > 0)
+        OR ($7 = 'debit' AND entries.amount < 0)
+    )
+    AND ($8::bigint = 0 OR EXISTS (
+        SELECT 1 FROM transfers
+        WHERE transfers.journal_id = entries.journal_id AND (
+            (transfers.from_account_id = entries.account_id AND transfers.to_account_id = $8)
+            OR (transfers.to_account_id = entries.account_id AND transfers.from_account_id = $8)
+        )
+    ))
+ORDER BY entries.id DESC
+LIMIT $9
+`
+
+type ListEntriesPageDescParams struct {
+	AccountID             int64     `json:"account_id"`
+	AfterID               int64     `json:"after_id"`
+	FromTime              time.Time `json:"from_time"`
+	ToTime                time.Time `json:"to_time"`
+	MinAmount             int64     `json:"min_amount"`
+	MaxAmount             int64     `json:"max_amount"`
+	Direction             string    `json:"direction"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id"`
+	LimitCount            int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListEntriesPageDesc(ctx context.Context, arg ListEntriesPageDescParams) ([]Entry, error) {
+	rows, err := q.db.QueryContext(ctx, listEntriesPageDesc,
+		arg.AccountID,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatementEntries = `-- name: ListStatementEntries :many
+SELECT
+    entries.id, entries.account_id, entries.amount, entries.created_at, entries.journal_id,
+    COALESCE(journal_transactions.kind, '')::varchar AS journal_kind,
+    COALESCE(users.full_name, '')::varchar AS counterparty
+FROM entries
+LEFT JOIN journal_transactions ON journal_transactions.id = entries.journal_id
+LEFT JOIN transfers ON transfers.journal_id = entries.journal_id
+LEFT JOIN accounts AS counterparty_accounts ON counterparty_accounts.id = (
+    CASE WHEN transfers.from_account_id = entries.account_id THEN transfers.to_account_id ELSE transfers.from_account_id END
+)
+LEFT JOIN users ON users.username = counterparty_accounts.owner
+WHERE entries.account_id = $1
+    AND entries.created_at >= $2
+    AND entries.created_at < $3
+    AND entries.id > $4
+ORDER BY entries.id
+LIMIT $5
+`
+
+type ListStatementEntriesParams struct {
+	AccountID  int64     `json:"account_id"`
+	FromTime   time.Time `json:"from_time"`
+	ToTime     time.Time `json:"to_time"`
+	AfterID    int64     `json:"after_id"`
+	LimitCount int32     `json:"limit_count"`
+}
+
+type ListStatementEntriesRow struct {
+	ID           int64     `json:"id"`
+	AccountID    int64     `json:"account_id"`
+	Amount       int64     `json:"amount"`
+	CreatedAt    time.Time `json:"created_at"`
+	JournalID    *int64    `json:"journal_id"`
+	JournalKind  string    `json:"journal_kind"`
+	Counterparty string    `json:"counterparty"`
+}
+
+func (q *Queries) ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStatementEntries,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementEntriesRow{}
+	for rows.Next() {
+		var i ListStatementEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.JournalID,
+			&i.JournalKind,
+			&i.Counterparty,
 		); err != nil {
 			return nil, err
 		}
@@ -9,21 +9,24 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// createRandomEntry creates a random entry
+// createRandomEntry creates a random deposit entry, entries are posted with their journal transactions
 func createRandomEntry(t *testing.T, account Account) Entry {
-	arg := CreateEntryParams{
+	store := NewStore(testDB)
+
+	arg := EntryTxParams{
 		AccountID: account.ID,
 		Amount:    util.RandomMoney(),
 	}
 
-	entry, err := testQueries.CreateEntry(context.Background(), arg)
+	result, err := store.EntryTx(context.Background(), arg)
 
 	require.NoError(t, err)
+	entry := result.Entry
 	require.NotEmpty(t, entry)
 
-	require.NoError(t, err)
 	require.Equal(t, arg.AccountID, entry.AccountID)
 	require.Equal(t, arg.Amount, entry.Amount)
+	require.NotNil(t, entry.JournalID)
 
 	require.NotZero(t, entry.CreatedAt)
 	require.NotZero(t, entry.ID)
@@ -78,3 +81,85 @@ func TestListEntries(t *testing.T) {
 		require.Equal(t, arg.AccountID, entry.AccountID)
 	}
 }
+
+// TestListEntriesByOwner tests ListEntriesByOwner func, entries of every account of the owner are listed
+func TestListEntriesByOwner(t *testing.T) {
+	acc1 := createRandomAccount(t)
+
+	currency := util.USD
+	if acc1.Currency == util.USD {
+		currency = util.EUR
+	}
+
+	acc2, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    acc1.Owner,
+		Balance:  util.RandomMoney(),
+		Currency: currency,
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		createRandomEntry(t, acc1)
+		createRandomEntry(t, acc2)
+	}
+
+	entries, err := testQueries.ListEntriesByOwner(context.Background(), ListEntriesByOwnerParams{
+		Owner:  acc1.Owner,
+		Limit:  10,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+
+	for _, entry := range entries {
+		require.Contains(t, []int64{acc1.ID, acc2.ID}, entry.AccountID)
+	}
+}
+
+// TestListStatementEntries tests ListStatementEntries and GetAccountBalanceAt funcs
+func TestListStatementEntries(t *testing.T) {
+	account := createRandomAccount(t)
+
+	from := time.Now().Add(-time.Minute)
+
+	var total int64
+	entries := make([]Entry, 3)
+
+	for i := range entries {
+		entries[i] = createRandomEntry(t, account)
+		total += entries[i].Amount
+	}
+
+	to := time.Now().Add(time.Minute)
+
+	opening, err := testQueries.GetAccountBalanceAt(context.Background(), GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        from,
+	})
+	require.NoError(t, err)
+	require.Zero(t, opening)
+
+	closing, err := testQueries.GetAccountBalanceAt(context.Background(), GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        to,
+	})
+	require.NoError(t, err)
+	require.Equal(t, total, closing)
+
+	//! lines are read in batches after the last read entry
+	rows, err := testQueries.ListStatementEntries(context.Background(), ListStatementEntriesParams{
+		AccountID:  account.ID,
+		FromTime:   from,
+		ToTime:     to,
+		AfterID:    entries[0].ID,
+		LimitCount: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	for i, row := range rows {
+		require.Equal(t, entries[i+1].ID, row.ID)
+		require.Equal(t, entries[i+1].Amount, row.Amount)
+		require.Equal(t, JournalDeposit, row.JournalKind)
+	}
+}
@@ -0,0 +1,165 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: fx.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createFxQuote = `-- name: CreateFxQuote :one
+INSERT INTO fx_quotes(
+    id,
+    username,
+    from_currency,
+    to_currency,
+    rate,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, username, from_currency, to_currency, rate, expires_at, used_at, created_at
+`
+
+type CreateFxQuoteParams struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         string    `json:"rate"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+func (q *Queries) CreateFxQuote(ctx context.Context, arg CreateFxQuoteParams) (FxQuote, error) {
+	row := q.db.QueryRowContext(ctx, createFxQuote,
+		arg.ID,
+		arg.Username,
+		arg.FromCurrency,
+		arg.ToCurrency,
+		arg.Rate,
+		arg.ExpiresAt,
+	)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFxQuote = `-- name: GetFxQuote :one
+SELECT id, username, from_currency, to_currency, rate, expires_at, used_at, created_at
+FROM fx_quotes
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error) {
+	row := q.db.QueryRowContext(ctx, getFxQuote, id)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFxRate = `-- name: GetFxRate :one
+SELECT id, from_currency, to_currency, rate, effective_at, created_at
+FROM fx_rates
+WHERE from_currency = $1
+AND to_currency = $2
+AND effective_at <= $3
+ORDER BY effective_at DESC
+LIMIT 1
+`
+
+type GetFxRateParams struct {
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	At           time.Time `json:"at"`
+}
+
+func (q *Queries) GetFxRate(ctx context.Context, arg GetFxRateParams) (FxRate, error) {
+	row := q.db.QueryRowContext(ctx, getFxRate, arg.FromCurrency, arg.ToCurrency, arg.At)
+	var i FxRate
+	err := row.Scan(
+		&i.ID,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.EffectiveAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const upsertFxRate = `-- name: UpsertFxRate :exec
+INSERT INTO fx_rates(
+    from_currency,
+    to_currency,
+    rate,
+    effective_at
+) VALUES (
+    $1, $2, $3, $4
+) ON CONFLICT (from_currency, to_currency, effective_at)
+DO UPDATE SET rate = EXCLUDED.rate
+`
+
+type UpsertFxRateParams struct {
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         string    `json:"rate"`
+	EffectiveAt  time.Time `json:"effective_at"`
+}
+
+func (q *Queries) UpsertFxRate(ctx context.Context, arg UpsertFxRateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertFxRate,
+		arg.FromCurrency,
+		arg.ToCurrency,
+		arg.Rate,
+		arg.EffectiveAt,
+	)
+	return err
+}
+
+const useFxQuote = `-- name: UseFxQuote :one
+UPDATE fx_quotes
+SET used_at = now()
+WHERE id = $1
+AND used_at IS NULL
+AND expires_at > now()
+RETURNING id, username, from_currency, to_currency, rate, expires_at, used_at, created_at
+`
+
+func (q *Queries) UseFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error) {
+	row := q.db.QueryRowContext(ctx, useFxQuote, id)
+	var i FxQuote
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.FromCurrency,
+		&i.ToCurrency,
+		&i.Rate,
+		&i.ExpiresAt,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
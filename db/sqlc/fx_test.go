@@ -0,0 +1,240 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomFxQuote creates a new quote for the given user and currency pair
+func createRandomFxQuote(t *testing.T, username, fromCurrency, toCurrency string) FxQuote {
+	arg := CreateFxQuoteParams{
+		ID:           uuid.New(),
+		Username:     username,
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         "1.25",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}
+
+	quote, err := testQueries.CreateFxQuote(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, quote)
+
+	require.Equal(t, arg.ID, quote.ID)
+	require.Equal(t, arg.Username, quote.Username)
+	require.Equal(t, arg.FromCurrency, quote.FromCurrency)
+	require.Equal(t, arg.ToCurrency, quote.ToCurrency)
+	require.Equal(t, arg.Rate, quote.Rate)
+	require.WithinDuration(t, arg.ExpiresAt, quote.ExpiresAt, time.Second)
+	require.False(t, quote.UsedAt.Valid)
+
+	return quote
+}
+
+// TestGetFxRate tests that GetFxRate returns the latest effective rate
+func TestGetFxRate(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	older := UpsertFxRateParams{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         "0.9",
+		EffectiveAt:  now.Add(-time.Hour),
+	}
+	latest := UpsertFxRateParams{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         "0.95",
+		EffectiveAt:  now,
+	}
+	future := UpsertFxRateParams{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         "1.1",
+		EffectiveAt:  now.Add(time.Hour),
+	}
+
+	for _, arg := range []UpsertFxRateParams{older, latest, future} {
+		err := testQueries.UpsertFxRate(context.Background(), arg)
+		require.NoError(t, err)
+	}
+
+	rate, err := testQueries.GetFxRate(context.Background(), GetFxRateParams{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		At:           now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, latest.Rate, rate.Rate)
+	require.WithinDuration(t, latest.EffectiveAt, rate.EffectiveAt, time.Second)
+
+	// upserting the same effective time updates the rate
+	latest.Rate = "0.96"
+	err = testQueries.UpsertFxRate(context.Background(), latest)
+	require.NoError(t, err)
+
+	rate, err = testQueries.GetFxRate(context.Background(), GetFxRateParams{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		At:           now.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, latest.Rate, rate.Rate)
+}
+
+// TestUseFxQuote tests that a quote can be used only once
+func TestUseFxQuote(t *testing.T) {
+	user := createRandomUser(t)
+	quote := createRandomFxQuote(t, user.Username, util.USD, util.EUR)
+
+	usedQuote, err := testQueries.UseFxQuote(context.Background(), quote.ID)
+	require.NoError(t, err)
+	require.True(t, usedQuote.UsedAt.Valid)
+
+	_, err = testQueries.UseFxQuote(context.Background(), quote.ID)
+	require.Error(t, err)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestFxTransferTx tests the FxTransferTx DB func
+func TestFxTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	quote := createRandomFxQuote(t, acc1.Owner, acc1.Currency, acc2.Currency)
+	amount := int64(10)
+
+	result, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        amount,
+		QuoteID:       quote.ID,
+	})
+	require.NoError(t, err)
+
+	//! check transfer, converted amount is 10 * 1.25 rounded half up
+	convertedAmount := int64(13)
+
+	require.Equal(t, amount, result.Transfer.Amount)
+	require.NotNil(t, result.Transfer.FxRate)
+	require.Equal(t, quote.Rate, *result.Transfer.FxRate)
+	require.NotNil(t, result.Transfer.ConvertedAmount)
+	require.Equal(t, convertedAmount, *result.Transfer.ConvertedAmount)
+
+	//! check entries and balances
+	require.Equal(t, -amount, result.FromEntry.Amount)
+	require.Equal(t, convertedAmount, result.ToEntry.Amount)
+	require.Equal(t, acc1.Balance-amount, result.FromAccount.Balance)
+	require.Equal(t, acc2.Balance+convertedAmount, result.ToAccount.Balance)
+
+	//! quote can't be used twice
+	_, err = store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        amount,
+		QuoteID:       quote.ID,
+	})
+	require.ErrorIs(t, err, ErrFxQuoteUnavailable)
+}
+
+// TestFxTransferTxConvertedAmountTooSmall tests that amounts which round to nothing at the rate aren't transferred
+func TestFxTransferTxConvertedAmountTooSmall(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	arg := CreateFxQuoteParams{
+		ID:           uuid.New(),
+		Username:     acc1.Owner,
+		FromCurrency: acc1.Currency,
+		ToCurrency:   acc2.Currency,
+		Rate:         "0.4",
+		ExpiresAt:    time.Now().Add(time.Minute),
+	}
+
+	quote, err := testQueries.CreateFxQuote(context.Background(), arg)
+	require.NoError(t, err)
+
+	//! 1 * 0.4 rounds to 0
+	_, err = store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        1,
+		QuoteID:       quote.ID,
+	})
+	require.ErrorIs(t, err, ErrConvertedAmountTooSmall)
+
+	//! nothing is debited and the quote isn't used
+	account, err := testQueries.GetAccount(context.Background(), acc1.ID)
+	require.NoError(t, err)
+	require.Equal(t, acc1.Balance, account.Balance)
+
+	quote, err = testQueries.GetFxQuote(context.Background(), quote.ID)
+	require.NoError(t, err)
+	require.False(t, quote.UsedAt.Valid)
+}
+
+// TestFxTransferTxQuoteMismatch tests that the accounts must match the currencies of the quote and its owner
+func TestFxTransferTxQuoteMismatch(t *testing.T) {
+	store := NewStore(testDB)
+
+	from := createEmptyAccount(t, util.USD)
+	to := createEmptyAccount(t, util.EUR)
+	other := createRandomUser(t)
+
+	testCases := []struct {
+		name  string
+		quote func(t *testing.T) FxQuote
+		err   error
+	}{
+		{
+			name: "From currency",
+			quote: func(t *testing.T) FxQuote {
+				return createRandomFxQuote(t, from.Owner, util.CAD, util.EUR)
+			},
+			err: ErrFxQuoteCurrencyMismatch,
+		},
+		{
+			name: "To currency",
+			quote: func(t *testing.T) FxQuote {
+				return createRandomFxQuote(t, from.Owner, util.USD, util.CAD)
+			},
+			err: ErrFxQuoteCurrencyMismatch,
+		},
+		{
+			name: "Quote of another user",
+			quote: func(t *testing.T) FxQuote {
+				return createRandomFxQuote(t, other.Username, util.USD, util.EUR)
+			},
+			err: ErrFxQuoteNotOwned,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			quote := tc.quote(t)
+
+			_, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+				FromAccountID: from.ID,
+				ToAccountID:   to.ID,
+				Amount:        10,
+				QuoteID:       quote.ID,
+			})
+			require.ErrorIs(t, err, tc.err)
+
+			//! the quote isn't used
+			quote, err = testQueries.GetFxQuote(context.Background(), quote.ID)
+			require.NoError(t, err)
+			require.False(t, quote.UsedAt.Valid)
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var ErrHoldNotPending = errors.New("hold is already captured, voided or expired")
+var ErrHoldExpired = errors.New("hold is expired")
+var ErrCaptureExceedsHold = errors.New("capture amount exceeds the held amount")
+var ErrNoHoldExpired = errors.New("no hold is expired")
+var ErrAccountHasHolds = errors.New("account has pending holds, they must be captured or voided first")
+
+// statuses of the holds
+const (
+	HoldPending  = "pending"
+	HoldCaptured = "captured"
+	HoldVoided   = "voided"
+	HoldExpired  = "expired"
+)
+
+// how long a hold lasts when its TTL isn't given and at most
+const (
+	DefaultHoldTTL = 7 * 24 * time.Hour
+	MaxHoldTTL     = 30 * 24 * time.Hour
+)
+
+// * CreateHoldTxParams hold the input values for reserving money on the from account for the to account,
+// * the hold expires ExpiresIn after it is created
+type CreateHoldTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	ToAccountID   int64              `json:"to_account_id"`
+	Amount        int64              `json:"amount"`
+	ExpiresIn     time.Duration      `json:"expires_in"`
+	Idempotency   *IdempotencyParams `json:"-"`
+	Actor         *AuditActor        `json:"-"`
+}
+
+// * CreateHoldTxResult holds the new hold and the from account with its reduced available balance
+type CreateHoldTxResult struct {
+	Hold        Hold    `json:"hold"`
+	FromAccount Account `json:"from_account"`
+}
+
+// * CaptureHoldTxParams hold the input values for capturing a hold, zero amount captures the whole hold
+// * and a smaller amount is a partial capture which releases the rest
+type CaptureHoldTxParams struct {
+	HoldID      int64              `json:"hold_id"`
+	Amount      int64              `json:"amount"`
+	Idempotency *IdempotencyParams `json:"-"`
+	Actor       *AuditActor        `json:"-"`
+}
+
+// * CaptureHoldTxResult holds the captured hold and the result of its transfer
+type CaptureHoldTxResult struct {
+	Hold Hold `json:"hold"`
+	TransferTxResult
+}
+
+// * VoidHoldTxParams hold the hold which is voided and who voids it
+type VoidHoldTxParams struct {
+	HoldID int64       `json:"hold_id"`
+	Actor  *AuditActor `json:"-"`
+}
+
+// * ReleaseHoldTxResult holds a voided or expired hold and the from account which its money is released on
+type ReleaseHoldTxResult struct {
+	Hold        Hold    `json:"hold"`
+	FromAccount Account `json:"from_account"`
+}
+
+// * CreateHoldTx reserves the amount on the from account for the to account. The ledger balance doesn't change,
+// * the available balance is reduced and it can't go below the overdraft limit, so held money can't be spent twice
+func (store *SQLStore) CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (CreateHoldTxResult, error) {
+	var result CreateHoldTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "create_hold", arg, &result, func(q *Queries) error {
+		toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+
+		if err != nil {
+			return err
+		}
+
+		if toAccount.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		if err := checkAccountStatus(toAccount, false); err != nil {
+			return err
+		}
+
+		// the update locks the from account, so concurrent holds and debits are checked one after the other
+		result.FromAccount, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     arg.FromAccountID,
+			Amount: arg.Amount,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if result.FromAccount.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		if err := checkAccountStatus(result.FromAccount, true); err != nil {
+			return err
+		}
+
+		if err := checkFunds(result.FromAccount); err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   arg.ToAccountID,
+			Amount:        arg.Amount,
+			TtlSeconds:    int64(arg.ExpiresIn / time.Second),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventCreateHold, AuditTargetHold, strconv.FormatInt(result.Hold.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * CaptureHoldTx transfers the captured amount of a pending hold to the to account and releases the whole hold
+// * within a single database transaction. A hold can be captured only once, so the rest of a partial capture is released
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "capture_hold", arg, &result, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != HoldPending {
+			return ErrHoldNotPending
+		}
+
+		// the sweeper may not have expired it yet
+		if !hold.ExpiresAt.After(time.Now()) {
+			return ErrHoldExpired
+		}
+
+		amount := arg.Amount
+
+		if amount == 0 {
+			amount = hold.Amount
+		}
+
+		if amount > hold.Amount {
+			return ErrCaptureExceedsHold
+		}
+
+		//! to avoid DB deadlock we lock the accounts in the order of their IDs like the journal does
+		if err := lockAccounts(ctx, q, hold.FromAccountID, hold.ToAccountID); err != nil {
+			return err
+		}
+
+		if _, err := q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+			ID:     hold.FromAccountID,
+			Amount: -hold.Amount,
+		}); err != nil {
+			return err
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: hold.FromAccountID,
+			ToAccountID:   hold.ToAccountID,
+			Amount:        amount,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.CloseHold(ctx, CloseHoldParams{
+			ID:             hold.ID,
+			Status:         HoldCaptured,
+			CapturedAmount: amount,
+			TransferID:     &result.Transfer.ID,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventCaptureHold, AuditTargetHold, strconv.FormatInt(hold.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * VoidHoldTx releases the money of a pending hold on the from account without moving it
+func (store *SQLStore) VoidHoldTx(ctx context.Context, arg VoidHoldTxParams) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+
+		if err != nil {
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldVoided)
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventVoidHold, AuditTargetHold, strconv.FormatInt(hold.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * ExpireHoldTx releases the money of the pending hold which expired the longest at now.
+// * The row is locked and the ones locked by other sweepers or captures are skipped, ErrNoHoldExpired is returned
+// * when there is nothing left to expire
+func (store *SQLStore) ExpireHoldTx(ctx context.Context, now time.Time) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetExpiredHoldForUpdate(ctx, now)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoHoldExpired
+			}
+			return err
+		}
+
+		result, err = releaseHold(ctx, q, hold, HoldExpired)
+
+		if err != nil {
+			return err
+		}
+
+		actor := &AuditActor{Channel: AuditChannelSweeper}
+
+		return appendAuditEvent(ctx, q, actor, AuditEventExpireHold, AuditTargetHold, strconv.FormatInt(hold.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * releaseHold closes the locked pending hold with the status and gives its money back to the available balance
+// * of the from account with the given queries
+func releaseHold(ctx context.Context, q *Queries, hold Hold, status string) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+	var err error
+
+	if hold.Status != HoldPending {
+		return result, ErrHoldNotPending
+	}
+
+	result.FromAccount, err = q.AddAccountHeldAmount(ctx, AddAccountHeldAmountParams{
+		ID:     hold.FromAccountID,
+		Amount: -hold.Amount,
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Hold, err = q.CloseHold(ctx, CloseHoldParams{
+		ID:     hold.ID,
+		Status: status,
+	})
+
+	return result, err
+}
+
+// * lockAccounts locks the accounts in the order of their IDs until the transaction ends
+func lockAccounts(ctx context.Context, q *Queries, accountIDs ...int64) error {
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	for _, id := range accountIDs {
+		if _, err := q.GetAccountForUpdate(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
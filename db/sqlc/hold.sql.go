@@ -0,0 +1,171 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: hold.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const closeHold = `-- name: CloseHold :one
+UPDATE holds
+SET
+    status = $1,
+    captured_amount = $2,
+    transfer_id = $3,
+    closed_at = now()
+WHERE id = $4 AND status = 'pending'
+RETURNING id, from_account_id, to_account_id, amount, captured_amount, status, transfer_id, expires_at, closed_at, created_at
+`
+
+type CloseHoldParams struct {
+	Status         string `json:"status"`
+	CapturedAmount int64  `json:"captured_amount"`
+	TransferID     *int64 `json:"transfer_id"`
+	ID             int64  `json:"id"`
+}
+
+func (q *Queries) CloseHold(ctx context.Context, arg CloseHoldParams) (Hold, error) {
+	row := q.db.QueryRowContext(ctx, closeHold,
+		arg.Status,
+		arg.CapturedAmount,
+		arg.TransferID,
+		arg.ID,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createHold = `-- name: CreateHold :one
+INSERT INTO holds(
+    from_account_id,
+    to_account_id,
+    amount,
+    expires_at
+) VALUES (
+    $1, $2, $3, now() + $4::bigint * interval '1 second'
+) RETURNING id, from_account_id, to_account_id, amount, captured_amount, status, transfer_id, expires_at, closed_at, created_at
+`
+
+type CreateHoldParams struct {
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	Amount        int64 `json:"amount"`
+	TtlSeconds    int64 `json:"ttl_seconds"`
+}
+
+func (q *Queries) CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error) {
+	row := q.db.QueryRowContext(ctx, createHold,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.TtlSeconds,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getExpiredHoldForUpdate = `-- name: GetExpiredHoldForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, captured_amount, status, transfer_id, expires_at, closed_at, created_at
+FROM holds
+WHERE status = 'pending' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED
+`
+
+func (q *Queries) GetExpiredHoldForUpdate(ctx context.Context, now time.Time) (Hold, error) {
+	row := q.db.QueryRowContext(ctx, getExpiredHoldForUpdate, now)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getHold = `-- name: GetHold :one
+SELECT id, from_account_id, to_account_id, amount, captured_amount, status, transfer_id, expires_at, closed_at, created_at
+FROM holds
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetHold(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRowContext(ctx, getHold, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getHoldForUpdate = `-- name: GetHoldForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, captured_amount, status, transfer_id, expires_at, closed_at, created_at
+FROM holds
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetHoldForUpdate(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRowContext(ctx, getHoldForUpdate, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CapturedAmount,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.ClosedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
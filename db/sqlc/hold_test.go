@@ -0,0 +1,203 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateHoldTx tests that a hold reduces the available balance of the from account but not its ledger balance
+func TestCreateHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	result, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        60,
+		ExpiresIn:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	require.NotZero(t, result.Hold.ID)
+	require.Equal(t, HoldPending, result.Hold.Status)
+	require.Equal(t, int64(60), result.Hold.Amount)
+	require.WithinDuration(t, time.Now().Add(time.Hour), result.Hold.ExpiresAt, time.Minute)
+	require.Nil(t, result.Hold.ClosedAt)
+
+	require.Equal(t, int64(100), result.FromAccount.Balance)
+	require.Equal(t, int64(60), result.FromAccount.HeldAmount)
+	require.Equal(t, int64(40), result.FromAccount.AvailableBalance)
+
+	//! held money can't be held again or spent
+	_, err = store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        50,
+		ExpiresIn:     time.Hour,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        50,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.EntryTx(context.Background(), EntryTxParams{AccountID: account.ID, Amount: -50})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	requireBalance(t, account.ID, 100)
+
+	//! accounts with pending holds can't be closed
+	_, err = store.CloseAccountTx(context.Background(), CloseAccountTxParams{AccountID: account.ID})
+	require.ErrorIs(t, err, ErrAccountHasHolds)
+}
+
+// TestCaptureHoldTx tests that a partial capture transfers the captured amount and releases the whole hold
+func TestCaptureHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	created, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        60,
+		ExpiresIn:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: created.Hold.ID, Amount: 70})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: created.Hold.ID, Amount: 40})
+	require.NoError(t, err)
+
+	require.Equal(t, HoldCaptured, result.Hold.Status)
+	require.Equal(t, int64(40), result.Hold.CapturedAmount)
+	require.NotNil(t, result.Hold.TransferID)
+	require.Equal(t, result.Transfer.ID, *result.Hold.TransferID)
+	require.NotNil(t, result.Hold.ClosedAt)
+
+	require.Equal(t, int64(40), result.Transfer.Amount)
+	require.Equal(t, int64(60), result.FromAccount.Balance)
+	require.Zero(t, result.FromAccount.HeldAmount)
+	require.Equal(t, int64(60), result.FromAccount.AvailableBalance)
+	require.Equal(t, int64(40), result.ToAccount.Balance)
+
+	//! a hold is captured only once
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: created.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotPending)
+
+	_, err = store.VoidHoldTx(context.Background(), VoidHoldTxParams{HoldID: created.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotPending)
+}
+
+// TestVoidHoldTx tests that a voided hold gives its money back to the available balance without moving it
+func TestVoidHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	created, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        60,
+		ExpiresIn:     time.Hour,
+	})
+	require.NoError(t, err)
+
+	result, err := store.VoidHoldTx(context.Background(), VoidHoldTxParams{HoldID: created.Hold.ID})
+	require.NoError(t, err)
+
+	require.Equal(t, HoldVoided, result.Hold.Status)
+	require.Zero(t, result.Hold.CapturedAmount)
+	require.Nil(t, result.Hold.TransferID)
+	require.Equal(t, int64(100), result.FromAccount.AvailableBalance)
+
+	requireBalance(t, account.ID, 100)
+	requireBalance(t, recipient.ID, 0)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: created.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotPending)
+}
+
+// TestExpireHoldTx tests that the expired holds are released and can't be captured
+func TestExpireHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	created, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		FromAccountID: account.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        60,
+		ExpiresIn:     time.Second,
+	})
+	require.NoError(t, err)
+
+	//! the sweeper hasn't run yet, but the hold can't be captured after it expires
+	time.Sleep(time.Second)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: created.Hold.ID})
+	require.ErrorIs(t, err, ErrHoldExpired)
+
+	//! other tests leave their expired holds too, so we expire until ours is expired
+	for {
+		result, err := store.ExpireHoldTx(context.Background(), time.Now())
+		require.NoError(t, err)
+
+		if result.Hold.ID == created.Hold.ID {
+			require.Equal(t, HoldExpired, result.Hold.Status)
+			require.Equal(t, int64(100), result.FromAccount.AvailableBalance)
+			break
+		}
+	}
+
+	hold, err := testQueries.GetHold(context.Background(), created.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, HoldExpired, hold.Status)
+}
+
+// TestCreateHoldTxConcurrent tests that concurrent holds and transfers from the same account can't spend
+// more than its balance together
+func TestCreateHoldTxConcurrent(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createAccountWithBalance(t, 100)
+	recipient := createAccountWithBalance(t, 0)
+
+	errs := runConcurrently(20, func(i int) error {
+		if i%2 == 0 {
+			_, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+				FromAccountID: account.ID,
+				ToAccountID:   recipient.ID,
+				Amount:        10,
+				ExpiresIn:     time.Hour,
+			})
+			return err
+		}
+
+		_, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: account.ID,
+			ToAccountID:   recipient.ID,
+			Amount:        10,
+		})
+		return err
+	})
+
+	require.Equal(t, 10, countSucceeded(t, errs))
+
+	updated, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, updated.AvailableBalance)
+}
@@ -0,0 +1,84 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: idempotency_key.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+)
+
+const createIdempotencyKey = `-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys(
+    username,
+    key,
+    request_hash
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT (username, key) DO NOTHING
+RETURNING username, key, request_hash, response, created_at
+`
+
+type CreateIdempotencyKeyParams struct {
+	Username    string `json:"username"`
+	Key         string `json:"key"`
+	RequestHash string `json:"request_hash"`
+}
+
+func (q *Queries) CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, createIdempotencyKey, arg.Username, arg.Key, arg.RequestHash)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Username,
+		&i.Key,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getIdempotencyKeyForUpdate = `-- name: GetIdempotencyKeyForUpdate :one
+SELECT username, key, request_hash, response, created_at
+FROM idempotency_keys
+WHERE username = $1 AND key = $2
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+type GetIdempotencyKeyForUpdateParams struct {
+	Username string `json:"username"`
+	Key      string `json:"key"`
+}
+
+func (q *Queries) GetIdempotencyKeyForUpdate(ctx context.Context, arg GetIdempotencyKeyForUpdateParams) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, getIdempotencyKeyForUpdate, arg.Username, arg.Key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Username,
+		&i.Key,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateIdempotencyKeyResponse = `-- name: UpdateIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET response = $3
+WHERE username = $1 AND key = $2
+`
+
+type UpdateIdempotencyKeyResponseParams struct {
+	Username string          `json:"username"`
+	Key      string          `json:"key"`
+	Response json.RawMessage `json:"response"`
+}
+
+func (q *Queries) UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error {
+	_, err := q.db.ExecContext(ctx, updateIdempotencyKeyResponse, arg.Username, arg.Key, arg.Response)
+	return err
+}
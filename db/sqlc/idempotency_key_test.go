@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCreateIdempotencyKey tests CreateIdempotencyKey func, second insert with the same key returns no rows
+func TestCreateIdempotencyKey(t *testing.T) {
+	user := createRandomUser(t)
+
+	arg := CreateIdempotencyKeyParams{
+		Username:    user.Username,
+		Key:         util.RandomString(16),
+		RequestHash: util.RandomString(64),
+	}
+
+	key, err := testQueries.CreateIdempotencyKey(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.Username, key.Username)
+	require.Equal(t, arg.Key, key.Key)
+	require.Equal(t, arg.RequestHash, key.RequestHash)
+	require.NotZero(t, key.CreatedAt)
+
+	_, err = testQueries.CreateIdempotencyKey(context.Background(), arg)
+
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestTransferTxIdempotency tests that concurrent transfers with the same key move the money only once
+func TestTransferTxIdempotency(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        10,
+		Idempotency: &IdempotencyParams{
+			Username: acc1.Owner,
+			Key:      util.RandomString(16),
+		},
+	}
+
+	n := 5
+
+	errs := make(chan error)
+	results := make(chan TransferTxResult)
+
+	for i := 0; i < n; i++ {
+		go func() {
+			result, err := store.TransferTx(context.Background(), arg)
+
+			errs <- err
+			results <- result
+		}()
+	}
+
+	var first TransferTxResult
+
+	for i := 0; i < n; i++ {
+		err := <-errs
+		require.NoError(t, err)
+
+		result := <-results
+		require.NotZero(t, result.Transfer.ID)
+
+		if i == 0 {
+			first = result
+			continue
+		}
+
+		// every request gets the result of the one which moved the money
+		require.Equal(t, first.Transfer.ID, result.Transfer.ID)
+		require.Equal(t, first.FromAccount.Balance, result.FromAccount.Balance)
+		require.Equal(t, first.ToAccount.Balance, result.ToAccount.Balance)
+	}
+
+	updatedAcc1, err := testQueries.GetAccount(context.Background(), acc1.ID)
+	require.NoError(t, err)
+	require.Equal(t, acc1.Balance-arg.Amount, updatedAcc1.Balance)
+
+	updatedAcc2, err := testQueries.GetAccount(context.Background(), acc2.ID)
+	require.NoError(t, err)
+	require.Equal(t, acc2.Balance+arg.Amount, updatedAcc2.Balance)
+
+	// same key with a different request is rejected
+	arg.Amount = 20
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyMismatch)
+}
+
+// TestEntryTxIdempotency tests that an entry retried with the same key is replayed
+func TestEntryTxIdempotency(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc := createRandomAccount(t)
+
+	arg := EntryTxParams{
+		AccountID: acc.ID,
+		Amount:    10,
+		Idempotency: &IdempotencyParams{
+			Username: acc.Owner,
+			Key:      util.RandomString(16),
+		},
+	}
+
+	result1, err := store.EntryTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	result2, err := store.EntryTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.Equal(t, result1.Entry.ID, result2.Entry.ID)
+	require.Equal(t, result1.Account.Balance, result2.Account.Balance)
+
+	updatedAcc, err := testQueries.GetAccount(context.Background(), acc.ID)
+	require.NoError(t, err)
+	require.Equal(t, acc.Balance+arg.Amount, updatedAcc.Balance)
+}
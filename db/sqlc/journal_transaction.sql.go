@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: journal_transaction.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createJournalTransaction = `-- name: CreateJournalTransaction :one
+INSERT INTO journal_transactions (
+    kind
+) VALUES (
+    $1
+) RETURNING id, kind, created_at
+`
+
+func (q *Queries) CreateJournalTransaction(ctx context.Context, kind string) (JournalTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createJournalTransaction, kind)
+	var i JournalTransaction
+	err := row.Scan(&i.ID, &i.Kind, &i.CreatedAt)
+	return i, err
+}
+
+const getJournalTransaction = `-- name: GetJournalTransaction :one
+SELECT id, kind, created_at FROM journal_transactions
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetJournalTransaction(ctx context.Context, id int64) (JournalTransaction, error) {
+	row := q.db.QueryRowContext(ctx, getJournalTransaction, id)
+	var i JournalTransaction
+	err := row.Scan(&i.ID, &i.Kind, &i.CreatedAt)
+	return i, err
+}
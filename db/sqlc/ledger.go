@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrSystemAccount = errors.New("system accounts can't be used directly")
+
+// kinds of the journal transactions
+const (
+	JournalTransfer   = "transfer"
+	JournalFxTransfer = "fx_transfer"
+	JournalReversal   = "reversal"
+	JournalDeposit    = "deposit"
+	JournalWithdrawal = "withdrawal"
+	JournalAdjustment = "adjustment"
+)
+
+// purposes of the system accounts, each currency has a system account for each purpose
+const (
+	SystemCashIn     = "cash_in"
+	SystemCashOut    = "cash_out"
+	SystemFees       = "fees"
+	SystemFx         = "fx"
+	SystemAdjustment = "adjustment"
+)
+
+// * ledgerLeg is the amount which is added to the balance of an account within a journal transaction
+type ledgerLeg struct {
+	AccountID int64
+	Amount    int64
+}
+
+// * ledgerPosting holds the journal transaction with an entry for each leg and the updated accounts by their IDs
+type ledgerPosting struct {
+	Journal  JournalTransaction
+	Entries  []Entry
+	Accounts map[int64]Account
+}
+
+// * systemAccount returns the system account of the purpose in the currency
+func systemAccount(ctx context.Context, q *Queries, purpose, currency string) (Account, error) {
+	account, err := q.GetSystemAccount(ctx, GetSystemAccountParams{
+		SystemPurpose: purpose,
+		Currency:      currency,
+	})
+
+	if err == sql.ErrNoRows {
+		return account, fmt.Errorf("no %s system account in %s", purpose, currency)
+	}
+
+	return account, err
+}
+
+// * postJournal creates a journal transaction with an entry for each leg and adds the legs to the account balances.
+// * Entries are created in the order of the legs, and balances are updated in the order of the account IDs
+// * to avoid DB deadlocks. The DB rejects the transaction on commit if the legs don't sum to zero in each currency
+func postJournal(ctx context.Context, q *Queries, kind string, legs []ledgerLeg) (ledgerPosting, error) {
+	posting := ledgerPosting{
+		Entries:  make([]Entry, len(legs)),
+		Accounts: make(map[int64]Account, len(legs)),
+	}
+
+	var err error
+
+	posting.Journal, err = q.CreateJournalTransaction(ctx, kind)
+
+	if err != nil {
+		return posting, err
+	}
+
+	amounts := make(map[int64]int64, len(legs))
+	accountIDs := make([]int64, 0, len(legs))
+
+	for i, leg := range legs {
+		posting.Entries[i], err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: leg.AccountID,
+			Amount:    leg.Amount,
+			JournalID: &posting.Journal.ID,
+		})
+
+		if err != nil {
+			return posting, err
+		}
+
+		if _, ok := amounts[leg.AccountID]; !ok {
+			accountIDs = append(accountIDs, leg.AccountID)
+		}
+
+		amounts[leg.AccountID] += leg.Amount
+	}
+
+	//! to avoid DB deadlock we always update the account with the smaller ID first
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	for _, id := range accountIDs {
+		posting.Accounts[id], err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     id,
+			Amount: amounts[id],
+		})
+
+		if err != nil {
+			return posting, err
+		}
+	}
+
+	return posting, nil
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// requireBalancedJournal checks that the entries of the journal transaction sum to zero in each currency
+func requireBalancedJournal(t *testing.T, journalID int64) []Entry {
+	entries, err := testQueries.ListEntriesByJournal(context.Background(), &journalID)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	sums := make(map[string]int64)
+
+	for _, entry := range entries {
+		account, err := testQueries.GetAccount(context.Background(), entry.AccountID)
+		require.NoError(t, err)
+
+		sums[account.Currency] += entry.Amount
+	}
+
+	for currency, sum := range sums {
+		require.Zerof(t, sum, "journal transaction is not balanced in %s", currency)
+	}
+
+	return entries
+}
+
+// TestEntryTxJournal tests that deposits and withdrawals are posted against the system accounts
+func TestEntryTxJournal(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc := createRandomAccount(t)
+
+	cashIn, err := testQueries.GetSystemAccount(context.Background(), GetSystemAccountParams{
+		SystemPurpose: SystemCashIn,
+		Currency:      acc.Currency,
+	})
+	require.NoError(t, err)
+
+	deposit, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: acc.ID,
+		Amount:    10,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, deposit.Entry.JournalID)
+
+	journal, err := testQueries.GetJournalTransaction(context.Background(), *deposit.Entry.JournalID)
+	require.NoError(t, err)
+	require.Equal(t, JournalDeposit, journal.Kind)
+
+	entries := requireBalancedJournal(t, journal.ID)
+	require.Len(t, entries, 2)
+	require.Equal(t, cashIn.ID, entries[1].AccountID)
+	require.Equal(t, int64(-10), entries[1].Amount)
+
+	withdrawal, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: acc.ID,
+		Amount:    -10,
+	})
+	require.NoError(t, err)
+
+	journal, err = testQueries.GetJournalTransaction(context.Background(), *withdrawal.Entry.JournalID)
+	require.NoError(t, err)
+	require.Equal(t, JournalWithdrawal, journal.Kind)
+	requireBalancedJournal(t, journal.ID)
+
+	//! system accounts can't be used directly
+	_, err = store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: cashIn.ID,
+		Amount:    10,
+	})
+	require.ErrorIs(t, err, ErrSystemAccount)
+}
+
+// TestFxTransferTxJournal tests that cross-currency transfers are balanced in each currency with the fx system accounts
+func TestFxTransferTxJournal(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	quote := createRandomFxQuote(t, acc1.Owner, acc1.Currency, acc2.Currency)
+
+	result, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        10,
+		QuoteID:       quote.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, result.FromEntry.JournalID, result.ToEntry.JournalID)
+
+	entries := requireBalancedJournal(t, *result.FromEntry.JournalID)
+	require.Len(t, entries, 4)
+}
+
+// TestTransferTxSystemAccount tests that customers can't transfer to system accounts
+func TestTransferTxSystemAccount(t *testing.T) {
+	store := NewStore(testDB)
+
+	acc := createRandomAccount(t)
+
+	fees, err := testQueries.GetSystemAccount(context.Background(), GetSystemAccountParams{
+		SystemPurpose: SystemFees,
+		Currency:      acc.Currency,
+	})
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: acc.ID,
+		ToAccountID:   fees.ID,
+		Amount:        10,
+	})
+	require.ErrorIs(t, err, ErrSystemAccount)
+}
+
+// TestUnbalancedJournal tests that the DB rejects a journal transaction which doesn't sum to zero
+func TestUnbalancedJournal(t *testing.T) {
+	store := NewStore(testDB).(*SQLStore)
+
+	acc := createRandomAccount(t)
+
+	err := store.execTx(context.Background(), func(q *Queries) error {
+		_, err := postJournal(context.Background(), q, JournalDeposit, []ledgerLeg{
+			{AccountID: acc.ID, Amount: util.RandomMoney()},
+		})
+
+		return err
+	})
+	require.Error(t, err)
+
+	//! nothing is committed
+	account, err := testQueries.GetAccount(context.Background(), acc.ID)
+	require.NoError(t, err)
+	require.Equal(t, acc.Balance, account.Balance)
+
+	//! entries can't be written without a journal transaction
+	_, err = testQueries.CreateEntry(context.Background(), CreateEntryParams{
+		AccountID: acc.ID,
+		Amount:    10,
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,228 @@
+package db
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// directions which the entries and the transfers are filtered by, transfers between the accounts
+// of the same user are internal for the user
+const (
+	DirectionCredit   = "credit"
+	DirectionDebit    = "debit"
+	DirectionIncoming = "incoming"
+	DirectionOutgoing = "outgoing"
+	DirectionInternal = "internal"
+)
+
+// bounds which are used when a filter isn't set, so the list queries don't need a branch for each filter
+var (
+	pageMinTime = time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	pageMaxTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+)
+
+// * PageParams holds the keyset of a page, rows after the row with AfterID are listed in the sort order.
+// * AfterID is zero on the first page
+type PageParams struct {
+	AfterID    int64 `json:"-"`
+	Descending bool  `json:"-"`
+	Limit      int32 `json:"-"`
+}
+
+// * ListAccountsPageParams holds the filters of the accounts of an owner, unset filters match every account.
+// * From is inclusive and To is exclusive
+type ListAccountsPageParams struct {
+	Owner      string    `json:"owner"`
+	Currency   string    `json:"currency,omitempty"`
+	From       time.Time `json:"from,omitempty"`
+	To         time.Time `json:"to,omitempty"`
+	MinBalance *int64    `json:"min_balance,omitempty"`
+	MaxBalance *int64    `json:"max_balance,omitempty"`
+	PageParams
+}
+
+// * ListEntriesPageParams holds the filters of the entries of an account, unset filters match every entry.
+// * Direction is credit or debit, and the counterparty is the other account of the transfer of the entry
+type ListEntriesPageParams struct {
+	AccountID             int64     `json:"account_id"`
+	From                  time.Time `json:"from,omitempty"`
+	To                    time.Time `json:"to,omitempty"`
+	MinAmount             *int64    `json:"min_amount,omitempty"`
+	MaxAmount             *int64    `json:"max_amount,omitempty"`
+	Direction             string    `json:"direction,omitempty"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id,omitempty"`
+	PageParams
+}
+
+// * ListTransfersPageParams holds the filters of the transfers from or to an account, unset filters match every transfer.
+// * Direction is incoming or outgoing from the view of the account
+type ListTransfersPageParams struct {
+	AccountID             int64     `json:"account_id"`
+	From                  time.Time `json:"from,omitempty"`
+	To                    time.Time `json:"to,omitempty"`
+	MinAmount             *int64    `json:"min_amount,omitempty"`
+	MaxAmount             *int64    `json:"max_amount,omitempty"`
+	Direction             string    `json:"direction,omitempty"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id,omitempty"`
+	PageParams
+}
+
+// * ListPaymentRequestsPageParams holds the filters of the payment requests of a user, unset filters match every request.
+// * Direction is incoming for the requests which the user must pay and outgoing for the ones the user sent
+type ListPaymentRequestsPageParams struct {
+	Username  string `json:"username"`
+	Direction string `json:"direction,omitempty"`
+	Status    string `json:"status,omitempty"`
+	PageParams
+}
+
+// * ListBillSplitsPageParams holds the initiator of the bill splits and the keyset of the page
+type ListBillSplitsPageParams struct {
+	Initiator string `json:"initiator"`
+	PageParams
+}
+
+// * ListAccountsPage lists a page of the accounts of the owner by their IDs
+func (store *SQLStore) ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) ([]Account, error) {
+	from, to := pageTimeRange(arg.From, arg.To)
+	minBalance, maxBalance := pageAmountRange(arg.MinBalance, arg.MaxBalance)
+
+	params := ListAccountsPageAscParams{
+		Owner:      arg.Owner,
+		AfterID:    pageAfterID(arg.PageParams),
+		FromTime:   from,
+		ToTime:     to,
+		MinBalance: minBalance,
+		MaxBalance: maxBalance,
+		Currency:   arg.Currency,
+		LimitCount: arg.Limit,
+	}
+
+	if arg.Descending {
+		return store.ListAccountsPageDesc(ctx, ListAccountsPageDescParams(params))
+	}
+
+	return store.ListAccountsPageAsc(ctx, params)
+}
+
+// * ListEntriesPage lists a page of the entries of the account by their IDs
+func (store *SQLStore) ListEntriesPage(ctx context.Context, arg ListEntriesPageParams) ([]Entry, error) {
+	from, to := pageTimeRange(arg.From, arg.To)
+	minAmount, maxAmount := pageAmountRange(arg.MinAmount, arg.MaxAmount)
+
+	params := ListEntriesPageAscParams{
+		AccountID:             arg.AccountID,
+		AfterID:               pageAfterID(arg.PageParams),
+		FromTime:              from,
+		ToTime:                to,
+		MinAmount:             minAmount,
+		MaxAmount:             maxAmount,
+		Direction:             arg.Direction,
+		CounterpartyAccountID: arg.CounterpartyAccountID,
+		LimitCount:            arg.Limit,
+	}
+
+	if arg.Descending {
+		return store.ListEntriesPageDesc(ctx, ListEntriesPageDescParams(params))
+	}
+
+	return store.ListEntriesPageAsc(ctx, params)
+}
+
+// * ListTransfersPage lists a page of the transfers from or to the account by their IDs
+func (store *SQLStore) ListTransfersPage(ctx context.Context, arg ListTransfersPageParams) ([]Transfer, error) {
+	from, to := pageTimeRange(arg.From, arg.To)
+	minAmount, maxAmount := pageAmountRange(arg.MinAmount, arg.MaxAmount)
+
+	params := ListTransfersPageAscParams{
+		AccountID:             arg.AccountID,
+		AfterID:               pageAfterID(arg.PageParams),
+		FromTime:              from,
+		ToTime:                to,
+		MinAmount:             minAmount,
+		MaxAmount:             maxAmount,
+		Direction:             arg.Direction,
+		CounterpartyAccountID: arg.CounterpartyAccountID,
+		LimitCount:            arg.Limit,
+	}
+
+	if arg.Descending {
+		return store.ListTransfersPageDesc(ctx, ListTransfersPageDescParams(params))
+	}
+
+	return store.ListTransfersPageAsc(ctx, params)
+}
+
+// * ListPaymentRequestsPage lists a page of the payment requests which the user sent or must pay by their IDs
+func (store *SQLStore) ListPaymentRequestsPage(ctx context.Context, arg ListPaymentRequestsPageParams) ([]PaymentRequest, error) {
+	params := ListPaymentRequestsPageAscParams{
+		Username:   arg.Username,
+		AfterID:    pageAfterID(arg.PageParams),
+		Direction:  arg.Direction,
+		Status:     arg.Status,
+		LimitCount: arg.Limit,
+	}
+
+	if arg.Descending {
+		return store.ListPaymentRequestsPageDesc(ctx, ListPaymentRequestsPageDescParams(params))
+	}
+
+	return store.ListPaymentRequestsPageAsc(ctx, params)
+}
+
+// * ListBillSplitsPage lists a page of the bill splits of the initiator by their IDs
+func (store *SQLStore) ListBillSplitsPage(ctx context.Context, arg ListBillSplitsPageParams) ([]BillSplit, error) {
+	params := ListBillSplitsPageAscParams{
+		Initiator:  arg.Initiator,
+		AfterID:    pageAfterID(arg.PageParams),
+		LimitCount: arg.Limit,
+	}
+
+	if arg.Descending {
+		return store.ListBillSplitsPageDesc(ctx, ListBillSplitsPageDescParams(params))
+	}
+
+	return store.ListBillSplitsPageAsc(ctx, params)
+}
+
+// * pageAfterID returns the ID which the rows of the page come after, the first page starts from the edge of the sort order
+func pageAfterID(page PageParams) int64 {
+	if page.AfterID > 0 {
+		return page.AfterID
+	}
+
+	if page.Descending {
+		return math.MaxInt64
+	}
+
+	return 0
+}
+
+// * pageTimeRange returns the creation time range of the rows, zero times don't bound the range
+func pageTimeRange(from, to time.Time) (time.Time, time.Time) {
+	if from.IsZero() {
+		from = pageMinTime
+	}
+
+	if to.IsZero() {
+		to = pageMaxTime
+	}
+
+	return from, to
+}
+
+// * pageAmountRange returns the inclusive amount range of the rows, nil amounts don't bound the range
+func pageAmountRange(min, max *int64) (int64, int64) {
+	minAmount, maxAmount := int64(math.MinInt64), int64(math.MaxInt64)
+
+	if min != nil {
+		minAmount = *min
+	}
+
+	if max != nil {
+		maxAmount = *max
+	}
+
+	return minAmount, maxAmount
+}
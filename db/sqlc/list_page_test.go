@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListAccountsPage tests that the accounts are paged by their IDs in both orders and filtered
+func TestListAccountsPage(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	var accounts []Account
+
+	for i, currency := range []string{util.USD, util.EUR, util.USD} {
+		account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  int64(i+1) * 100,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+
+		accounts = append(accounts, account)
+	}
+
+	arg := ListAccountsPageParams{
+		Owner:      user.Username,
+		PageParams: PageParams{Limit: 2},
+	}
+
+	page, err := store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, accountIDs(accounts[:2]), accountIDs(page))
+
+	arg.AfterID = page[1].ID
+
+	page, err = store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, accountIDs(accounts[2:]), accountIDs(page))
+
+	arg.AfterID = 0
+	arg.Descending = true
+
+	page, err = store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, []int64{accounts[2].ID, accounts[1].ID}, accountIDs(page))
+
+	//! filters apply to every page
+	minBalance := int64(200)
+	arg.Descending = false
+	arg.Currency = util.USD
+	arg.MinBalance = &minBalance
+
+	page, err = store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, []int64{accounts[2].ID}, accountIDs(page))
+
+	arg.Currency = ""
+	arg.MinBalance = nil
+	arg.From = accounts[2].CreatedAt.Add(time.Hour)
+
+	page, err = store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+// TestListEntriesAndTransfersPage tests that the entries and the transfers of an account are filtered by their direction,
+// amount and counterparty
+func TestListEntriesAndTransfersPage(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createFundedAccount(t)
+	counterparty1 := createFundedAccount(t)
+	counterparty2 := createFundedAccount(t)
+
+	transfer := func(from, to Account, amount int64) TransferTxResult {
+		result, err := store.TransferTx(context.Background(), TransferTxParams{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        amount,
+		})
+		require.NoError(t, err)
+
+		return result
+	}
+
+	out1 := transfer(account, counterparty1, 10)
+	in2 := transfer(counterparty2, account, 20)
+	out2 := transfer(account, counterparty2, 30)
+
+	entries, err := store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID:  account.ID,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{out1.FromEntry.ID, in2.ToEntry.ID, out2.FromEntry.ID}, entryIDs(entries))
+
+	entries, err = store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID:  account.ID,
+		Direction:  DirectionDebit,
+		PageParams: PageParams{Descending: true, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{out2.FromEntry.ID, out1.FromEntry.ID}, entryIDs(entries))
+
+	entries, err = store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID:             account.ID,
+		CounterpartyAccountID: counterparty2.ID,
+		PageParams:            PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{in2.ToEntry.ID, out2.FromEntry.ID}, entryIDs(entries))
+
+	maxAmount := int64(-20)
+
+	entries, err = store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID:  account.ID,
+		MaxAmount:  &maxAmount,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{out2.FromEntry.ID}, entryIDs(entries))
+
+	transfers, err := store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+		AccountID:  account.ID,
+		PageParams: PageParams{AfterID: out1.Transfer.ID, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{in2.Transfer.ID, out2.Transfer.ID}, transferIDs(transfers))
+
+	transfers, err = store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+		AccountID:  account.ID,
+		Direction:  DirectionIncoming,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{in2.Transfer.ID}, transferIDs(transfers))
+
+	minAmount := int64(20)
+
+	transfers, err = store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+		AccountID:             account.ID,
+		MinAmount:             &minAmount,
+		Direction:             DirectionOutgoing,
+		CounterpartyAccountID: counterparty2.ID,
+		PageParams:            PageParams{Descending: true, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{out2.Transfer.ID}, transferIDs(transfers))
+}
+
+// createFundedAccount creates a USD account which has enough balance for the transfers of the tests
+func createFundedAccount(t *testing.T) Account {
+	return createAccountWithBalance(t, 1000)
+}
+
+// entryIDs returns the IDs of the entries
+func entryIDs(entries []Entry) []int64 {
+	ids := make([]int64, len(entries))
+
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	return ids
+}
+
+// transferIDs returns the IDs of the transfers
+func transferIDs(transfers []Transfer) []int64 {
+	ids := make([]int64, len(transfers))
+
+	for i, transfer := range transfers {
+		ids[i] = transfer.ID
+	}
+
+	return ids
+}
@@ -5,6 +5,8 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +18,60 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// active, frozen or closed
+	Status   string     `json:"status"`
+	ClosedAt *time.Time `json:"closed_at"`
+	// cash_in, cash_out, fees, fx or adjustment for system accounts, null for customer accounts
+	SystemPurpose    *string `json:"system_purpose"`
+	OverdraftLimit   int64   `json:"overdraft_limit"`
+	HeldAmount       int64   `json:"held_amount"`
+	AvailableBalance int64   `json:"available_balance"`
+	// user who froze the account, null unless it is frozen
+	FrozenBy *string `json:"frozen_by"`
+}
+
+type AdminAuditLog struct {
+	ID            int64  `json:"id"`
+	AdminUsername string `json:"admin_username"`
+	Action        string `json:"action"`
+	// user, account or session
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// required for actions which change data
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AuditEvent struct {
+	ID    int64  `json:"id"`
+	Actor string `json:"actor"`
+	// http, grpc, gateway or scheduler
+	Channel    string `json:"channel"`
+	ClientIp   string `json:"client_ip"`
+	UserAgent  string `json:"user_agent"`
+	Action     string `json:"action"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	// result of the mutation as JSON
+	Details string `json:"details"`
+	// hash of the previous event, empty for the first one
+	PrevHash string `json:"prev_hash"`
+	// sha256 of the event with prev_hash
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BillSplit struct {
+	ID        int64  `json:"id"`
+	Initiator string `json:"initiator"`
+	// account of the initiator which the payment requests of the split are paid into
+	ToAccountID int64  `json:"to_account_id"`
+	TotalAmount int64  `json:"total_amount"`
+	Currency    string `json:"currency"`
+	Memo        string `json:"memo"`
+	// equal, exact or percentage
+	Rule      string    `json:"rule"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +80,136 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// null for the entries which were written before the ledger
+	JournalID *int64 `json:"journal_id"`
+}
+
+type FxQuote struct {
+	ID           uuid.UUID    `json:"id"`
+	Username     string       `json:"username"`
+	FromCurrency string       `json:"from_currency"`
+	ToCurrency   string       `json:"to_currency"`
+	Rate         string       `json:"rate"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	UsedAt       sql.NullTime `json:"used_at"`
+	CreatedAt    time.Time    `json:"created_at"`
+}
+
+type FxRate struct {
+	ID           int64     `json:"id"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Rate         string    `json:"rate"`
+	EffectiveAt  time.Time `json:"effective_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type Hold struct {
+	ID            int64 `json:"id"`
+	FromAccountID int64 `json:"from_account_id"`
+	ToAccountID   int64 `json:"to_account_id"`
+	Amount        int64 `json:"amount"`
+	// amount which is transferred on capture, the rest of the hold is released
+	CapturedAmount int64 `json:"captured_amount"`
+	// pending, captured, voided or expired
+	Status     string     `json:"status"`
+	TransferID *int64     `json:"transfer_id"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	ClosedAt   *time.Time `json:"closed_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Username string `json:"username"`
+	Key      string `json:"key"`
+	// sha256 of the operation and its params
+	RequestHash string `json:"request_hash"`
+	// serialized result which is returned for replays
+	Response  json.RawMessage `json:"response"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type JournalTransaction struct {
+	ID int64 `json:"id"`
+	// transfer, fx_transfer, reversal, deposit, withdrawal or adjustment
+	Kind      string    `json:"kind"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type PaymentRequest struct {
+	ID int64 `json:"id"`
+	// user who asks for the money, it is paid into to_account_id
+	Requester   string `json:"requester"`
+	Payer       string `json:"payer"`
+	ToAccountID int64  `json:"to_account_id"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Memo        string `json:"memo"`
+	// pending, accepted, declined, cancelled or expired
+	Status     string    `json:"status"`
+	TransferID *int64    `json:"transfer_id"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	SplitID    *int64    `json:"split_id"`
+}
+
+type PaymentRequestEvent struct {
+	ID               int64  `json:"id"`
+	PaymentRequestID int64  `json:"payment_request_id"`
+	Status           string `json:"status"`
+	// user who changed the status, null when the request expired
+	Actor     *string   `json:"actor"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ReconciliationReport struct {
+	ID                int64 `json:"id"`
+	AccountsChecked   int64 `json:"accounts_checked"`
+	TransfersChecked  int64 `json:"transfers_checked"`
+	CurrenciesChecked int64 `json:"currencies_checked"`
+	DiscrepancyCount  int64 `json:"discrepancy_count"`
+	// invariants which don't hold, empty if the ledger is reconciled
+	Discrepancies json.RawMessage `json:"discrepancies"`
+	StartedAt     time.Time       `json:"started_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	// once, daily, weekly or monthly
+	Frequency string `json:"frequency"`
+	// first occurrence, later occurrences are calculated from it
+	StartAt time.Time `json:"start_at"`
+	// when the current occurrence is scheduled
+	ScheduledAt time.Time `json:"scheduled_at"`
+	// when the current occurrence is attempted next, later than scheduled_at after failures
+	RunAt          time.Time  `json:"run_at"`
+	EndAt          *time.Time `json:"end_at"`
+	MaxOccurrences *int32     `json:"max_occurrences"`
+	Occurrences    int32      `json:"occurrences"`
+	MaxRetries     int32      `json:"max_retries"`
+	// seconds to wait before retrying a failed occurrence
+	RetryInterval int64 `json:"retry_interval"`
+	// failed attempts of the current occurrence
+	Retries int32 `json:"retries"`
+	// active, completed, failed or cancelled
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ScheduledTransferExecution struct {
+	ID                  int64  `json:"id"`
+	ScheduledTransferID int64  `json:"scheduled_transfer_id"`
+	TransferID          *int64 `json:"transfer_id"`
+	// succeeded or failed
+	Status        string    `json:"status"`
+	FailureReason *string   `json:"failure_reason"`
+	ExecutedAt    time.Time `json:"executed_at"`
 }
 
 type Session struct {
@@ -35,6 +221,25 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// id of the session created at login, shared by every rotation of it
+	FamilyID uuid.UUID  `json:"family_id"`
+	ParentID *uuid.UUID `json:"parent_id"`
+	// when the refresh token is rotated, it can not be used again
+	UsedAt *time.Time `json:"used_at"`
+}
+
+type Statement struct {
+	ID          int64     `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	PeriodStart time.Time `json:"period_start"`
+	// exclusive, the first moment of the next period
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	FileName       string    `json:"file_name"`
+	// rendered PDF of the statement
+	Content   []byte    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Transfer struct {
@@ -44,6 +249,18 @@ type Transfer struct {
 	// only positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// rate used for cross-currency transfers
+	FxRate *string `json:"fx_rate"`
+	// amount credited in the currency of the to account
+	ConvertedAmount *int64 `json:"converted_amount"`
+	// completed, partially_refunded or reversed
+	Status string `json:"status"`
+	// total amount of the reversals of the transfer
+	RefundedAmount int64 `json:"refunded_amount"`
+	// the original transfer if this transfer is a reversal
+	ReversalOf *int64 `json:"reversal_of"`
+	// journal transaction of the entries, null for the transfers which were made before the ledger
+	JournalID *int64 `json:"journal_id"`
 }
 
 type User struct {
@@ -53,4 +270,6 @@ type User struct {
 	Email             string    `json:"email"`
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
+	// depositor or admin
+	Role string `json:"role"`
 }
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var ErrRecipientNotFound = errors.New("no user with this username or email")
+var ErrRecipientHasNoAccount = errors.New("recipient has no account in this currency")
+
+// * Payee is the account which money sent to a recipient in a currency goes to, with the owner of it
+type Payee struct {
+	Account  Account `json:"account"`
+	FullName string  `json:"full_name"`
+	Email    string  `json:"email"`
+}
+
+// * ResolvePayee finds the user with the username or the email of the recipient and the open account of the user
+// * in the currency, a user has at most one open account in each currency. System accounts are never payees
+func (store *SQLStore) ResolvePayee(ctx context.Context, recipient, currency string) (Payee, error) {
+	var payee Payee
+
+	user, err := store.GetUserByUsernameOrEmail(ctx, recipient)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return payee, ErrRecipientNotFound
+		}
+		return payee, err
+	}
+
+	payee.FullName = user.FullName
+	payee.Email = user.Email
+
+	payee.Account, err = store.GetOwnerAccount(ctx, GetOwnerAccountParams{
+		Owner:    user.Username,
+		Currency: currency,
+	})
+
+	if err == sql.ErrNoRows {
+		return payee, ErrRecipientHasNoAccount
+	}
+
+	return payee, err
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResolvePayee tests that a recipient is resolved by the username or the email to the open account in the currency
+func TestResolvePayee(t *testing.T) {
+	store := NewStore(testDB)
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Currency: util.USD,
+	})
+	require.NoError(t, err)
+
+	for _, recipient := range []string{user.Username, user.Email} {
+		payee, err := store.ResolvePayee(context.Background(), recipient, util.USD)
+		require.NoError(t, err)
+		require.Equal(t, account.ID, payee.Account.ID)
+		require.Equal(t, user.FullName, payee.FullName)
+		require.Equal(t, user.Email, payee.Email)
+	}
+
+	_, err = store.ResolvePayee(context.Background(), user.Username, util.EUR)
+	require.ErrorIs(t, err, ErrRecipientHasNoAccount)
+
+	//! closed accounts don't receive money
+	_, err = testQueries.CloseAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+
+	_, err = store.ResolvePayee(context.Background(), user.Email, util.USD)
+	require.ErrorIs(t, err, ErrRecipientHasNoAccount)
+
+	_, err = store.ResolvePayee(context.Background(), util.RandomOwner(), util.USD)
+	require.ErrorIs(t, err, ErrRecipientNotFound)
+}
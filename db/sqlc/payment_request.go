@@ -0,0 +1,271 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var ErrPaymentRequestNotPending = errors.New("payment request is already accepted, declined, cancelled or expired")
+var ErrPaymentRequestExpired = errors.New("payment request is expired")
+var ErrPaymentRequestToSelf = errors.New("payment request can't be sent to yourself")
+var ErrRequesterHasNoAccount = errors.New("requester has no account in this currency")
+var ErrPayerHasNoAccount = errors.New("payer has no account in this currency")
+var ErrNoPaymentRequestExpired = errors.New("no payment request is expired")
+
+// statuses of the payment requests, every status change is written to the history of the request
+const (
+	PaymentRequestPending   = "pending"
+	PaymentRequestAccepted  = "accepted"
+	PaymentRequestDeclined  = "declined"
+	PaymentRequestCancelled = "cancelled"
+	PaymentRequestExpired   = "expired"
+)
+
+// how long a payment request lasts when its TTL isn't given and at most
+const (
+	DefaultPaymentRequestTTL = 7 * 24 * time.Hour
+	MaxPaymentRequestTTL     = 90 * 24 * time.Hour
+)
+
+// * CreatePaymentRequestTxParams hold the input values for asking the payer for money, the payer is a username or an email.
+// * The money is paid into the requester's open account in the currency and the request expires ExpiresIn after it is created
+type CreatePaymentRequestTxParams struct {
+	Requester string        `json:"requester"`
+	Payer     string        `json:"payer"`
+	Amount    int64         `json:"amount"`
+	Currency  string        `json:"currency"`
+	Memo      string        `json:"memo"`
+	ExpiresIn time.Duration `json:"expires_in"`
+}
+
+// * AcceptPaymentRequestTxParams hold the payment request which the payer pays
+type AcceptPaymentRequestTxParams struct {
+	PaymentRequestID int64              `json:"payment_request_id"`
+	Idempotency      *IdempotencyParams `json:"-"`
+	Actor            *AuditActor        `json:"-"`
+}
+
+// * AcceptPaymentRequestTxResult holds the accepted payment request and the result of its transfer
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest `json:"payment_request"`
+	TransferTxResult
+}
+
+// * ClosePaymentRequestTxParams hold the payment request which is declined or cancelled and who does it
+type ClosePaymentRequestTxParams struct {
+	PaymentRequestID int64       `json:"payment_request_id"`
+	Actor            *AuditActor `json:"-"`
+}
+
+// * CreatePaymentRequestTx creates a pending payment request and the first entry of its history
+func (store *SQLStore) CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (PaymentRequest, error) {
+	var result PaymentRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		payer, err := q.GetUserByUsernameOrEmail(ctx, arg.Payer)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrRecipientNotFound
+			}
+			return err
+		}
+
+		if payer.Username == arg.Requester {
+			return ErrPaymentRequestToSelf
+		}
+
+		toAccount, err := q.GetOwnerAccount(ctx, GetOwnerAccountParams{
+			Owner:    arg.Requester,
+			Currency: arg.Currency,
+		})
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrRequesterHasNoAccount
+			}
+			return err
+		}
+
+		result, err = newPaymentRequest(ctx, q, CreatePaymentRequestParams{
+			Requester:   arg.Requester,
+			Payer:       payer.Username,
+			ToAccountID: toAccount.ID,
+			Amount:      arg.Amount,
+			Currency:    arg.Currency,
+			Memo:        arg.Memo,
+			TtlSeconds:  int64(arg.ExpiresIn / time.Second),
+		})
+
+		return err
+	})
+
+	return result, err
+}
+
+// * AcceptPaymentRequestTx transfers the amount of a pending payment request from the payer's open account in its currency
+// * to the requester's account and marks the request as accepted within a single database transaction
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "accept_payment_request", arg, &result, func(q *Queries) error {
+		request, err := lockPendingPaymentRequest(ctx, q, arg.PaymentRequestID)
+
+		if err != nil {
+			return err
+		}
+
+		fromAccount, err := q.GetOwnerAccount(ctx, GetOwnerAccountParams{
+			Owner:    request.Payer,
+			Currency: request.Currency,
+		})
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrPayerHasNoAccount
+			}
+			return err
+		}
+
+		result.TransferTxResult, err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   request.ToAccountID,
+			Amount:        request.Amount,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = setPaymentRequestStatus(ctx, q, request.ID, PaymentRequestAccepted, &result.Transfer.ID, arg.Actor)
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventAcceptPaymentRequest, AuditTargetPaymentRequest, strconv.FormatInt(request.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * DeclinePaymentRequestTx closes a pending payment request without paying it, it is done by the payer
+func (store *SQLStore) DeclinePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error) {
+	return store.closePaymentRequest(ctx, arg, PaymentRequestDeclined)
+}
+
+// * CancelPaymentRequestTx closes a pending payment request before it is paid, it is done by the requester
+func (store *SQLStore) CancelPaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error) {
+	return store.closePaymentRequest(ctx, arg, PaymentRequestCancelled)
+}
+
+// * ExpirePaymentRequestTx expires the pending payment request which expired the longest at now.
+// * The row is locked and the ones locked by other expirers or payers are skipped, ErrNoPaymentRequestExpired is returned
+// * when there is nothing left to expire
+func (store *SQLStore) ExpirePaymentRequestTx(ctx context.Context, now time.Time) (PaymentRequest, error) {
+	var result PaymentRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetExpiredPaymentRequestForUpdate(ctx, now)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoPaymentRequestExpired
+			}
+			return err
+		}
+
+		result, err = setPaymentRequestStatus(ctx, q, request.ID, PaymentRequestExpired, nil, nil)
+
+		return err
+	})
+
+	return result, err
+}
+
+// * closePaymentRequest closes a pending payment request with the status without moving money
+func (store *SQLStore) closePaymentRequest(ctx context.Context, arg ClosePaymentRequestTxParams, status string) (PaymentRequest, error) {
+	var result PaymentRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := lockPendingPaymentRequest(ctx, q, arg.PaymentRequestID)
+
+		if err != nil {
+			return err
+		}
+
+		result, err = setPaymentRequestStatus(ctx, q, request.ID, status, nil, arg.Actor)
+
+		return err
+	})
+
+	return result, err
+}
+
+// * newPaymentRequest creates a pending payment request and writes the requester to its history with the given queries
+func newPaymentRequest(ctx context.Context, q *Queries, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	request, err := q.CreatePaymentRequest(ctx, arg)
+
+	if err != nil {
+		return request, err
+	}
+
+	_, err = q.CreatePaymentRequestEvent(ctx, CreatePaymentRequestEventParams{
+		PaymentRequestID: request.ID,
+		Status:           PaymentRequestPending,
+		Actor:            &arg.Requester,
+	})
+
+	return request, err
+}
+
+// * lockPendingPaymentRequest locks the payment request until the transaction ends and checks that it can still be answered
+func lockPendingPaymentRequest(ctx context.Context, q *Queries, id int64) (PaymentRequest, error) {
+	request, err := q.GetPaymentRequestForUpdate(ctx, id)
+
+	if err != nil {
+		return request, err
+	}
+
+	if request.Status != PaymentRequestPending {
+		return request, ErrPaymentRequestNotPending
+	}
+
+	// the expirer may not have expired it yet
+	if !request.ExpiresAt.After(time.Now()) {
+		return request, ErrPaymentRequestExpired
+	}
+
+	return request, nil
+}
+
+// * setPaymentRequestStatus changes the status of the locked pending payment request and writes it to the history,
+// * the actor is empty in the history when nobody changed it
+func setPaymentRequestStatus(ctx context.Context, q *Queries, id int64, status string, transferID *int64, actor *AuditActor) (PaymentRequest, error) {
+	request, err := q.UpdatePaymentRequestStatus(ctx, UpdatePaymentRequestStatusParams{
+		ID:         id,
+		Status:     status,
+		TransferID: transferID,
+	})
+
+	if err != nil {
+		return request, err
+	}
+
+	var username *string
+
+	if actor != nil && actor.Username != "" {
+		username = &actor.Username
+	}
+
+	_, err = q.CreatePaymentRequestEvent(ctx, CreatePaymentRequestEventParams{
+		PaymentRequestID: id,
+		Status:           status,
+		Actor:            username,
+	})
+
+	return request, err
+}
@@ -0,0 +1,432 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: payment_request.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createPaymentRequest = `-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests(
+    requester,
+    payer,
+    to_account_id,
+    amount,
+    currency,
+    memo,
+    split_id,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, now() + $8::bigint * interval '1 second'
+) RETURNING id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+`
+
+type CreatePaymentRequestParams struct {
+	Requester   string `json:"requester"`
+	Payer       string `json:"payer"`
+	ToAccountID int64  `json:"to_account_id"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Memo        string `json:"memo"`
+	SplitID     *int64 `json:"split_id"`
+	TtlSeconds  int64  `json:"ttl_seconds"`
+}
+
+func (q *Queries) CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentRequest,
+		arg.Requester,
+		arg.Payer,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Currency,
+		arg.Memo,
+		arg.SplitID,
+		arg.TtlSeconds,
+	)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SplitID,
+	)
+	return i, err
+}
+
+const createPaymentRequestEvent = `-- name: CreatePaymentRequestEvent :one
+INSERT INTO payment_request_events(
+    payment_request_id,
+    status,
+    actor
+) VALUES (
+    $1, $2, $3
+) RETURNING id, payment_request_id, status, actor, created_at
+`
+
+type CreatePaymentRequestEventParams struct {
+	PaymentRequestID int64   `json:"payment_request_id"`
+	Status           string  `json:"status"`
+	Actor            *string `json:"actor"`
+}
+
+func (q *Queries) CreatePaymentRequestEvent(ctx context.Context, arg CreatePaymentRequestEventParams) (PaymentRequestEvent, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentRequestEvent, arg.PaymentRequestID, arg.Status, arg.Actor)
+	var i PaymentRequestEvent
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentRequestID,
+		&i.Status,
+		&i.Actor,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getExpiredPaymentRequestForUpdate = `-- name: GetExpiredPaymentRequestForUpdate :one
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE status = 'pending' AND expires_at <= $1
+ORDER BY expires_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED
+`
+
+func (q *Queries) GetExpiredPaymentRequestForUpdate(ctx context.Context, now time.Time) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getExpiredPaymentRequestForUpdate, now)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SplitID,
+	)
+	return i, err
+}
+
+const getPaymentRequest = `-- name: GetPaymentRequest :one
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequest, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SplitID,
+	)
+	return i, err
+}
+
+const getPaymentRequestForUpdate = `-- name: GetPaymentRequestForUpdate :one
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentRequestForUpdate, id)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SplitID,
+	)
+	return i, err
+}
+
+const listPaymentRequestEvents = `-- name: ListPaymentRequestEvents :many
+SELECT id, payment_request_id, status, actor, created_at
+FROM payment_request_events
+WHERE payment_request_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListPaymentRequestEvents(ctx context.Context, paymentRequestID int64) ([]PaymentRequestEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentRequestEvents, paymentRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequestEvent{}
+	for rows.Next() {
+		var i PaymentRequestEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentRequestID,
+			&i.Status,
+			&i.Actor,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentRequestsPageAsc = `-- name: ListPaymentRequestsPageAsc :many
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE (requester = $1 OR payer = $1)
+    AND id > $2
+    AND (
+        $3::varchar = ''
+        OR ($3 = 'incoming' AND payer = $1)
+        OR ($3 = 'outgoing' AND requester = $1)
+    )
+    AND ($4::varchar = '' OR status = $4)
+ORDER BY id
+LIMIT $5
+`
+
+type ListPaymentRequestsPageAscParams struct {
+	Username   string `json:"username"`
+	AfterID    int64  `json:"after_id"`
+	Direction  string `json:"direction"`
+	Status     string `json:"status"`
+	LimitCount int32  `json:"limit_count"`
+}
+
+func (q *Queries) ListPaymentRequestsPageAsc(ctx context.Context, arg ListPaymentRequestsPageAscParams) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentRequestsPageAsc,
+		arg.Username,
+		arg.AfterID,
+		arg.Direction,
+		arg.Status,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Memo,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.SplitID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentRequestsPageDesc = `-- name: ListPaymentRequestsPageDesc :many
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE (requester = $1 OR payer = $1)
+    AND id < $2
+    AND (
+        $3::varchar = ''
+        OR ($3 = 'incoming' AND payer = $1)
+        OR ($3 = 'outgoing' AND requester = $1)
+    )
+    AND ($4::varchar = '' OR status = $4)
+ORDER BY id DESC
+LIMIT $5
+`
+
+type ListPaymentRequestsPageDescParams struct {
+	Username   string `json:"username"`
+	AfterID    int64  `json:"after_id"`
+	Direction  string `json:"direction"`
+	Status     string `json:"status"`
+	LimitCount int32  `json:"limit_count"`
+}
+
+func (q *Queries) ListPaymentRequestsPageDesc(ctx context.Context, arg ListPaymentRequestsPageDescParams) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentRequestsPageDesc,
+		arg.Username,
+		arg.AfterID,
+		arg.Direction,
+		arg.Status,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Memo,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.SplitID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSplitPaymentRequests = `-- name: ListSplitPaymentRequests :many
+SELECT id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+FROM payment_requests
+WHERE split_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListSplitPaymentRequests(ctx context.Context, splitID *int64) ([]PaymentRequest, error) {
+	rows, err := q.db.QueryContext(ctx, listSplitPaymentRequests, splitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PaymentRequest{}
+	for rows.Next() {
+		var i PaymentRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.Requester,
+			&i.Payer,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Currency,
+			&i.Memo,
+			&i.Status,
+			&i.TransferID,
+			&i.ExpiresAt,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+			&i.SplitID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updatePaymentRequestStatus = `-- name: UpdatePaymentRequestStatus :one
+UPDATE payment_requests
+SET
+    status = $1,
+    transfer_id = $2,
+    updated_at = now()
+WHERE id = $3 AND status = 'pending'
+RETURNING id, requester, payer, to_account_id, amount, currency, memo, status, transfer_id, expires_at, updated_at, created_at, split_id
+`
+
+type UpdatePaymentRequestStatusParams struct {
+	Status     string `json:"status"`
+	TransferID *int64 `json:"transfer_id"`
+	ID         int64  `json:"id"`
+}
+
+func (q *Queries) UpdatePaymentRequestStatus(ctx context.Context, arg UpdatePaymentRequestStatusParams) (PaymentRequest, error) {
+	row := q.db.QueryRowContext(ctx, updatePaymentRequestStatus, arg.Status, arg.TransferID, arg.ID)
+	var i PaymentRequest
+	err := row.Scan(
+		&i.ID,
+		&i.Requester,
+		&i.Payer,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Currency,
+		&i.Memo,
+		&i.Status,
+		&i.TransferID,
+		&i.ExpiresAt,
+		&i.UpdatedAt,
+		&i.CreatedAt,
+		&i.SplitID,
+	)
+	return i, err
+}
@@ -0,0 +1,254 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomPaymentRequest creates a pending payment request of amount from the owner of payer to the owner of requester
+func createRandomPaymentRequest(t *testing.T, requester, payer Account, amount int64) PaymentRequest {
+	store := NewStore(testDB)
+
+	request, err := store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		Requester: requester.Owner,
+		Payer:     payer.Owner,
+		Amount:    amount,
+		Currency:  util.USD,
+		Memo:      util.RandomString(10),
+		ExpiresIn: time.Hour,
+	})
+	require.NoError(t, err)
+
+	require.NotZero(t, request.ID)
+	require.Equal(t, requester.Owner, request.Requester)
+	require.Equal(t, payer.Owner, request.Payer)
+	require.Equal(t, requester.ID, request.ToAccountID)
+	require.Equal(t, amount, request.Amount)
+	require.Equal(t, PaymentRequestPending, request.Status)
+	require.Nil(t, request.TransferID)
+	require.WithinDuration(t, time.Now().Add(time.Hour), request.ExpiresAt, time.Minute)
+
+	return request
+}
+
+// TestCreatePaymentRequestTx tests that a payment request needs another user and an account of the requester in the currency
+func TestCreatePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	requester := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 100)
+
+	request := createRandomPaymentRequest(t, requester, payer, 40)
+
+	events, err := store.ListPaymentRequestEvents(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, PaymentRequestPending, events[0].Status)
+	require.Equal(t, requester.Owner, *events[0].Actor)
+
+	_, err = store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		Requester: requester.Owner,
+		Payer:     requester.Owner,
+		Amount:    40,
+		Currency:  util.USD,
+		ExpiresIn: time.Hour,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestToSelf)
+
+	_, err = store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		Requester: requester.Owner,
+		Payer:     util.RandomOwner(),
+		Amount:    40,
+		Currency:  util.USD,
+		ExpiresIn: time.Hour,
+	})
+	require.ErrorIs(t, err, ErrRecipientNotFound)
+
+	_, err = store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		Requester: requester.Owner,
+		Payer:     payer.Owner,
+		Amount:    40,
+		Currency:  util.EUR,
+		ExpiresIn: time.Hour,
+	})
+	require.ErrorIs(t, err, ErrRequesterHasNoAccount)
+}
+
+// TestAcceptPaymentRequestTx tests that accepting a payment request transfers its amount from the payer to the requester once
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	requester := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 100)
+
+	request := createRandomPaymentRequest(t, requester, payer, 40)
+
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		PaymentRequestID: request.ID,
+		Actor:            &AuditActor{Username: payer.Owner, Channel: AuditChannelHTTP},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, PaymentRequestAccepted, result.PaymentRequest.Status)
+	require.NotNil(t, result.PaymentRequest.TransferID)
+	require.Equal(t, result.Transfer.ID, *result.PaymentRequest.TransferID)
+	require.Equal(t, payer.ID, result.Transfer.FromAccountID)
+	require.Equal(t, requester.ID, result.Transfer.ToAccountID)
+	require.Equal(t, int64(40), result.Transfer.Amount)
+
+	requireBalance(t, payer.ID, 60)
+	requireBalance(t, requester.ID, 40)
+
+	events, err := store.ListPaymentRequestEvents(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, PaymentRequestAccepted, events[1].Status)
+	require.Equal(t, payer.Owner, *events[1].Actor)
+
+	//! a request is paid only once
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{PaymentRequestID: request.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+
+	_, err = store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{PaymentRequestID: request.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+
+	requireBalance(t, payer.ID, 60)
+}
+
+// TestAcceptPaymentRequestTxInsufficientFunds tests that a payment request stays pending when the payer can't pay it
+func TestAcceptPaymentRequestTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	requester := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 10)
+
+	request := createRandomPaymentRequest(t, requester, payer, 40)
+
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{PaymentRequestID: request.ID})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	request, err = store.GetPaymentRequest(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestPending, request.Status)
+
+	requireBalance(t, payer.ID, 10)
+}
+
+// TestDeclineAndCancelPaymentRequestTx tests that declined and cancelled payment requests can't be paid
+func TestDeclineAndCancelPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	requester := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 100)
+
+	declined := createRandomPaymentRequest(t, requester, payer, 40)
+
+	request, err := store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{
+		PaymentRequestID: declined.ID,
+		Actor:            &AuditActor{Username: payer.Owner},
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestDeclined, request.Status)
+
+	cancelled := createRandomPaymentRequest(t, requester, payer, 40)
+
+	request, err = store.CancelPaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{
+		PaymentRequestID: cancelled.ID,
+		Actor:            &AuditActor{Username: requester.Owner},
+	})
+	require.NoError(t, err)
+	require.Equal(t, PaymentRequestCancelled, request.Status)
+
+	for _, id := range []int64{declined.ID, cancelled.ID} {
+		_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{PaymentRequestID: id})
+		require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+	}
+
+	requireBalance(t, payer.ID, 100)
+}
+
+// TestExpirePaymentRequestTx tests that the expired payment requests are expired and can't be paid anymore
+func TestExpirePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	requester := createAccountWithBalance(t, 0)
+	payer := createAccountWithBalance(t, 100)
+
+	request := createRandomPaymentRequest(t, requester, payer, 40)
+
+	// requests of other tests may expire as well, so we expire until ours is expired
+	now := request.ExpiresAt.Add(time.Second)
+
+	for {
+		expired, err := store.ExpirePaymentRequestTx(context.Background(), now)
+		require.NoError(t, err)
+
+		if expired.ID == request.ID {
+			require.Equal(t, PaymentRequestExpired, expired.Status)
+			break
+		}
+	}
+
+	events, err := store.ListPaymentRequestEvents(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, PaymentRequestExpired, events[1].Status)
+	require.Nil(t, events[1].Actor)
+
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{PaymentRequestID: request.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+
+	requireBalance(t, payer.ID, 100)
+}
+
+// TestListPaymentRequestsPage tests that users see the requests they sent as outgoing and the ones they must pay as incoming
+func TestListPaymentRequestsPage(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createAccountWithBalance(t, 100)
+	other := createAccountWithBalance(t, 100)
+
+	outgoing := createRandomPaymentRequest(t, user, other, 10)
+	incoming := createRandomPaymentRequest(t, other, user, 20)
+
+	requests, err := store.ListPaymentRequestsPage(context.Background(), ListPaymentRequestsPageParams{
+		Username:   user.Owner,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{outgoing.ID, incoming.ID}, paymentRequestIDs(requests))
+
+	requests, err = store.ListPaymentRequestsPage(context.Background(), ListPaymentRequestsPageParams{
+		Username:   user.Owner,
+		Direction:  DirectionIncoming,
+		PageParams: PageParams{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{incoming.ID}, paymentRequestIDs(requests))
+
+	_, err = store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{PaymentRequestID: outgoing.ID})
+	require.NoError(t, err)
+
+	requests, err = store.ListPaymentRequestsPage(context.Background(), ListPaymentRequestsPageParams{
+		Username:   user.Owner,
+		Status:     PaymentRequestPending,
+		PageParams: PageParams{Descending: true, Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{incoming.ID}, paymentRequestIDs(requests))
+}
+
+// paymentRequestIDs returns the IDs of the payment requests
+func paymentRequestIDs(requests []PaymentRequest) []int64 {
+	ids := make([]int64, len(requests))
+
+	for i, request := range requests {
+		ids[i] = request.ID
+	}
+
+	return ids
+}
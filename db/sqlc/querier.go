@@ -6,28 +6,122 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddAccountHeldAmount(ctx context.Context, arg AddAccountHeldAmountParams) (Account, error)
+	AddTransferRefund(ctx context.Context, arg AddTransferRefundParams) (Transfer, error)
+	BlockOtherSessions(ctx context.Context, arg BlockOtherSessionsParams) (int64, error)
+	BlockSession(ctx context.Context, id uuid.UUID) (Session, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	CancelScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
+	CloseAccount(ctx context.Context, id int64) (Account, error)
+	CloseHold(ctx context.Context, arg CloseHoldParams) (Hold, error)
+	CountAccounts(ctx context.Context) (int64, error)
+	CountJournaledTransfers(ctx context.Context) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAdminAuditLog(ctx context.Context, arg CreateAdminAuditLogParams) (AdminAuditLog, error)
+	CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) (AuditEvent, error)
+	CreateBillSplit(ctx context.Context, arg CreateBillSplitParams) (BillSplit, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFxQuote(ctx context.Context, arg CreateFxQuoteParams) (FxQuote, error)
+	CreateFxTransfer(ctx context.Context, arg CreateFxTransferParams) (Transfer, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateJournalTransaction(ctx context.Context, kind string) (JournalTransaction, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreatePaymentRequestEvent(ctx context.Context, arg CreatePaymentRequestEventParams) (PaymentRequestEvent, error)
+	CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error)
+	CreateReversalTransfer(ctx context.Context, arg CreateReversalTransferParams) (Transfer, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferExecution(ctx context.Context, arg CreateScheduledTransferExecutionParams) (ScheduledTransferExecution, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateStatement(ctx context.Context, arg CreateStatementParams) (CreateStatementRow, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DeferScheduledTransferRun(ctx context.Context, arg DeferScheduledTransferRunParams) error
 	DeleteAccount(ctx context.Context, id int64) error
+	FreezeAccount(ctx context.Context, arg FreezeAccountParams) (Account, error)
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountBalanceAt(ctx context.Context, arg GetAccountBalanceAtParams) (int64, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetBillSplit(ctx context.Context, id int64) (BillSplit, error)
+	GetDueScheduledTransferForUpdate(ctx context.Context, now time.Time) (ScheduledTransfer, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetExpiredHoldForUpdate(ctx context.Context, now time.Time) (Hold, error)
+	GetExpiredPaymentRequestForUpdate(ctx context.Context, now time.Time) (PaymentRequest, error)
+	GetFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error)
+	GetFxRate(ctx context.Context, arg GetFxRateParams) (FxRate, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKeyForUpdate(ctx context.Context, arg GetIdempotencyKeyForUpdateParams) (IdempotencyKey, error)
+	GetJournalTransaction(ctx context.Context, id int64) (JournalTransaction, error)
+	GetLastAuditEvent(ctx context.Context) (AuditEvent, error)
+	GetLatestReconciliationReport(ctx context.Context) (ReconciliationReport, error)
+	GetOwnerAccount(ctx context.Context, arg GetOwnerAccountParams) (Account, error)
+	GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error)
+	GetStatement(ctx context.Context, arg GetStatementParams) (Statement, error)
+	GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (Account, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserByUsernameOrEmail(ctx context.Context, recipient string) (User, error)
+	ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsPageAsc(ctx context.Context, arg ListAccountsPageAscParams) ([]Account, error)
+	ListAccountsPageDesc(ctx context.Context, arg ListAccountsPageDescParams) ([]Account, error)
+	ListAccountsWithoutStatement(ctx context.Context, arg ListAccountsWithoutStatementParams) ([]Account, error)
+	ListActiveSessions(ctx context.Context, arg ListActiveSessionsParams) ([]Session, error)
+	ListAdminAuditLogs(ctx context.Context, arg ListAdminAuditLogsParams) ([]AdminAuditLog, error)
+	ListAuditEventsAfter(ctx context.Context, arg ListAuditEventsAfterParams) ([]AuditEvent, error)
+	ListBillSplitsPageAsc(ctx context.Context, arg ListBillSplitsPageAscParams) ([]BillSplit, error)
+	ListBillSplitsPageDesc(ctx context.Context, arg ListBillSplitsPageDescParams) ([]BillSplit, error)
+	// money enters and leaves the ledger only through the system accounts, so the balances of all accounts net to zero
+	// in each currency once the entries which were written before the ledger are taken out
+	ListCurrencyNetBalances(ctx context.Context) ([]ListCurrencyNetBalancesRow, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListEntriesByJournal(ctx context.Context, journalID *int64) ([]Entry, error)
+	ListEntriesByOwner(ctx context.Context, arg ListEntriesByOwnerParams) ([]Entry, error)
+	ListEntriesPageAsc(ctx context.Context, arg ListEntriesPageAscParams) ([]Entry, error)
+	ListEntriesPageDesc(ctx context.Context, arg ListEntriesPageDescParams) ([]Entry, error)
+	ListPaymentRequestEvents(ctx context.Context, paymentRequestID int64) ([]PaymentRequestEvent, error)
+	ListPaymentRequestsPageAsc(ctx context.Context, arg ListPaymentRequestsPageAscParams) ([]PaymentRequest, error)
+	ListPaymentRequestsPageDesc(ctx context.Context, arg ListPaymentRequestsPageDescParams) ([]PaymentRequest, error)
+	ListScheduledTransferExecutions(ctx context.Context, arg ListScheduledTransferExecutionsParams) ([]ScheduledTransferExecution, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	ListSplitPaymentRequests(ctx context.Context, splitID *int64) ([]PaymentRequest, error)
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]ListStatementEntriesRow, error)
+	ListStatements(ctx context.Context, arg ListStatementsParams) ([]ListStatementsRow, error)
+	ListTransferEntryMismatches(ctx context.Context) ([]ListTransferEntryMismatchesRow, error)
+	ListTransferParties(ctx context.Context, accountIds []int64) ([]ListTransferPartiesRow, error)
+	ListTransferReversals(ctx context.Context, reversalOf *int64) ([]Transfer, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ListTransfersPageAsc(ctx context.Context, arg ListTransfersPageAscParams) ([]Transfer, error)
+	ListTransfersPageDesc(ctx context.Context, arg ListTransfersPageDescParams) ([]Transfer, error)
+	ListUnbalancedJournals(ctx context.Context) ([]ListUnbalancedJournalsRow, error)
+	LockAuditChain(ctx context.Context) error
+	MarkSessionUsed(ctx context.Context, id uuid.UUID) (Session, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SetAccountOverdraftLimit(ctx context.Context, arg SetAccountOverdraftLimitParams) (Account, error)
+	SetTransferJournal(ctx context.Context, arg SetTransferJournalParams) (Transfer, error)
+	UnblockSession(ctx context.Context, id uuid.UUID) (Session, error)
+	UnfreezeAccount(ctx context.Context, id int64) (Account, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) error
+	UpdatePaymentRequestStatus(ctx context.Context, arg UpdatePaymentRequestStatusParams) (PaymentRequest, error)
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
+	UpdateScheduledTransferRun(ctx context.Context, arg UpdateScheduledTransferRunParams) (ScheduledTransfer, error)
+	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error)
+	UpsertFxRate(ctx context.Context, arg UpsertFxRateParams) error
+	UseFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error)
 }
 
 var _ Querier = (*Queries)(nil)
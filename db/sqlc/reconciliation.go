@@ -0,0 +1,145 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// kinds of the discrepancies which are found by the reconciliation
+const (
+	DiscrepancyAccountBalance     = "account_balance"
+	DiscrepancyTransferEntries    = "transfer_entries"
+	DiscrepancyUnbalancedJournal  = "unbalanced_journal"
+	DiscrepancyCurrencyNetBalance = "currency_net_balance"
+)
+
+// * Discrepancy is an invariant of the ledger which doesn't hold, Expected is what the entries imply
+// * and Actual is what is stored. For transfer entries they are the entry counts of the journal transaction,
+// * equal counts mean the amounts or the accounts of the entries don't match the transfer
+type Discrepancy struct {
+	Kind       string `json:"kind"`
+	AccountID  *int64 `json:"account_id,omitempty"`
+	TransferID *int64 `json:"transfer_id,omitempty"`
+	JournalID  *int64 `json:"journal_id,omitempty"`
+	Currency   string `json:"currency,omitempty"`
+	Expected   int64  `json:"expected"`
+	Actual     int64  `json:"actual"`
+}
+
+// * ReconcileTxResult holds the written reconciliation report and its discrepancies
+type ReconcileTxResult struct {
+	Report        ReconciliationReport `json:"report"`
+	Discrepancies []Discrepancy        `json:"discrepancies"`
+}
+
+// * ReconcileTx checks the invariants of the ledger and writes the report with the discrepancies it finds:
+// * the balance of each account equals the sum of its entries, each transfer has its two entries (four for
+// * cross-currency ones) in its journal transaction, each journal transaction is balanced, and the balances of all
+// * accounts, system accounts included, net to zero in each currency apart from the entries written before the ledger.
+// * Checks run in one repeatable read transaction, so money which moves meanwhile doesn't show up as drift
+func (store *SQLStore) ReconcileTx(ctx context.Context) (ReconcileTxResult, error) {
+	var result ReconcileTxResult
+
+	startedAt := time.Now()
+	err := store.execTxWithIsolation(ctx, sql.LevelRepeatableRead, func(q *Queries) error {
+		result.Discrepancies = []Discrepancy{}
+
+		accounts, err := q.ListAccountBalanceMismatches(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			id := account.ID
+			result.Discrepancies = append(result.Discrepancies, Discrepancy{
+				Kind:      DiscrepancyAccountBalance,
+				AccountID: &id,
+				Expected:  account.EntryTotal,
+				Actual:    account.Balance,
+			})
+		}
+
+		transfers, err := q.ListTransferEntryMismatches(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, transfer := range transfers {
+			id := transfer.ID
+			result.Discrepancies = append(result.Discrepancies, Discrepancy{
+				Kind:       DiscrepancyTransferEntries,
+				TransferID: &id,
+				Expected:   transfer.ExpectedEntryCount,
+				Actual:     transfer.EntryCount,
+			})
+		}
+
+		journals, err := q.ListUnbalancedJournals(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, journal := range journals {
+			result.Discrepancies = append(result.Discrepancies, Discrepancy{
+				Kind:      DiscrepancyUnbalancedJournal,
+				JournalID: journal.JournalID,
+				Currency:  journal.Currency,
+				Expected:  0,
+				Actual:    journal.Total,
+			})
+		}
+
+		currencies, err := q.ListCurrencyNetBalances(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		for _, currency := range currencies {
+			if currency.NetBalance != 0 {
+				result.Discrepancies = append(result.Discrepancies, Discrepancy{
+					Kind:     DiscrepancyCurrencyNetBalance,
+					Currency: currency.Currency,
+					Expected: 0,
+					Actual:   currency.NetBalance,
+				})
+			}
+		}
+
+		accountsChecked, err := q.CountAccounts(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		transfersChecked, err := q.CountJournaledTransfers(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		discrepancies, err := json.Marshal(result.Discrepancies)
+
+		if err != nil {
+			return err
+		}
+
+		result.Report, err = q.CreateReconciliationReport(ctx, CreateReconciliationReportParams{
+			AccountsChecked:   accountsChecked,
+			TransfersChecked:  transfersChecked,
+			CurrenciesChecked: int64(len(currencies)),
+			DiscrepancyCount:  int64(len(result.Discrepancies)),
+			Discrepancies:     discrepancies,
+			StartedAt:         startedAt,
+		})
+
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,290 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: reconciliation.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const countAccounts = `-- name: CountAccounts :one
+SELECT count(*) FROM accounts
+`
+
+func (q *Queries) CountAccounts(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAccounts)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countJournaledTransfers = `-- name: CountJournaledTransfers :one
+SELECT count(*) FROM transfers
+WHERE journal_id IS NOT NULL
+`
+
+func (q *Queries) CountJournaledTransfers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countJournaledTransfers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createReconciliationReport = `-- name: CreateReconciliationReport :one
+INSERT INTO reconciliation_reports (
+    accounts_checked,
+    transfers_checked,
+    currencies_checked,
+    discrepancy_count,
+    discrepancies,
+    started_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING id, accounts_checked, transfers_checked, currencies_checked, discrepancy_count, discrepancies, started_at, created_at
+`
+
+type CreateReconciliationReportParams struct {
+	AccountsChecked   int64           `json:"accounts_checked"`
+	TransfersChecked  int64           `json:"transfers_checked"`
+	CurrenciesChecked int64           `json:"currencies_checked"`
+	DiscrepancyCount  int64           `json:"discrepancy_count"`
+	Discrepancies     json.RawMessage `json:"discrepancies"`
+	StartedAt         time.Time       `json:"started_at"`
+}
+
+func (q *Queries) CreateReconciliationReport(ctx context.Context, arg CreateReconciliationReportParams) (ReconciliationReport, error) {
+	row := q.db.QueryRowContext(ctx, createReconciliationReport,
+		arg.AccountsChecked,
+		arg.TransfersChecked,
+		arg.CurrenciesChecked,
+		arg.DiscrepancyCount,
+		arg.Discrepancies,
+		arg.StartedAt,
+	)
+	var i ReconciliationReport
+	err := row.Scan(
+		&i.ID,
+		&i.AccountsChecked,
+		&i.TransfersChecked,
+		&i.CurrenciesChecked,
+		&i.DiscrepancyCount,
+		&i.Discrepancies,
+		&i.StartedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLatestReconciliationReport = `-- name: GetLatestReconciliationReport :one
+SELECT id, accounts_checked, transfers_checked, currencies_checked, discrepancy_count, discrepancies, started_at, created_at FROM reconciliation_reports
+ORDER BY id DESC
+LIMIT 1
+`
+
+func (q *Queries) GetLatestReconciliationReport(ctx context.Context) (ReconciliationReport, error) {
+	row := q.db.QueryRowContext(ctx, getLatestReconciliationReport)
+	var i ReconciliationReport
+	err := row.Scan(
+		&i.ID,
+		&i.AccountsChecked,
+		&i.TransfersChecked,
+		&i.CurrenciesChecked,
+		&i.DiscrepancyCount,
+		&i.Discrepancies,
+		&i.StartedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountBalanceMismatches = `-- name: ListAccountBalanceMismatches :many
+SELECT
+    accounts.id,
+    accounts.balance,
+    COALESCE(sum(entries.amount), 0)::bigint AS entry_total
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+GROUP BY accounts.id
+HAVING accounts.balance <> COALESCE(sum(entries.amount), 0)
+ORDER BY accounts.id
+`
+
+type ListAccountBalanceMismatchesRow struct {
+	ID         int64 `json:"id"`
+	Balance    int64 `json:"balance"`
+	EntryTotal int64 `json:"entry_total"`
+}
+
+func (q *Queries) ListAccountBalanceMismatches(ctx context.Context) ([]ListAccountBalanceMismatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountBalanceMismatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListAccountBalanceMismatchesRow{}
+	for rows.Next() {
+		var i ListAccountBalanceMismatchesRow
+		if err := rows.Scan(&i.ID, &i.Balance, &i.EntryTotal); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrencyNetBalances = `-- name: ListCurrencyNetBalances :many
+SELECT
+    accounts.currency,
+    (sum(accounts.balance) - COALESCE(sum(legacy_entries.entry_total), 0))::bigint AS net_balance
+FROM accounts
+LEFT JOIN (
+    SELECT account_id, sum(amount) AS entry_total
+    FROM entries
+    WHERE journal_id IS NULL
+        AND created_at < COALESCE((SELECT min(created_at) FROM journal_transactions), 'infinity')
+    GROUP BY account_id
+) AS legacy_entries ON legacy_entries.account_id = accounts.id
+GROUP BY accounts.currency
+ORDER BY accounts.currency
+`
+
+type ListCurrencyNetBalancesRow struct {
+	Currency   string `json:"currency"`
+	NetBalance int64  `json:"net_balance"`
+}
+
+// money enters and leaves the ledger only through the system accounts, so the balances of all accounts net to zero
+// in each currency once the entries which were written before the ledger are taken out
+func (q *Queries) ListCurrencyNetBalances(ctx context.Context) ([]ListCurrencyNetBalancesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCurrencyNetBalances)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCurrencyNetBalancesRow{}
+	for rows.Next() {
+		var i ListCurrencyNetBalancesRow
+		if err := rows.Scan(&i.Currency, &i.NetBalance); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferEntryMismatches = `-- name: ListTransferEntryMismatches :many
+SELECT
+    transfers.id,
+    (CASE WHEN transfers.fx_rate IS NULL THEN 2 ELSE 4 END)::bigint AS expected_entry_count,
+    (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+    )::bigint AS entry_count
+FROM transfers
+WHERE transfers.journal_id IS NOT NULL AND (
+    (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+    ) <> CASE WHEN transfers.fx_rate IS NULL THEN 2 ELSE 4 END
+    OR (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+            AND entries.account_id = transfers.from_account_id
+            AND entries.amount = -transfers.amount
+    ) <> 1
+    OR (
+        SELECT count(*) FROM entries
+        WHERE entries.journal_id = transfers.journal_id
+            AND entries.account_id = transfers.to_account_id
+            AND entries.amount = COALESCE(transfers.converted_amount, transfers.amount)
+    ) <> 1
+)
+ORDER BY transfers.id
+`
+
+type ListTransferEntryMismatchesRow struct {
+	ID                 int64 `json:"id"`
+	ExpectedEntryCount int64 `json:"expected_entry_count"`
+	EntryCount         int64 `json:"entry_count"`
+}
+
+func (q *Queries) ListTransferEntryMismatches(ctx context.Context) ([]ListTransferEntryMismatchesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferEntryMismatches)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTransferEntryMismatchesRow{}
+	for rows.Next() {
+		var i ListTransferEntryMismatchesRow
+		if err := rows.Scan(&i.ID, &i.ExpectedEntryCount, &i.EntryCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnbalancedJournals = `-- name: ListUnbalancedJournals :many
+SELECT
+    entries.journal_id,
+    accounts.currency,
+    sum(entries.amount)::bigint AS total
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+WHERE entries.journal_id IS NOT NULL
+GROUP BY entries.journal_id, accounts.currency
+HAVING sum(entries.amount) <> 0
+ORDER BY entries.journal_id
+`
+
+type ListUnbalancedJournalsRow struct {
+	JournalID *int64 `json:"journal_id"`
+	Currency  string `json:"currency"`
+	Total     int64  `json:"total"`
+}
+
+func (q *Queries) ListUnbalancedJournals(ctx context.Context) ([]ListUnbalancedJournalsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUnbalancedJournals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnbalancedJournalsRow{}
+	for rows.Next() {
+		var i ListUnbalancedJournalsRow
+		if err := rows.Scan(&i.JournalID, &i.Currency, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createEmptyAccount creates an account without balance, so its balance matches its entries
+func createEmptyAccount(t *testing.T, currency string) Account {
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  0,
+		Currency: currency,
+	})
+	require.NoError(t, err)
+
+	return account
+}
+
+// findAccountDiscrepancy returns the balance discrepancy of the account and nil if there is none
+func findAccountDiscrepancy(discrepancies []Discrepancy, accountID int64) *Discrepancy {
+	for i := range discrepancies {
+		discrepancy := discrepancies[i]
+
+		if discrepancy.Kind == DiscrepancyAccountBalance && *discrepancy.AccountID == accountID {
+			return &discrepancy
+		}
+	}
+
+	return nil
+}
+
+// TestReconcileTx tests that the ledger moves are reconciled and a balance which drifts from its entries is reported
+func TestReconcileTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createEmptyAccount(t, "USD")
+	account2 := createEmptyAccount(t, "USD")
+
+	_, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: account1.ID,
+		Amount:    100,
+	})
+	require.NoError(t, err)
+
+	transfer, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        40,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, transfer.Transfer.JournalID)
+
+	result, err := store.ReconcileTx(context.Background())
+	require.NoError(t, err)
+	require.NotZero(t, result.Report.ID)
+	require.NotZero(t, result.Report.AccountsChecked)
+	require.NotZero(t, result.Report.TransfersChecked)
+	require.Equal(t, int64(len(result.Discrepancies)), result.Report.DiscrepancyCount)
+
+	//! other tests leave drifted accounts behind, so we only check our own records
+	for _, discrepancy := range result.Discrepancies {
+		if discrepancy.AccountID != nil {
+			require.NotEqual(t, account1.ID, *discrepancy.AccountID)
+			require.NotEqual(t, account2.ID, *discrepancy.AccountID)
+		}
+
+		if discrepancy.TransferID != nil {
+			require.NotEqual(t, transfer.Transfer.ID, *discrepancy.TransferID)
+		}
+
+		if discrepancy.JournalID != nil {
+			require.NotEqual(t, *transfer.Transfer.JournalID, *discrepancy.JournalID)
+		}
+	}
+
+	//! balance is changed without an entry
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account2.ID,
+		Amount: 5,
+	})
+	require.NoError(t, err)
+
+	result, err = store.ReconcileTx(context.Background())
+	require.NoError(t, err)
+
+	discrepancy := findAccountDiscrepancy(result.Discrepancies, account2.ID)
+	require.NotNil(t, discrepancy)
+	require.Equal(t, int64(40), discrepancy.Expected)
+	require.Equal(t, int64(45), discrepancy.Actual)
+
+	report, err := testQueries.GetLatestReconciliationReport(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, result.Report.ID, report.ID)
+
+	var stored []Discrepancy
+	err = json.Unmarshal(report.Discrepancies, &stored)
+	require.NoError(t, err)
+	require.NotNil(t, findAccountDiscrepancy(stored, account2.ID))
+}
+
+// currencyNetBalance returns the net balance of the currency which is reported by the discrepancies, zero if there is none
+func currencyNetBalance(discrepancies []Discrepancy, currency string) int64 {
+	for _, discrepancy := range discrepancies {
+		if discrepancy.Kind == DiscrepancyCurrencyNetBalance && discrepancy.Currency == currency {
+			return discrepancy.Actual
+		}
+	}
+
+	return 0
+}
+
+// TestReconcileTxCurrencyNetBalance tests that money which is created outside of the ledger is reported
+// even though the balance of the account matches its entries and no journal transaction is unbalanced
+func TestReconcileTxCurrencyNetBalance(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createEmptyAccount(t, "CAD")
+
+	//! a journal transaction is written first, so the seeded entry isn't one which was written before the ledger
+	_, err := store.EntryTx(context.Background(), EntryTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	})
+	require.NoError(t, err)
+
+	before, err := store.ReconcileTx(context.Background())
+	require.NoError(t, err)
+
+	//! other tests leave drifted accounts behind, so we compare the net balance before and after the drift
+	netBalance := currencyNetBalance(before.Discrepancies, "CAD")
+
+	//! the entry and its balance are written without a journal transaction while the ledger trigger is disabled
+	tx, err := testDB.BeginTx(context.Background(), nil)
+	require.NoError(t, err)
+
+	_, err = tx.ExecContext(context.Background(), `ALTER TABLE entries DISABLE TRIGGER entries_balanced`)
+	require.NoError(t, err)
+
+	_, err = New(tx).CreateEntry(context.Background(), CreateEntryParams{
+		AccountID: account.ID,
+		Amount:    50,
+	})
+	require.NoError(t, err)
+
+	_, err = New(tx).AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: 50,
+	})
+	require.NoError(t, err)
+
+	_, err = tx.ExecContext(context.Background(), `ALTER TABLE entries ENABLE TRIGGER entries_balanced`)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	after, err := store.ReconcileTx(context.Background())
+	require.NoError(t, err)
+
+	//! the balance matches the entries and the entry has no journal, so only the net balance of the currency drifts
+	require.Nil(t, findAccountDiscrepancy(after.Discrepancies, account.ID))
+	require.Equal(t, netBalance+50, currencyNetBalance(after.Discrepancies, "CAD"))
+}
@@ -0,0 +1,438 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: scheduled_transfer.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const cancelScheduledTransfer = `-- name: CancelScheduledTransfer :one
+UPDATE scheduled_transfers
+SET status = 'cancelled'
+WHERE id = $1 AND status = 'active'
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+`
+
+func (q *Queries) CancelScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, cancelScheduledTransfer, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createScheduledTransfer = `-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers(
+    owner,
+    from_account_id,
+    to_account_id,
+    amount,
+    frequency,
+    start_at,
+    scheduled_at,
+    run_at,
+    end_at,
+    max_occurrences,
+    max_retries,
+    retry_interval
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $6, $6, $7, $8, $9, $10
+) RETURNING id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+`
+
+type CreateScheduledTransferParams struct {
+	Owner          string     `json:"owner"`
+	FromAccountID  int64      `json:"from_account_id"`
+	ToAccountID    int64      `json:"to_account_id"`
+	Amount         int64      `json:"amount"`
+	Frequency      string     `json:"frequency"`
+	StartAt        time.Time  `json:"start_at"`
+	EndAt          *time.Time `json:"end_at"`
+	MaxOccurrences *int32     `json:"max_occurrences"`
+	MaxRetries     int32      `json:"max_retries"`
+	RetryInterval  int64      `json:"retry_interval"`
+}
+
+func (q *Queries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, createScheduledTransfer,
+		arg.Owner,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.Frequency,
+		arg.StartAt,
+		arg.EndAt,
+		arg.MaxOccurrences,
+		arg.MaxRetries,
+		arg.RetryInterval,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createScheduledTransferExecution = `-- name: CreateScheduledTransferExecution :one
+INSERT INTO scheduled_transfer_executions(
+    scheduled_transfer_id,
+    transfer_id,
+    status,
+    failure_reason
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, scheduled_transfer_id, transfer_id, status, failure_reason, executed_at
+`
+
+type CreateScheduledTransferExecutionParams struct {
+	ScheduledTransferID int64   `json:"scheduled_transfer_id"`
+	TransferID          *int64  `json:"transfer_id"`
+	Status              string  `json:"status"`
+	FailureReason       *string `json:"failure_reason"`
+}
+
+func (q *Queries) CreateScheduledTransferExecution(ctx context.Context, arg CreateScheduledTransferExecutionParams) (ScheduledTransferExecution, error) {
+	row := q.db.QueryRowContext(ctx, createScheduledTransferExecution,
+		arg.ScheduledTransferID,
+		arg.TransferID,
+		arg.Status,
+		arg.FailureReason,
+	)
+	var i ScheduledTransferExecution
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduledTransferID,
+		&i.TransferID,
+		&i.Status,
+		&i.FailureReason,
+		&i.ExecutedAt,
+	)
+	return i, err
+}
+
+const deferScheduledTransferRun = `-- name: DeferScheduledTransferRun :exec
+UPDATE scheduled_transfers
+SET run_at = $2
+WHERE id = $1 AND status = 'active'
+`
+
+type DeferScheduledTransferRunParams struct {
+	ID    int64     `json:"id"`
+	RunAt time.Time `json:"run_at"`
+}
+
+func (q *Queries) DeferScheduledTransferRun(ctx context.Context, arg DeferScheduledTransferRunParams) error {
+	_, err := q.db.ExecContext(ctx, deferScheduledTransferRun, arg.ID, arg.RunAt)
+	return err
+}
+
+const getDueScheduledTransferForUpdate = `-- name: GetDueScheduledTransferForUpdate :one
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+FROM scheduled_transfers
+WHERE status = 'active' AND run_at <= $1
+ORDER BY run_at
+LIMIT 1
+FOR NO KEY UPDATE SKIP LOCKED
+`
+
+func (q *Queries) GetDueScheduledTransferForUpdate(ctx context.Context, now time.Time) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getDueScheduledTransferForUpdate, now)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getScheduledTransfer = `-- name: GetScheduledTransfer :one
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+FROM scheduled_transfers
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, getScheduledTransfer, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listScheduledTransferExecutions = `-- name: ListScheduledTransferExecutions :many
+SELECT id, scheduled_transfer_id, transfer_id, status, failure_reason, executed_at
+FROM scheduled_transfer_executions
+WHERE scheduled_transfer_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransferExecutionsParams struct {
+	ScheduledTransferID int64 `json:"scheduled_transfer_id"`
+	Limit               int32 `json:"limit"`
+	Offset              int32 `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransferExecutions(ctx context.Context, arg ListScheduledTransferExecutionsParams) ([]ScheduledTransferExecution, error) {
+	rows, err := q.db.QueryContext(ctx, listScheduledTransferExecutions, arg.ScheduledTransferID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransferExecution{}
+	for rows.Next() {
+		var i ScheduledTransferExecution
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduledTransferID,
+			&i.TransferID,
+			&i.Status,
+			&i.FailureReason,
+			&i.ExecutedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransfers = `-- name: ListScheduledTransfers :many
+SELECT id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransfersParams struct {
+	Owner  string `json:"owner"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.QueryContext(ctx, listScheduledTransfers, arg.Owner, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.Frequency,
+			&i.StartAt,
+			&i.ScheduledAt,
+			&i.RunAt,
+			&i.EndAt,
+			&i.MaxOccurrences,
+			&i.Occurrences,
+			&i.MaxRetries,
+			&i.RetryInterval,
+			&i.Retries,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateScheduledTransfer = `-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    amount = COALESCE($1, amount),
+    end_at = COALESCE($2, end_at),
+    max_occurrences = COALESCE($3, max_occurrences),
+    max_retries = COALESCE($4, max_retries),
+    retry_interval = COALESCE($5, retry_interval)
+WHERE id = $6 AND status = 'active'
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+`
+
+type UpdateScheduledTransferParams struct {
+	Amount         sql.NullInt64 `json:"amount"`
+	EndAt          *time.Time    `json:"end_at"`
+	MaxOccurrences *int32        `json:"max_occurrences"`
+	MaxRetries     sql.NullInt32 `json:"max_retries"`
+	RetryInterval  sql.NullInt64 `json:"retry_interval"`
+	ID             int64         `json:"id"`
+}
+
+func (q *Queries) UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, updateScheduledTransfer,
+		arg.Amount,
+		arg.EndAt,
+		arg.MaxOccurrences,
+		arg.MaxRetries,
+		arg.RetryInterval,
+		arg.ID,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateScheduledTransferRun = `-- name: UpdateScheduledTransferRun :one
+UPDATE scheduled_transfers
+SET
+    scheduled_at = $2,
+    run_at = $3,
+    occurrences = $4,
+    retries = $5,
+    status = $6
+WHERE id = $1
+RETURNING id, owner, from_account_id, to_account_id, amount, frequency, start_at, scheduled_at, run_at, end_at, max_occurrences, occurrences, max_retries, retry_interval, retries, status, created_at
+`
+
+type UpdateScheduledTransferRunParams struct {
+	ID          int64     `json:"id"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	RunAt       time.Time `json:"run_at"`
+	Occurrences int32     `json:"occurrences"`
+	Retries     int32     `json:"retries"`
+	Status      string    `json:"status"`
+}
+
+func (q *Queries) UpdateScheduledTransferRun(ctx context.Context, arg UpdateScheduledTransferRunParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRowContext(ctx, updateScheduledTransferRun,
+		arg.ID,
+		arg.ScheduledAt,
+		arg.RunAt,
+		arg.Occurrences,
+		arg.Retries,
+		arg.Status,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.Frequency,
+		&i.StartAt,
+		&i.ScheduledAt,
+		&i.RunAt,
+		&i.EndAt,
+		&i.MaxOccurrences,
+		&i.Occurrences,
+		&i.MaxRetries,
+		&i.RetryInterval,
+		&i.Retries,
+		&i.Status,
+		&i.CreatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,292 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomScheduledTransfer creates a scheduled transfer between two new accounts,
+// it starts at a random time far in the past so only the test executes it
+func createRandomScheduledTransfer(t *testing.T, frequency string, amount int64, maxRetries int32) ScheduledTransfer {
+	acc1 := createRandomAccount(t)
+	acc2 := createRandomAccount(t)
+
+	arg := CreateScheduledTransferParams{
+		Owner:         acc1.Owner,
+		FromAccountID: acc1.ID,
+		ToAccountID:   acc2.ID,
+		Amount:        amount,
+		Frequency:     frequency,
+		StartAt:       time.Unix(util.RandomInt(0, 1000000), 0).UTC(),
+		MaxRetries:    maxRetries,
+		RetryInterval: 60,
+	}
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.NotZero(t, scheduled.ID)
+	require.Equal(t, arg.Owner, scheduled.Owner)
+	require.Equal(t, arg.Amount, scheduled.Amount)
+	require.Equal(t, arg.Frequency, scheduled.Frequency)
+	require.WithinDuration(t, arg.StartAt, scheduled.StartAt, time.Second)
+	require.WithinDuration(t, arg.StartAt, scheduled.ScheduledAt, time.Second)
+	require.WithinDuration(t, arg.StartAt, scheduled.RunAt, time.Second)
+	require.Zero(t, scheduled.Occurrences)
+	require.Equal(t, ScheduledTransferActive, scheduled.Status)
+
+	return scheduled
+}
+
+// TestCreateScheduledTransfer tests CreateScheduledTransfer func
+func TestCreateScheduledTransfer(t *testing.T) {
+	createRandomScheduledTransfer(t, util.Daily, 10, 0)
+}
+
+// TestCancelScheduledTransfer tests CancelScheduledTransfer func, only active ones can be cancelled
+func TestCancelScheduledTransfer(t *testing.T) {
+	scheduled := createRandomScheduledTransfer(t, util.Weekly, 10, 0)
+
+	cancelled, err := testQueries.CancelScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferCancelled, cancelled.Status)
+
+	_, err = testQueries.CancelScheduledTransfer(context.Background(), scheduled.ID)
+	require.EqualError(t, err, sql.ErrNoRows.Error())
+}
+
+// TestExecuteScheduledTransferTx tests executing a recurring scheduled transfer
+func TestExecuteScheduledTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	amount := int64(10)
+
+	scheduled := createRandomScheduledTransfer(t, util.Daily, amount, 0)
+
+	result, err := store.ExecuteScheduledTransferTx(context.Background(), scheduled.RunAt)
+	require.NoError(t, err)
+
+	require.Equal(t, scheduled.ID, result.ScheduledTransfer.ID)
+	require.Equal(t, ExecutionSucceeded, result.Execution.Status)
+	require.Nil(t, result.Execution.FailureReason)
+	require.NotNil(t, result.Transfer)
+	require.NotNil(t, result.Execution.TransferID)
+	require.Equal(t, result.Transfer.Transfer.ID, *result.Execution.TransferID)
+	require.Equal(t, amount, result.Transfer.Transfer.Amount)
+
+	//! next occurrence is scheduled for the next day
+	require.Equal(t, int32(1), result.ScheduledTransfer.Occurrences)
+	require.Equal(t, ScheduledTransferActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, scheduled.StartAt.AddDate(0, 0, 1), result.ScheduledTransfer.ScheduledAt, time.Second)
+	require.WithinDuration(t, scheduled.StartAt.AddDate(0, 0, 1), result.ScheduledTransfer.RunAt, time.Second)
+
+	//! nothing is due until the next occurrence
+	_, err = store.ExecuteScheduledTransferTx(context.Background(), scheduled.RunAt)
+	require.ErrorIs(t, err, ErrNoScheduledTransferDue)
+
+	_, err = testQueries.CancelScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+}
+
+// TestExecuteScheduledTransferTxFailure tests that a failed one-off transfer is retried and then marked as failed
+func TestExecuteScheduledTransferTxFailure(t *testing.T) {
+	store := NewStore(testDB)
+
+	// amount is more than the balance of a random account
+	scheduled := createRandomScheduledTransfer(t, util.Once, 10000, 1)
+
+	result, err := store.ExecuteScheduledTransferTx(context.Background(), scheduled.RunAt)
+	require.NoError(t, err)
+
+	require.Equal(t, ExecutionFailed, result.Execution.Status)
+	require.NotNil(t, result.Execution.FailureReason)
+	require.Equal(t, ErrInsufficientFunds.Error(), *result.Execution.FailureReason)
+	require.Nil(t, result.Execution.TransferID)
+	require.Nil(t, result.Transfer)
+
+	//! first failure is retried after the retry interval
+	require.Equal(t, int32(1), result.ScheduledTransfer.Retries)
+	require.Equal(t, ScheduledTransferActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, scheduled.RunAt.Add(time.Minute), result.ScheduledTransfer.RunAt, time.Second)
+
+	//! money is not moved
+	fromAccount, err := testQueries.GetAccount(context.Background(), scheduled.FromAccountID)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, fromAccount.Balance, int64(0))
+
+	//! after the retries are exhausted one-off transfer fails
+	result, err = store.ExecuteScheduledTransferTx(context.Background(), result.ScheduledTransfer.RunAt)
+	require.NoError(t, err)
+
+	require.Equal(t, ExecutionFailed, result.Execution.Status)
+	require.Equal(t, ScheduledTransferFailed, result.ScheduledTransfer.Status)
+
+	executions, err := testQueries.ListScheduledTransferExecutions(context.Background(), ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               5,
+		Offset:              0,
+	})
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+}
+
+// TestExecuteScheduledTransferTxDeferred tests that a scheduled transfer whose execution fails outside of the savepoint
+// is pushed back without using up its retries, so it doesn't stay the one which is due the longest
+func TestExecuteScheduledTransferTxDeferred(t *testing.T) {
+	store := NewStore(testDB)
+
+	//! the accounts have different currencies, so the journal isn't balanced and the transaction fails at commit
+	from := createAccountWithBalance(t, 100)
+	to := createEmptyAccount(t, util.EUR)
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), CreateScheduledTransferParams{
+		Owner:         from.Owner,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Amount:        10,
+		Frequency:     util.Once,
+		StartAt:       time.Unix(util.RandomInt(0, 1000000), 0).UTC(),
+		MaxRetries:    1,
+		RetryInterval: 60,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ExecuteScheduledTransferTx(context.Background(), scheduled.RunAt)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrNoScheduledTransferDue)
+
+	deferred, err := testQueries.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, ScheduledTransferActive, deferred.Status)
+	require.Zero(t, deferred.Retries)
+	require.WithinDuration(t, scheduled.RunAt.Add(scheduledTransferDeferral), deferred.RunAt, time.Second)
+
+	//! no execution is recorded and money isn't moved
+	executions, err := testQueries.ListScheduledTransferExecutions(context.Background(), ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               5,
+		Offset:              0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, executions)
+
+	fromAccount, err := testQueries.GetAccount(context.Background(), from.ID)
+	require.NoError(t, err)
+	require.Equal(t, from.Balance, fromAccount.Balance)
+
+	_, err = testQueries.CancelScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+}
+
+// TestIsScheduledTransferFailure tests that only the errors of the accounts are recorded as failed executions
+func TestIsScheduledTransferFailure(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "insufficient funds", err: ErrInsufficientFunds, expected: true},
+		{name: "frozen account", err: ErrAccountFrozen, expected: true},
+		{name: "closed account", err: ErrAccountClosed, expected: true},
+		{name: "system account", err: ErrSystemAccount, expected: true},
+		{name: "wrapped failure", err: fmt.Errorf("cannot transfer: %w", ErrInsufficientFunds), expected: true},
+		{name: "deadlock", err: &pq.Error{Code: "40P01"}, expected: false},
+		{name: "serialization failure", err: &pq.Error{Code: "40001"}, expected: false},
+		{name: "connection error", err: driver.ErrBadConn, expected: false},
+		{name: "context cancelled", err: context.Canceled, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isScheduledTransferFailure(tc.err))
+		})
+	}
+}
+
+// TestNextScheduledTransferRun tests calculating the next run of a scheduled transfer after its execution
+func TestNextScheduledTransferRun(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 9, 0, 0, 0, time.UTC)
+	now := start.Add(time.Minute)
+	maxOccurrences := int32(2)
+	endAt := start.AddDate(0, 1, 0)
+
+	testCases := []struct {
+		name      string
+		scheduled ScheduledTransfer
+		succeeded bool
+		check     func(t *testing.T, arg UpdateScheduledTransferRunParams)
+	}{
+		{
+			name:      "monthly succeeded",
+			scheduled: ScheduledTransfer{Frequency: util.Monthly, StartAt: start, ScheduledAt: start, RunAt: start},
+			succeeded: true,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferActive, arg.Status)
+				require.Equal(t, int32(1), arg.Occurrences)
+				require.Equal(t, time.Date(2023, time.February, 28, 9, 0, 0, 0, time.UTC), arg.ScheduledAt)
+				require.Equal(t, arg.ScheduledAt, arg.RunAt)
+			},
+		},
+		{
+			name:      "failed with retries left",
+			scheduled: ScheduledTransfer{Frequency: util.Daily, StartAt: start, ScheduledAt: start, RunAt: start, MaxRetries: 2, RetryInterval: 30},
+			succeeded: false,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferActive, arg.Status)
+				require.Equal(t, int32(0), arg.Occurrences)
+				require.Equal(t, int32(1), arg.Retries)
+				require.Equal(t, start, arg.ScheduledAt)
+				require.Equal(t, now.Add(30*time.Second), arg.RunAt)
+			},
+		},
+		{
+			name:      "failed recurring without retries is skipped",
+			scheduled: ScheduledTransfer{Frequency: util.Weekly, StartAt: start, ScheduledAt: start, RunAt: start},
+			succeeded: false,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferActive, arg.Status)
+				require.Equal(t, int32(1), arg.Occurrences)
+				require.Equal(t, start.AddDate(0, 0, 7), arg.ScheduledAt)
+			},
+		},
+		{
+			name:      "max occurrences",
+			scheduled: ScheduledTransfer{Frequency: util.Daily, StartAt: start, ScheduledAt: start, RunAt: start, Occurrences: 1, MaxOccurrences: &maxOccurrences},
+			succeeded: true,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferCompleted, arg.Status)
+				require.Equal(t, int32(2), arg.Occurrences)
+			},
+		},
+		{
+			name:      "end date",
+			scheduled: ScheduledTransfer{Frequency: util.Monthly, StartAt: start, ScheduledAt: start, RunAt: start, Occurrences: 1, EndAt: &endAt},
+			succeeded: true,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferCompleted, arg.Status)
+			},
+		},
+		{
+			name:      "one-off succeeded",
+			scheduled: ScheduledTransfer{Frequency: util.Once, StartAt: start, ScheduledAt: start, RunAt: start},
+			succeeded: true,
+			check: func(t *testing.T, arg UpdateScheduledTransferRunParams) {
+				require.Equal(t, ScheduledTransferCompleted, arg.Status)
+				require.Equal(t, int32(1), arg.Occurrences)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, nextScheduledTransferRun(tc.scheduled, tc.succeeded, now))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// createRandomSession creates a new session for the given user
+func createRandomSession(t *testing.T, username string) Session {
+	id := uuid.New()
+
+	arg := CreateSessionParams{
+		ID:           id,
+		Username:     username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+
+	session, err := testQueries.CreateSession(context.Background(), arg)
+
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.Username, session.Username)
+	require.Equal(t, arg.RefreshToken, session.RefreshToken)
+	require.False(t, session.IsBlocked)
+	require.Equal(t, id, session.FamilyID)
+	require.Nil(t, session.ParentID)
+	require.Nil(t, session.UsedAt)
+	require.WithinDuration(t, arg.ExpiresAt, session.ExpiresAt, time.Second)
+
+	return session
+}
+
+// TestBlockSession tests that blocked sessions aren't listed as active
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+
+	session1 := createRandomSession(t, user.Username)
+	session2 := createRandomSession(t, user.Username)
+
+	blocked, err := testQueries.BlockSession(context.Background(), session1.ID)
+	require.NoError(t, err)
+	require.True(t, blocked.IsBlocked)
+
+	sessions, err := testQueries.ListActiveSessions(context.Background(), ListActiveSessionsParams{
+		Username: user.Username,
+		Limit:    5,
+		Offset:   0,
+	})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session2.ID, sessions[0].ID)
+}
+
+// TestBlockOtherSessions tests that every session of the user except the given one is blocked
+func TestBlockOtherSessions(t *testing.T) {
+	user := createRandomUser(t)
+	current := createRandomSession(t, user.Username)
+
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user.Username)
+	}
+
+	// sessions of other users are not affected
+	other := createRandomSession(t, createRandomUser(t).Username)
+
+	revoked, err := testQueries.BlockOtherSessions(context.Background(), BlockOtherSessionsParams{
+		Username: user.Username,
+		ID:       current.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), revoked)
+
+	sessions, err := testQueries.ListActiveSessions(context.Background(), ListActiveSessionsParams{
+		Username: user.Username,
+		Limit:    5,
+		Offset:   0,
+	})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, current.ID, sessions[0].ID)
+
+	other, err = testQueries.GetSession(context.Background(), other.ID)
+	require.NoError(t, err)
+	require.False(t, other.IsBlocked)
+}
+
+// TestRotateSessionTx tests that a rotated refresh token can't be used again and its reuse blocks the whole family
+func TestRotateSessionTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	root := createRandomSession(t, user.Username)
+
+	newSession := func() CreateSessionParams {
+		return CreateSessionParams{
+			ID:           uuid.New(),
+			RefreshToken: util.RandomString(32),
+			UserAgent:    util.RandomString(10),
+			ClientIp:     "127.0.0.1",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}
+	}
+
+	result, err := store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  root.ID,
+		NewSession: newSession(),
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, root.ID, result.ParentSession.ID)
+	require.NotNil(t, result.ParentSession.UsedAt)
+
+	child := result.Session
+	require.Equal(t, user.Username, child.Username)
+	require.Equal(t, root.FamilyID, child.FamilyID)
+	require.NotNil(t, child.ParentID)
+	require.Equal(t, root.ID, *child.ParentID)
+	require.Nil(t, child.UsedAt)
+
+	//! presenting the rotated token again blocks the family
+	_, err = store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  root.ID,
+		NewSession: newSession(),
+	})
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	child, err = testQueries.GetSession(context.Background(), child.ID)
+	require.NoError(t, err)
+	require.True(t, child.IsBlocked)
+
+	_, err = store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		SessionID:  child.ID,
+		NewSession: newSession(),
+	})
+	require.ErrorIs(t, err, ErrSessionBlocked)
+}
+
+// TestUnblockSessionTx tests that an admin unblocks only blocked sessions and it is audited
+func TestUnblockSessionTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	admin := createRandomUser(t)
+	user := createRandomUser(t)
+	session := createRandomSession(t, user.Username)
+
+	arg := UnblockSessionTxParams{
+		AdminUsername: admin.Username,
+		SessionID:     session.ID,
+		Reason:        util.RandomString(12),
+	}
+
+	//! session which is not blocked can't be unblocked
+	_, err := store.UnblockSessionTx(context.Background(), arg)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	_, err = testQueries.BlockSession(context.Background(), session.ID)
+	require.NoError(t, err)
+
+	result, err := store.UnblockSessionTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	require.False(t, result.Session.IsBlocked)
+	require.Equal(t, admin.Username, result.AuditLog.AdminUsername)
+	require.Equal(t, AdminActionUnblockSession, result.AuditLog.Action)
+	require.Equal(t, AuditTargetSession, result.AuditLog.TargetType)
+	require.Equal(t, session.ID.String(), result.AuditLog.TargetID)
+	require.Equal(t, arg.Reason, result.AuditLog.Reason)
+
+	//! the unblock is also chained to the audit events
+	event, err := testQueries.GetLastAuditEvent(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, AuditEventUnblockSession, event.Action)
+	require.Equal(t, AuditTargetSession, event.TargetType)
+	require.Equal(t, session.ID.String(), event.TargetID)
+}
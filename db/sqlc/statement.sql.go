@@ -0,0 +1,221 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.15.0
+// source: statement.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createStatement = `-- name: CreateStatement :one
+INSERT INTO statements (
+    account_id,
+    period_start,
+    period_end,
+    opening_balance,
+    closing_balance,
+    file_name,
+    content
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (account_id, period_start) DO NOTHING
+RETURNING id, account_id, period_start, period_end, opening_balance, closing_balance, file_name, created_at
+`
+
+type CreateStatementParams struct {
+	AccountID      int64     `json:"account_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	FileName       string    `json:"file_name"`
+	Content        []byte    `json:"content"`
+}
+
+type CreateStatementRow struct {
+	ID             int64     `json:"id"`
+	AccountID      int64     `json:"account_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	FileName       string    `json:"file_name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (q *Queries) CreateStatement(ctx context.Context, arg CreateStatementParams) (CreateStatementRow, error) {
+	row := q.db.QueryRowContext(ctx, createStatement,
+		arg.AccountID,
+		arg.PeriodStart,
+		arg.PeriodEnd,
+		arg.OpeningBalance,
+		arg.ClosingBalance,
+		arg.FileName,
+		arg.Content,
+	)
+	var i CreateStatementRow
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.OpeningBalance,
+		&i.ClosingBalance,
+		&i.FileName,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getStatement = `-- name: GetStatement :one
+SELECT id, account_id, period_start, period_end, opening_balance, closing_balance, file_name, content, created_at FROM statements
+WHERE id = $1 AND account_id = $2
+LIMIT 1
+`
+
+type GetStatementParams struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+}
+
+func (q *Queries) GetStatement(ctx context.Context, arg GetStatementParams) (Statement, error) {
+	row := q.db.QueryRowContext(ctx, getStatement, arg.ID, arg.AccountID)
+	var i Statement
+	err := row.Scan(
+		&i.ID,
+		&i.AccountID,
+		&i.PeriodStart,
+		&i.PeriodEnd,
+		&i.OpeningBalance,
+		&i.ClosingBalance,
+		&i.FileName,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAccountsWithoutStatement = `-- name: ListAccountsWithoutStatement :many
+SELECT id, owner, balance, currency, created_at, status, closed_at, system_purpose, overdraft_limit, held_amount, available_balance, frozen_by FROM accounts
+WHERE accounts.id > $1
+    AND accounts.system_purpose IS NULL
+    AND accounts.created_at < $2::timestamptz
+    AND (accounts.closed_at IS NULL OR accounts.closed_at >= $3::timestamptz)
+    AND NOT EXISTS (
+        SELECT 1 FROM statements
+        WHERE statements.account_id = accounts.id
+            AND statements.period_start = $3::timestamptz
+    )
+ORDER BY id
+LIMIT $4
+`
+
+type ListAccountsWithoutStatementParams struct {
+	AfterID     int64     `json:"after_id"`
+	PeriodEnd   time.Time `json:"period_end"`
+	PeriodStart time.Time `json:"period_start"`
+	LimitCount  int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListAccountsWithoutStatement(ctx context.Context, arg ListAccountsWithoutStatementParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsWithoutStatement,
+		arg.AfterID,
+		arg.PeriodEnd,
+		arg.PeriodStart,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.Status,
+			&i.ClosedAt,
+			&i.SystemPurpose,
+			&i.OverdraftLimit,
+			&i.HeldAmount,
+			&i.AvailableBalance,
+			&i.FrozenBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listStatements = `-- name: ListStatements :many
+SELECT id, account_id, period_start, period_end, opening_balance, closing_balance, file_name, created_at
+FROM statements
+WHERE account_id = $1
+ORDER BY period_start DESC
+LIMIT $2
+OFFSET $3
+`
+
+type ListStatementsParams struct {
+	AccountID int64 `json:"account_id"`
+	Limit     int32 `json:"limit"`
+	Offset    int32 `json:"offset"`
+}
+
+type ListStatementsRow struct {
+	ID             int64     `json:"id"`
+	AccountID      int64     `json:"account_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	FileName       string    `json:"file_name"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (q *Queries) ListStatements(ctx context.Context, arg ListStatementsParams) ([]ListStatementsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listStatements, arg.AccountID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListStatementsRow{}
+	for rows.Next() {
+		var i ListStatementsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.PeriodStart,
+			&i.PeriodEnd,
+			&i.OpeningBalance,
+			&i.ClosingBalance,
+			&i.FileName,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestStatements tests CreateStatement, GetStatement, ListStatements and ListAccountsWithoutStatement funcs
+func TestStatements(t *testing.T) {
+	account := createRandomAccount(t)
+
+	//! the period is in the future, so no other account has a statement for it
+	start := time.Date(time.Now().Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	period := ListAccountsWithoutStatementParams{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		LimitCount:  1000000,
+	}
+
+	accounts, err := testQueries.ListAccountsWithoutStatement(context.Background(), period)
+	require.NoError(t, err)
+	require.Contains(t, accountIDs(accounts), account.ID)
+
+	arg := CreateStatementParams{
+		AccountID:      account.ID,
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: 10,
+		ClosingBalance: 20,
+		FileName:       "statement.pdf",
+		Content:        []byte("%PDF-1.4\n"),
+	}
+
+	created, err := testQueries.CreateStatement(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+	require.Equal(t, arg.ClosingBalance, created.ClosingBalance)
+
+	//! a period has a single statement
+	_, err = testQueries.CreateStatement(context.Background(), arg)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	accounts, err = testQueries.ListAccountsWithoutStatement(context.Background(), period)
+	require.NoError(t, err)
+	require.NotContains(t, accountIDs(accounts), account.ID)
+
+	statement, err := testQueries.GetStatement(context.Background(), GetStatementParams{ID: created.ID, AccountID: account.ID})
+	require.NoError(t, err)
+	require.Equal(t, arg.Content, statement.Content)
+	require.WithinDuration(t, start, statement.PeriodStart, time.Second)
+
+	//! statements are only found with their own account
+	_, err = testQueries.GetStatement(context.Background(), GetStatementParams{ID: created.ID, AccountID: account.ID + 1})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	statements, err := testQueries.ListStatements(context.Background(), ListStatementsParams{
+		AccountID: account.ID,
+		Limit:     5,
+		Offset:    0,
+	})
+	require.NoError(t, err)
+	require.Len(t, statements, 1)
+	require.Equal(t, created.ID, statements[0].ID)
+}
+
+// accountIDs returns the IDs of the accounts
+func accountIDs(accounts []Account) []int64 {
+	ids := make([]int64, len(accounts))
+
+	for i, account := range accounts {
+		ids[i] = account.ID
+	}
+
+	return ids
+}
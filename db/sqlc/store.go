@@ -2,31 +2,144 @@ package db
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/google/uuid"
 )
 
 var ErrInsufficientFunds = errors.New("insufficient funds")
+var ErrFxQuoteUnavailable = errors.New("fx quote is expired or already used")
+var ErrConvertedAmountTooSmall = errors.New("amount is too small to be converted at the rate of the quote")
+var ErrFxQuoteCurrencyMismatch = errors.New("currencies of the accounts don't match the fx quote")
+var ErrFxQuoteNotOwned = errors.New("fx quote doesn't belong to the owner of the from account")
+var ErrIdempotencyKeyMismatch = errors.New("idempotency key is already used with a different request")
+var ErrNoScheduledTransferDue = errors.New("no scheduled transfer is due")
+var ErrTransferAlreadyReversed = errors.New("transfer is already reversed")
+var ErrRefundExceedsTransfer = errors.New("refund amount exceeds the amount which is not refunded yet")
+var ErrReversalNotReversible = errors.New("reversal of a transfer can't be reversed")
+var ErrFxTransferNotReversible = errors.New("cross-currency transfers can't be reversed")
+var ErrAccountFrozen = errors.New("account is frozen")
+var ErrAccountClosed = errors.New("account is closed")
+var ErrAccountBalanceNotZero = errors.New("account balance must be zero or swept to another account")
+var ErrInvalidSweepAccount = errors.New("sweep account must be another account of the owner")
+var ErrSweepNeedsFxQuote = errors.New("sweeping to an account with a different currency needs an fx quote")
+var ErrSessionBlocked = errors.New("blocked session")
+var ErrRefreshTokenReused = errors.New("refresh token is already used, its sessions are blocked")
+
+// statuses of the accounts
+const (
+	AccountActive = "active"
+	AccountFrozen = "frozen"
+	AccountClosed = "closed"
+)
+
+// statuses of the transfers
+const (
+	TransferCompleted         = "completed"
+	TransferPartiallyRefunded = "partially_refunded"
+	TransferReversed          = "reversed"
+)
+
+// statuses of the scheduled transfers and their executions
+const (
+	ScheduledTransferActive    = "active"
+	ScheduledTransferCompleted = "completed"
+	ScheduledTransferFailed    = "failed"
+	ScheduledTransferCancelled = "cancelled"
+
+	ExecutionSucceeded = "succeeded"
+	ExecutionFailed    = "failed"
+)
+
+// actions of the admins and the targets which are written to the admin audit logs and the audit events
+const (
+	AdminActionSearchUsers        = "search_users"
+	AdminActionListUserAccounts   = "list_user_accounts"
+	AdminActionListUserEntries    = "list_user_entries"
+	AdminActionListAccountEntries = "list_account_entries"
+	AdminActionAdjustBalance      = "adjust_balance"
+	AdminActionSetOverdraftLimit  = "set_overdraft_limit"
+	AdminActionUnblockSession     = "unblock_session"
+	AdminActionFreezeAccount      = "freeze_account"
+	AdminActionUnfreezeAccount    = "unfreeze_account"
+	AdminActionReverseTransfer    = "reverse_transfer"
+
+	AuditTargetUser     = "user"
+	AuditTargetAccount  = "account"
+	AuditTargetSession  = "session"
+	AuditTargetTransfer = "transfer"
+	AuditTargetHold     = "hold"
+
+	AuditTargetPaymentRequest = "payment_request"
+)
 
 // Store interface enables both the MockDB and our real DB can use this queries
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
 	EntryTx(ctx context.Context, arg EntryTxParams) (EntryTxResult, error)
+	FxTransferTx(ctx context.Context, arg FxTransferTxParams) (TransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
+	ExecuteScheduledTransferTx(ctx context.Context, now time.Time) (ExecuteScheduledTransferTxResult, error)
+	FreezeAccountTx(ctx context.Context, arg FreezeAccountTxParams) (Account, error)
+	UnfreezeAccountTx(ctx context.Context, arg UnfreezeAccountTxParams) (Account, error)
+	CloseAccountTx(ctx context.Context, arg CloseAccountTxParams) (CloseAccountTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error)
+	AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error)
+	SetOverdraftLimitTx(ctx context.Context, arg SetOverdraftLimitTxParams) (SetOverdraftLimitTxResult, error)
+	UnblockSessionTx(ctx context.Context, arg UnblockSessionTxParams) (UnblockSessionTxResult, error)
+	VerifyAuditChain(ctx context.Context) (AuditChainReport, error)
+	ReconcileTx(ctx context.Context) (ReconcileTxResult, error)
+	ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) ([]Account, error)
+	ListEntriesPage(ctx context.Context, arg ListEntriesPageParams) ([]Entry, error)
+	ListTransfersPage(ctx context.Context, arg ListTransfersPageParams) ([]Transfer, error)
+	TransferDetails(ctx context.Context, arg TransferDetailsParams) ([]TransferDetail, error)
+	ResolvePayee(ctx context.Context, recipient, currency string) (Payee, error)
+	CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (CreateHoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	VoidHoldTx(ctx context.Context, arg VoidHoldTxParams) (ReleaseHoldTxResult, error)
+	ExpireHoldTx(ctx context.Context, now time.Time) (ReleaseHoldTxResult, error)
+	CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (PaymentRequest, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	DeclinePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error)
+	CancelPaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error)
+	ExpirePaymentRequestTx(ctx context.Context, now time.Time) (PaymentRequest, error)
+	ListPaymentRequestsPage(ctx context.Context, arg ListPaymentRequestsPageParams) ([]PaymentRequest, error)
+	CreateBillSplitTx(ctx context.Context, arg CreateBillSplitTxParams) (BillSplitDetail, error)
+	ListBillSplitsPage(ctx context.Context, arg ListBillSplitsPageParams) ([]BillSplit, error)
+	Close(ctx context.Context) error
 }
 
 // * Store provides all functions to execute db queries and transactions
 type SQLStore struct {
 	*Queries
-	db *sql.DB
+	db    *sql.DB
+	retry TxRetryPolicy
+	txs   sync.WaitGroup // open transactions, so we can drain them before closing the DB
+}
+
+// * IdempotencyParams identifies a request which must be executed only once for the user and key
+type IdempotencyParams struct {
+	Username string
+	Key      string
 }
 
 // * TransferTxParams hold the all necessary input values for the transfer
 type TransferTxParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID int64              `json:"from_account_id"`
+	ToAccountID   int64              `json:"to_account_id"`
+	Amount        int64              `json:"amount"`
+	Idempotency   *IdempotencyParams `json:"-"`
+	Actor         *AuditActor        `json:"-"`
 }
 
 // * TransferTxResult holds the result of the transfer transaction
@@ -38,10 +151,39 @@ type TransferTxResult struct {
 	ToEntry     Entry    `json:"to_entry"`
 }
 
+// * FxTransferTxParams hold the all necessary input values for a cross-currency transfer,
+// * amount is in the currency of the from account and QuoteID is the quote which locks the rate
+type FxTransferTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	ToAccountID   int64              `json:"to_account_id"`
+	Amount        int64              `json:"amount"`
+	QuoteID       uuid.UUID          `json:"quote_id"`
+	Idempotency   *IdempotencyParams `json:"-"`
+	Actor         *AuditActor        `json:"-"`
+}
+
+// * ReverseTransferTxParams hold the input values for reversing a transfer,
+// * zero amount reverses the amount which is not refunded yet and a smaller amount is a partial refund
+type ReverseTransferTxParams struct {
+	TransferID    int64              `json:"transfer_id"`
+	Amount        int64              `json:"amount"`
+	AdminUsername string             `json:"admin_username"`
+	Idempotency   *IdempotencyParams `json:"-"`
+	Actor         *AuditActor        `json:"-"`
+}
+
+// * ReverseTransferTxResult holds the original transfer after the reversal and the result of the mirror transfer
+type ReverseTransferTxResult struct {
+	OriginalTransfer Transfer `json:"original_transfer"`
+	TransferTxResult
+}
+
 // EntryTxParams holds the params of the entryTx func
 type EntryTxParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID   int64              `json:"account_id"`
+	Amount      int64              `json:"amount"`
+	Idempotency *IdempotencyParams `json:"-"`
+	Actor       *AuditActor        `json:"-"`
 }
 
 // EntryTxResult holds the result values of the entryTx func
@@ -50,17 +192,141 @@ type EntryTxResult struct {
 	Account Account `json:"account"`
 }
 
-// * NewStore returns a new Store with the given DB
+// * ExecuteScheduledTransferTxResult holds the scheduled transfer after its execution and the execution record,
+// * Transfer is nil if the execution failed
+type ExecuteScheduledTransferTxResult struct {
+	ScheduledTransfer ScheduledTransfer          `json:"scheduled_transfer"`
+	Execution         ScheduledTransferExecution `json:"execution"`
+	Transfer          *TransferTxResult          `json:"transfer"`
+}
+
+// * FreezeAccountTxParams hold the active account which is frozen and the user who freezes it,
+// * the freeze can only be lifted by that user or by the roles which may unfreeze any account.
+// * AdminUsername is set when an admin freezes an account of another user, so it is written to the admin audit logs
+type FreezeAccountTxParams struct {
+	AccountID     int64       `json:"account_id"`
+	FrozenBy      string      `json:"frozen_by"`
+	AdminUsername string      `json:"admin_username"`
+	Actor         *AuditActor `json:"-"`
+}
+
+// * UnfreezeAccountTxParams hold the frozen account which is activated again and who activates it,
+// * AdminUsername is set when an admin unfreezes an account of another user
+type UnfreezeAccountTxParams struct {
+	AccountID     int64       `json:"account_id"`
+	AdminUsername string      `json:"admin_username"`
+	Actor         *AuditActor `json:"-"`
+}
+
+// * CloseAccountTxParams hold the input values for closing an account,
+// * the remaining balance is swept to SweepAccountID and zero means the balance must already be zero.
+// * QuoteID is only needed if the sweep account has a different currency
+type CloseAccountTxParams struct {
+	AccountID      int64       `json:"account_id"`
+	SweepAccountID int64       `json:"sweep_account_id"`
+	QuoteID        *uuid.UUID  `json:"quote_id"`
+	Actor          *AuditActor `json:"-"`
+}
+
+// * CloseAccountTxResult holds the closed account and the sweep transfer, Sweep is nil if nothing is swept
+type CloseAccountTxResult struct {
+	Account Account           `json:"account"`
+	Sweep   *TransferTxResult `json:"sweep"`
+}
+
+// * RotateSessionTxParams hold the session of the presented refresh token and the session which replaces it,
+// * username, family and parent of the new session are taken from the old one
+type RotateSessionTxParams struct {
+	SessionID  uuid.UUID
+	NewSession CreateSessionParams
+}
+
+// * RotateSessionTxResult holds the used parent session and the new session
+type RotateSessionTxResult struct {
+	ParentSession Session `json:"parent_session"`
+	Session       Session `json:"session"`
+}
+
+// * AdjustBalanceTxParams hold the input values for a balance adjustment of an admin,
+// * negative amount takes money out of the account
+type AdjustBalanceTxParams struct {
+	AdminUsername string      `json:"admin_username"`
+	AccountID     int64       `json:"account_id"`
+	Amount        int64       `json:"amount"`
+	Reason        string      `json:"reason"`
+	Actor         *AuditActor `json:"-"`
+}
+
+// * AdjustBalanceTxResult holds the adjusted account, its entry and the audit log of the adjustment
+type AdjustBalanceTxResult struct {
+	Account  Account       `json:"account"`
+	Entry    Entry         `json:"entry"`
+	AuditLog AdminAuditLog `json:"audit_log"`
+}
+
+// * SetOverdraftLimitTxParams hold the overdraft limit which an admin sets for an account and the reason of it
+type SetOverdraftLimitTxParams struct {
+	AdminUsername  string      `json:"admin_username"`
+	AccountID      int64       `json:"account_id"`
+	OverdraftLimit int64       `json:"overdraft_limit"`
+	Reason         string      `json:"reason"`
+	Actor          *AuditActor `json:"-"`
+}
+
+// * SetOverdraftLimitTxResult holds the account with its new overdraft limit and the audit log of the admin
+type SetOverdraftLimitTxResult struct {
+	Account  Account       `json:"account"`
+	AuditLog AdminAuditLog `json:"audit_log"`
+}
+
+// * UnblockSessionTxParams hold the session which is unblocked by an admin and the reason
+type UnblockSessionTxParams struct {
+	AdminUsername string      `json:"admin_username"`
+	SessionID     uuid.UUID   `json:"session_id"`
+	Reason        string      `json:"reason"`
+	Actor         *AuditActor `json:"-"`
+}
+
+// * UnblockSessionTxResult holds the unblocked session and the audit log of it
+type UnblockSessionTxResult struct {
+	Session  Session       `json:"session"`
+	AuditLog AdminAuditLog `json:"audit_log"`
+}
+
+// * NewStore returns a new Store with the given DB, its transactions are retried with the DefaultTxRetryPolicy
 func NewStore(db *sql.DB) Store {
+	return NewStoreWithTxRetry(db, DefaultTxRetryPolicy)
+}
+
+// * NewStoreWithTxRetry returns a new Store with the given DB which retries its transactions with the given policy
+func NewStoreWithTxRetry(db *sql.DB, retry TxRetryPolicy) Store {
 	return &SQLStore{
 		db:      db,
+		retry:   retry,
 		Queries: New(db),
 	}
 }
 
 // * execTx executes a function within a database transaction
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+	return store.execTxWithIsolation(ctx, sql.LevelDefault, fn)
+}
+
+// * execTxWithIsolation executes a function within a database transaction with the given isolation level.
+// * Transactions which fail because of a deadlock or a serialization failure are run again from the start with the retry policy
+// * of the store, so fn must not have effects outside of the transaction
+func (store *SQLStore) execTxWithIsolation(ctx context.Context, isolation sql.IsolationLevel, fn func(*Queries) error) error {
+	store.txs.Add(1)
+	defer store.txs.Done()
+
+	return retryTx(ctx, store.retry, func() error {
+		return store.runTx(ctx, isolation, fn)
+	})
+}
+
+// * runTx executes a function within a single database transaction
+func (store *SQLStore) runTx(ctx context.Context, isolation sql.IsolationLevel, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, &sql.TxOptions{Isolation: isolation})
 
 	if err != nil {
 		return err
@@ -71,7 +337,7 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 
 	if err != nil {
 		if rbErr := tx.Rollback(); rbErr != nil {
-			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+			return fmt.Errorf("tx err: %w, rb err: %v", err, rbErr)
 		}
 		return err
 	}
@@ -79,128 +345,951 @@ func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) erro
 	return tx.Commit()
 }
 
+// * execIdempotentTx executes fn within a database transaction only once for the idempotency key.
+// * The key is inserted in the same transaction, so concurrent duplicates wait for the first one to finish,
+// * and replays get the stored result instead of running fn again. Without a key it is the same as execTx
+func (store *SQLStore) execIdempotentTx(ctx context.Context, idempotency *IdempotencyParams, operation string, arg interface{}, result interface{}, fn func(*Queries) error) error {
+	if idempotency == nil {
+		return store.execTx(ctx, fn)
+	}
+
+	requestHash, err := hashRequest(operation, arg)
+
+	if err != nil {
+		return err
+	}
+
+	return store.execTx(ctx, func(q *Queries) error {
+		_, err := q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+			Username:    idempotency.Username,
+			Key:         idempotency.Key,
+			RequestHash: requestHash,
+		})
+
+		// no rows means the key is already used, so we replay the stored result
+		if err == sql.ErrNoRows {
+			key, err := q.GetIdempotencyKeyForUpdate(ctx, GetIdempotencyKeyForUpdateParams{
+				Username: idempotency.Username,
+				Key:      idempotency.Key,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if key.RequestHash != requestHash {
+				return ErrIdempotencyKeyMismatch
+			}
+
+			return json.Unmarshal(key.Response, result)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := fn(q); err != nil {
+			return err
+		}
+
+		response, err := json.Marshal(result)
+
+		if err != nil {
+			return err
+		}
+
+		return q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+			Username: idempotency.Username,
+			Key:      idempotency.Key,
+			Response: response,
+		})
+	})
+}
+
+// * hashRequest hashes the operation with its params, so a key can't be reused for a different request
+func hashRequest(operation string, arg interface{}) (string, error) {
+	payload, err := json.Marshal(arg)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(append([]byte(operation+":"), payload...))
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// * Close waits for the open transactions to finish, then closes the DB.
+// * If ctx is done before they finish, the DB is closed anyway and the context error is returned
+func (store *SQLStore) Close(ctx context.Context) error {
+	drained := make(chan struct{})
+
+	go func() {
+		store.txs.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return store.db.Close()
+	case <-ctx.Done():
+		if err := store.db.Close(); err != nil {
+			return fmt.Errorf("drain err: %v, close err: %v", ctx.Err(), err)
+		}
+		return fmt.Errorf("cannot drain open transactions: %w", ctx.Err())
+	}
+}
+
 // * TransferTx performs a money transfer from one account to the other.
-// * It creates a transfer record, add account entries, and update account balances within a single database transaction
+// * It creates a transfer record, add account entries, update account balances and appends the audit event
+// * within a single database transaction. The from account can't go below its overdraft limit
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
-	err := store.execTx(ctx, func(q *Queries) error {
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "transfer", arg, &result, func(q *Queries) error {
 		var err error
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
-			FromAccountID: arg.FromAccountID,
-			ToAccountID:   arg.ToAccountID,
-			Amount:        arg.Amount,
-		})
+		result, err = transfer(ctx, q, arg)
 
 		if err != nil {
 			return err
 		}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventTransfer, AuditTargetTransfer, strconv.FormatInt(result.Transfer.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * transfer creates a transfer record, add account entries, and update account balances with the given queries
+func transfer(ctx context.Context, q *Queries, arg TransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+	var err error
+
+	result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	err = moveMoney(ctx, q, &result, JournalTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount, arg.Amount)
+
+	return result, err
+}
+
+// * moveMoney posts the journal transaction of the transfer in the result, debit is taken from the from account and credit is added
+// * to the to account. Cross-currency transfers pass the legs of the fx system accounts, so the journal is balanced
+// * in each currency
+func moveMoney(ctx context.Context, q *Queries, result *TransferTxResult, kind string, fromAccountID, toAccountID, debit, credit int64, fxLegs ...ledgerLeg) error {
+	legs := append([]ledgerLeg{
+		{AccountID: fromAccountID, Amount: -debit},
+		{AccountID: toAccountID, Amount: credit},
+	}, fxLegs...)
+
+	posting, err := postJournal(ctx, q, kind, legs)
+
+	if err != nil {
+		return err
+	}
+
+	result.FromEntry = posting.Entries[0]
+	result.ToEntry = posting.Entries[1]
+	result.FromAccount = posting.Accounts[fromAccountID]
+	result.ToAccount = posting.Accounts[toAccountID]
+
+	// transfer is linked to its journal transaction, so its entries can be reconciled
+	result.Transfer, err = q.SetTransferJournal(ctx, SetTransferJournalParams{
+		ID:        result.Transfer.ID,
+		JournalID: &posting.Journal.ID,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// money moves in and out of the system accounts only with deposits, withdrawals, fx and adjustments
+	if result.FromAccount.SystemPurpose != nil || result.ToAccount.SystemPurpose != nil {
+		return ErrSystemAccount
+	}
+
+	// accounts are locked by the updates, so their statuses can't change until the transaction ends
+	if err := checkAccountStatus(result.FromAccount, true); err != nil {
+		return err
+	}
+
+	if err := checkAccountStatus(result.ToAccount, false); err != nil {
+		return err
+	}
+
+	return checkFunds(result.FromAccount)
+}
+
+// * checkAccountStatus checks if the account can send or receive money, frozen accounts can only receive
+func checkAccountStatus(account Account, sending bool) error {
+	switch {
+	case account.Status == AccountClosed:
+		return ErrAccountClosed
+	case account.Status == AccountFrozen && sending:
+		return ErrAccountFrozen
+	}
+
+	return nil
+}
+
+// * checkFunds checks if the available balance of an account which sent or held money is within its overdraft limit.
+// * The balance is the one returned by the update which locks the account, so concurrent debits are checked one after the other
+// * and can't overdraw the account together. Held money isn't available, so it can't be spent by other debits
+func checkFunds(account Account) error {
+	if account.AvailableBalance < -account.OverdraftLimit {
+		return ErrInsufficientFunds
+	}
+
+	return nil
+}
+
+// * FxTransferTx performs a money transfer between accounts with different currencies.
+// * It uses the quote, debits the amount in the from account's currency and credits the converted amount
+// * in the to account's currency, and records the rate used on the transfer within a single database transaction
+func (store *SQLStore) FxTransferTx(ctx context.Context, arg FxTransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "fx_transfer", arg, &result, func(q *Queries) error {
+		var err error
+
+		result, err = fxTransfer(ctx, q, arg)
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventFxTransfer, AuditTargetTransfer, strconv.FormatInt(result.Transfer.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// * fxTransfer uses the quote and creates a cross-currency transfer with its entries with the given queries.
+// * The accounts must have the currencies of the quote and the quote must belong to the owner of the from account
+func fxTransfer(ctx context.Context, q *Queries, arg FxTransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	// quote can be used only once and only before it expires
+	quote, err := q.UseFxQuote(ctx, arg.QuoteID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return result, ErrFxQuoteUnavailable
+		}
+		return result, err
+	}
+
+	// callers check the accounts too, but entries in the wrong currency would only fail the journal at commit
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+
+	if err != nil {
+		return result, err
+	}
+
+	toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+
+	if err != nil {
+		return result, err
+	}
+
+	if fromAccount.Currency != quote.FromCurrency || toAccount.Currency != quote.ToCurrency {
+		return result, ErrFxQuoteCurrencyMismatch
+	}
+
+	if quote.Username != fromAccount.Owner {
+		return result, ErrFxQuoteNotOwned
+	}
+
+	convertedAmount, err := util.ConvertAmount(arg.Amount, quote.Rate)
+
+	if err != nil {
+		return result, err
+	}
+
+	// amounts which round to nothing in the to currency would be debited without crediting anything
+	if convertedAmount <= 0 {
+		return result, ErrConvertedAmountTooSmall
+	}
+
+	// fx system accounts take the amount in the from currency and give the converted amount in the to currency
+	fxFrom, err := systemAccount(ctx, q, SystemFx, quote.FromCurrency)
+
+	if err != nil {
+		return result, err
+	}
+
+	fxTo, err := systemAccount(ctx, q, SystemFx, quote.ToCurrency)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Transfer, err = q.CreateFxTransfer(ctx, CreateFxTransferParams{
+		FromAccountID:   arg.FromAccountID,
+		ToAccountID:     arg.ToAccountID,
+		Amount:          arg.Amount,
+		FxRate:          &quote.Rate,
+		ConvertedAmount: &convertedAmount,
+	})
+
+	if err != nil {
+		return result, err
+	}
+
+	err = moveMoney(ctx, q, &result, JournalFxTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount, convertedAmount,
+		ledgerLeg{AccountID: fxFrom.ID, Amount: arg.Amount},
+		ledgerLeg{AccountID: fxTo.ID, Amount: -convertedAmount},
+	)
+
+	return result, err
+}
+
+// * ReverseTransferTx moves the money of a transfer back with a mirror transfer which references the original one.
+// * The original transfer is locked so its reversals are serialized, and they can't exceed its amount in total
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "reverse_transfer", arg, &result, func(q *Queries) error {
+		original, err := q.GetTransferForUpdate(ctx, arg.TransferID)
+
+		if err != nil {
+			return err
+		}
+
+		if original.ReversalOf != nil {
+			return ErrReversalNotReversible
+		}
+
+		if original.FxRate != nil {
+			return ErrFxTransferNotReversible
+		}
+
+		if original.Status == TransferReversed {
+			return ErrTransferAlreadyReversed
+		}
+
+		amount := arg.Amount
+		refundable := original.Amount - original.RefundedAmount
+
+		if amount == 0 {
+			amount = refundable
+		}
+
+		if amount > refundable {
+			return ErrRefundExceedsTransfer
+		}
+
+		// mirror transfer moves the money from the to account back to the from account
+		result.Transfer, err = q.CreateReversalTransfer(ctx, CreateReversalTransferParams{
+			FromAccountID: original.ToAccountID,
+			ToAccountID:   original.FromAccountID,
+			Amount:        amount,
+			ReversalOf:    &original.ID,
 		})
 
 		if err != nil {
 			return err
 		}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
+		err = moveMoney(ctx, q, &result.TransferTxResult, JournalReversal, original.ToAccountID, original.FromAccountID, amount, amount)
+
+		if err != nil {
+			return err
+		}
+
+		result.OriginalTransfer, err = q.AddTransferRefund(ctx, AddTransferRefundParams{
+			ID:     original.ID,
+			Amount: amount,
 		})
 
 		if err != nil {
 			return err
 		}
 
-		//! to avoid DB deadlock reorganized the order of the DB funcs
+		err = logAdminAction(ctx, q, arg.AdminUsername, AdminActionReverseTransfer, AuditTargetTransfer, strconv.FormatInt(original.ID, 10))
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
+		if err != nil {
+			return err
+		}
 
-			if err != nil {
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventReverseTransfer, AuditTargetTransfer, strconv.FormatInt(result.Transfer.ID, 10), result)
+	})
+
+	return result, err
+}
+
+// scheduledTransferDeferral is how long a scheduled transfer waits after its execution fails with an error
+// which isn't caused by its accounts
+const scheduledTransferDeferral = 5 * time.Minute
+
+// * ExecuteScheduledTransferTx executes the scheduled transfer which is due the longest at now.
+// * The row is locked and the ones locked by other executors are skipped, so each occurrence runs once.
+// * A transfer which fails because of the accounts is rolled back to a savepoint, so the failure is recorded in the same transaction
+// * and the occurrence is retried later according to the retry policy of the scheduled transfer. Other errors fail the whole
+// * transaction, so they are retried like any other transaction and don't use up the retries of the scheduled transfer
+func (store *SQLStore) ExecuteScheduledTransferTx(ctx context.Context, now time.Time) (ExecuteScheduledTransferTxResult, error) {
+	var result ExecuteScheduledTransferTxResult
+	var scheduled ScheduledTransfer
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// a retried transaction starts over, so nothing of the failed attempt is returned
+		result = ExecuteScheduledTransferTxResult{}
+		scheduled = ScheduledTransfer{}
+
+		scheduled, err = q.GetDueScheduledTransferForUpdate(ctx, now)
+
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNoScheduledTransferDue
+			}
+			return err
+		}
+
+		if _, err := q.db.ExecContext(ctx, "SAVEPOINT scheduled_transfer"); err != nil {
+			return err
+		}
+
+		transferResult, transferErr := transfer(ctx, q, TransferTxParams{
+			FromAccountID: scheduled.FromAccountID,
+			ToAccountID:   scheduled.ToAccountID,
+			Amount:        scheduled.Amount,
+		})
+
+		executionArg := CreateScheduledTransferExecutionParams{
+			ScheduledTransferID: scheduled.ID,
+			Status:              ExecutionSucceeded,
+		}
+
+		if transferErr != nil {
+			if !isScheduledTransferFailure(transferErr) {
+				return transferErr
+			}
+
+			if _, err := q.db.ExecContext(ctx, "ROLLBACK TO SAVEPOINT scheduled_transfer"); err != nil {
 				return err
 			}
 
+			reason := transferErr.Error()
+			executionArg.Status = ExecutionFailed
+			executionArg.FailureReason = &reason
 		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
+			result.Transfer = &transferResult
+			executionArg.TransferID = &transferResult.Transfer.ID
+
+			// scheduled transfers run on behalf of their owners
+			actor := &AuditActor{
+				Username: scheduled.Owner,
+				Channel:  AuditChannelScheduler,
+			}
+
+			err := appendAuditEvent(ctx, q, actor, AuditEventScheduledTransfer, AuditTargetTransfer, strconv.FormatInt(transferResult.Transfer.ID, 10), transferResult)
 
 			if err != nil {
 				return err
 			}
+		}
+
+		result.Execution, err = q.CreateScheduledTransferExecution(ctx, executionArg)
 
+		if err != nil {
+			return err
 		}
 
-		return nil
+		result.ScheduledTransfer, err = q.UpdateScheduledTransferRun(ctx, nextScheduledTransferRun(scheduled, transferErr == nil, now))
+
+		return err
 	})
 
+	// the scheduled transfer stays the one which is due the longest if its execution keeps failing, so it is pushed back
+	// in a separate transaction and the other scheduled transfers aren't blocked by it
+	if err != nil && scheduled.ID != 0 {
+		deferErr := store.DeferScheduledTransferRun(ctx, DeferScheduledTransferRunParams{
+			ID:    scheduled.ID,
+			RunAt: now.Add(scheduledTransferDeferral),
+		})
+
+		if deferErr != nil {
+			return result, fmt.Errorf("%w, cannot defer scheduled transfer [%d]: %v", err, scheduled.ID, deferErr)
+		}
+	}
+
 	return result, err
 }
 
-// * EntryTx performs a money entry for an account.
-// * It checks the funds of the account, updates the account and creates a new entry
-func (store *SQLStore) EntryTx(ctx context.Context, arg EntryTxParams) (EntryTxResult, error) {
-	var result EntryTxResult
+// * isScheduledTransferFailure returns true if the scheduled transfer can't be made because of its accounts,
+// * so the failure is recorded as an execution. Deadlocks, connection errors and the like aren't failures of the scheduled transfer
+func isScheduledTransferFailure(err error) bool {
+	for _, failure := range []error{ErrInsufficientFunds, ErrAccountFrozen, ErrAccountClosed, ErrSystemAccount} {
+		if errors.Is(err, failure) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// * nextScheduledTransferRun returns when the scheduled transfer runs next after an execution.
+// * A failed occurrence is retried after the retry interval until the retries are exhausted,
+// * then it is skipped like a succeeded one and the next occurrence is scheduled if there is any
+func nextScheduledTransferRun(scheduled ScheduledTransfer, succeeded bool, now time.Time) UpdateScheduledTransferRunParams {
+	arg := UpdateScheduledTransferRunParams{
+		ID:          scheduled.ID,
+		ScheduledAt: scheduled.ScheduledAt,
+		RunAt:       scheduled.RunAt,
+		Occurrences: scheduled.Occurrences,
+		Retries:     scheduled.Retries,
+		Status:      ScheduledTransferActive,
+	}
+
+	if !succeeded && scheduled.Retries < scheduled.MaxRetries {
+		arg.Retries++
+		arg.RunAt = now.Add(time.Duration(scheduled.RetryInterval) * time.Second)
+		return arg
+	}
+
+	arg.Occurrences++
+	arg.Retries = 0
+
+	next, ok := util.Occurrence(scheduled.Frequency, scheduled.StartAt, int(arg.Occurrences))
+
+	if !ok ||
+		(scheduled.MaxOccurrences != nil && arg.Occurrences >= *scheduled.MaxOccurrences) ||
+		(scheduled.EndAt != nil && next.After(*scheduled.EndAt)) {
+		arg.Status = ScheduledTransferCompleted
+
+		// a one-off transfer which never succeeded is failed
+		if !succeeded && scheduled.Frequency == util.Once {
+			arg.Status = ScheduledTransferFailed
+		}
+
+		return arg
+	}
+
+	arg.ScheduledAt = next
+	arg.RunAt = next
+
+	return arg
+}
+
+// * logAdminAction writes the admin audit log of an action which an admin does on a resource of another user
+// * through the shared routes with the given queries, nothing is written if adminUsername is empty
+func logAdminAction(ctx context.Context, q *Queries, adminUsername, action, targetType, targetID string) error {
+	if adminUsername == "" {
+		return nil
+	}
+
+	_, err := q.CreateAdminAuditLog(ctx, CreateAdminAuditLogParams{
+		AdminUsername: adminUsername,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+	})
+
+	return err
+}
+
+// * FreezeAccountTx freezes an active account and appends its audit event within a single database transaction
+func (store *SQLStore) FreezeAccountTx(ctx context.Context, arg FreezeAccountTxParams) (Account, error) {
+	var account Account
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
 
-		acc, err := q.GetAccount(ctx, arg.AccountID)
+		// only active accounts are frozen, sql.ErrNoRows is returned for the others
+		account, err = q.FreezeAccount(ctx, FreezeAccountParams{
+			ID:       arg.AccountID,
+			FrozenBy: &arg.FrozenBy,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		err = logAdminAction(ctx, q, arg.AdminUsername, AdminActionFreezeAccount, AuditTargetAccount, strconv.FormatInt(account.ID, 10))
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventFreezeAccount, AuditTargetAccount, strconv.FormatInt(account.ID, 10), account)
+	})
+
+	return account, err
+}
+
+// * UnfreezeAccountTx activates a frozen account again and appends its audit event within a single database transaction
+func (store *SQLStore) UnfreezeAccountTx(ctx context.Context, arg UnfreezeAccountTxParams) (Account, error) {
+	var account Account
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// only frozen accounts are activated, sql.ErrNoRows is returned for the others
+		account, err = q.UnfreezeAccount(ctx, arg.AccountID)
 
 		if err != nil {
 			return err
 		}
 
-		if arg.Amount < 0 && (-arg.Amount) > acc.Balance {
-			err = ErrInsufficientFunds
+		err = logAdminAction(ctx, q, arg.AdminUsername, AdminActionUnfreezeAccount, AuditTargetAccount, strconv.FormatInt(account.ID, 10))
+
+		if err != nil {
 			return err
 		}
 
-		arg1 := AddAccountBalanceParams{
-			Amount: arg.Amount,
-			ID:     arg.AccountID,
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventUnfreezeAccount, AuditTargetAccount, strconv.FormatInt(account.ID, 10), account)
+	})
+
+	return account, err
+}
+
+// * CloseAccountTx closes an active or frozen account. An account with money in it can only be closed
+// * by sweeping its balance to another account of the owner in the same transaction
+func (store *SQLStore) CloseAccountTx(ctx context.Context, arg CloseAccountTxParams) (CloseAccountTxResult, error) {
+	var result CloseAccountTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		result = CloseAccountTxResult{}
+
+		//! to avoid DB deadlock we lock the account with the smaller ID first like transfers do
+		if arg.SweepAccountID != 0 && arg.SweepAccountID < arg.AccountID {
+			if _, err := q.GetAccountForUpdate(ctx, arg.SweepAccountID); err != nil {
+				return err
+			}
 		}
 
-		result.Account, err = q.AddAccountBalance(ctx, arg1)
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
 
 		if err != nil {
 			return err
 		}
 
-		arg2 := CreateEntryParams{
-			AccountID: arg.AccountID,
-			Amount:    arg.Amount,
+		if account.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		if account.Status == AccountClosed {
+			return ErrAccountClosed
+		}
+
+		// held money is promised to other accounts, so the holds must be settled before the balance is swept
+		if account.HeldAmount > 0 {
+			return ErrAccountHasHolds
 		}
 
-		result.Entry, err = q.CreateEntry(ctx, arg2)
+		if account.Balance != 0 {
+			if arg.SweepAccountID == 0 || account.Balance < 0 {
+				return ErrAccountBalanceNotZero
+			}
+
+			sweepAccount, err := q.GetAccountForUpdate(ctx, arg.SweepAccountID)
+
+			if err != nil {
+				return err
+			}
+
+			if sweepAccount.ID == account.ID || sweepAccount.Owner != account.Owner {
+				return ErrInvalidSweepAccount
+			}
+
+			// frozen accounts can't send money, so they must be unfrozen before their balance is swept
+			var sweep TransferTxResult
+
+			switch {
+			case sweepAccount.Currency == account.Currency:
+				sweep, err = transfer(ctx, q, TransferTxParams{
+					FromAccountID: account.ID,
+					ToAccountID:   sweepAccount.ID,
+					Amount:        account.Balance,
+				})
+			case arg.QuoteID == nil:
+				return ErrSweepNeedsFxQuote
+			default:
+				sweep, err = fxTransfer(ctx, q, FxTransferTxParams{
+					FromAccountID: account.ID,
+					ToAccountID:   sweepAccount.ID,
+					Amount:        account.Balance,
+					QuoteID:       *arg.QuoteID,
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			result.Sweep = &sweep
+		}
+
+		result.Account, err = q.CloseAccount(ctx, account.ID)
 
 		if err != nil {
 			return err
 		}
 
-		return err
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventCloseAccount, AuditTargetAccount, strconv.FormatInt(account.ID, 10), result)
 	})
 
 	return result, err
 }
 
-// * addMoney adds an amount to two given accounts
-func addMoney(ctx context.Context, q *Queries, accountID1, amount1, accountID2, amount2 int64) (account1 Account, account2 Account, err error) {
-	account1, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-		ID:     accountID1,
-		Amount: amount1,
+// * RotateSessionTx marks the session as used and creates the next session of its family.
+// * A session which is already used means its refresh token is replayed, so every session of the family
+// * is blocked and committed before ErrRefreshTokenReused is returned
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error) {
+	var result RotateSessionTxResult
+	reused := false
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		reused = false
+
+		parent, err := q.GetSessionForUpdate(ctx, arg.SessionID)
+
+		if err != nil {
+			return err
+		}
+
+		if parent.UsedAt != nil {
+			reused = true
+			_, err := q.BlockSessionFamily(ctx, parent.FamilyID)
+			return err
+		}
+
+		if parent.IsBlocked {
+			return ErrSessionBlocked
+		}
+
+		result.ParentSession, err = q.MarkSessionUsed(ctx, parent.ID)
+
+		if err != nil {
+			return err
+		}
+
+		newSession := arg.NewSession
+		newSession.Username = parent.Username
+		newSession.FamilyID = parent.FamilyID
+		newSession.ParentID = &parent.ID
+
+		result.Session, err = q.CreateSession(ctx, newSession)
+
+		return err
 	})
 
-	if err != nil {
-		return
+	if err == nil && reused {
+		return result, ErrRefreshTokenReused
 	}
 
-	account2, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-		ID:     accountID2,
-		Amount: amount2,
+	return result, err
+}
+
+// * AdjustBalanceTx adds the amount to the balance of the account against the adjustment system account
+// * and writes the audit log of the admin in the same transaction. Frozen accounts can be adjusted but closed ones can't,
+// * and negative adjustments can't take the available balance below the overdraft limit like transfers
+func (store *SQLStore) AdjustBalanceTx(ctx context.Context, arg AdjustBalanceTxParams) (AdjustBalanceTxResult, error) {
+	var result AdjustBalanceTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		// the account is locked, so concurrent transfers and holds wait for the adjustment
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+
+		if err != nil {
+			return err
+		}
+
+		if account.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		if account.Status == AccountClosed {
+			return ErrAccountClosed
+		}
+
+		adjustment, err := systemAccount(ctx, q, SystemAdjustment, account.Currency)
+
+		if err != nil {
+			return err
+		}
+
+		posting, err := postJournal(ctx, q, JournalAdjustment, []ledgerLeg{
+			{AccountID: account.ID, Amount: arg.Amount},
+			{AccountID: adjustment.ID, Amount: -arg.Amount},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		result.Account = posting.Accounts[account.ID]
+		result.Entry = posting.Entries[0]
+
+		// held money isn't available, so negative adjustments are checked like the debits of transfers
+		if arg.Amount < 0 {
+			if err := checkFunds(result.Account); err != nil {
+				return err
+			}
+		}
+
+		result.AuditLog, err = q.CreateAdminAuditLog(ctx, CreateAdminAuditLogParams{
+			AdminUsername: arg.AdminUsername,
+			Action:        AdminActionAdjustBalance,
+			TargetType:    AuditTargetAccount,
+			TargetID:      strconv.FormatInt(arg.AccountID, 10),
+			Reason:        arg.Reason,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventAdjustBalance, AuditTargetAccount, strconv.FormatInt(arg.AccountID, 10), result)
 	})
 
-	return
+	return result, err
+}
+
+// * SetOverdraftLimitTx sets how far the balance of a customer account can go below zero and writes the audit log of the admin
+// * in the same transaction. A limit below the current debt doesn't change the balance, the account just can't send money until it is paid back
+func (store *SQLStore) SetOverdraftLimitTx(ctx context.Context, arg SetOverdraftLimitTxParams) (SetOverdraftLimitTxResult, error) {
+	var result SetOverdraftLimitTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+
+		if err != nil {
+			return err
+		}
+
+		if account.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		if account.Status == AccountClosed {
+			return ErrAccountClosed
+		}
+
+		result.Account, err = q.SetAccountOverdraftLimit(ctx, SetAccountOverdraftLimitParams{
+			ID:             arg.AccountID,
+			OverdraftLimit: arg.OverdraftLimit,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		result.AuditLog, err = q.CreateAdminAuditLog(ctx, CreateAdminAuditLogParams{
+			AdminUsername: arg.AdminUsername,
+			Action:        AdminActionSetOverdraftLimit,
+			TargetType:    AuditTargetAccount,
+			TargetID:      strconv.FormatInt(arg.AccountID, 10),
+			Reason:        arg.Reason,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventSetOverdraftLimit, AuditTargetAccount, strconv.FormatInt(arg.AccountID, 10), result)
+	})
+
+	return result, err
+}
+
+// * UnblockSessionTx unblocks a blocked session and writes the audit log of the admin in the same transaction,
+// * sql.ErrNoRows is returned if the session is not blocked
+func (store *SQLStore) UnblockSessionTx(ctx context.Context, arg UnblockSessionTxParams) (UnblockSessionTxResult, error) {
+	var result UnblockSessionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Session, err = q.UnblockSession(ctx, arg.SessionID)
+
+		if err != nil {
+			return err
+		}
+
+		result.AuditLog, err = q.CreateAdminAuditLog(ctx, CreateAdminAuditLogParams{
+			AdminUsername: arg.AdminUsername,
+			Action:        AdminActionUnblockSession,
+			TargetType:    AuditTargetSession,
+			TargetID:      arg.SessionID.String(),
+			Reason:        arg.Reason,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventUnblockSession, AuditTargetSession, arg.SessionID.String(), result)
+	})
+
+	return result, err
+}
+
+// * EntryTx performs a money entry for an account.
+// * It posts a journal transaction against the cash in or cash out system account of the currency, checks that a withdrawal
+// * doesn't take the account below its overdraft limit, then appends the audit event
+func (store *SQLStore) EntryTx(ctx context.Context, arg EntryTxParams) (EntryTxResult, error) {
+	var result EntryTxResult
+
+	err := store.execIdempotentTx(ctx, arg.Idempotency, "entry", arg, &result, func(q *Queries) error {
+		acc, err := q.GetAccount(ctx, arg.AccountID)
+
+		if err != nil {
+			return err
+		}
+
+		if acc.SystemPurpose != nil {
+			return ErrSystemAccount
+		}
+
+		// deposits come from the cash in account and withdrawals go to the cash out account of the currency
+		kind, purpose := JournalDeposit, SystemCashIn
+
+		if arg.Amount < 0 {
+			kind, purpose = JournalWithdrawal, SystemCashOut
+		}
+
+		system, err := systemAccount(ctx, q, purpose, acc.Currency)
+
+		if err != nil {
+			return err
+		}
+
+		posting, err := postJournal(ctx, q, kind, []ledgerLeg{
+			{AccountID: arg.AccountID, Amount: arg.Amount},
+			{AccountID: system.ID, Amount: -arg.Amount},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		result.Entry = posting.Entries[0]
+		result.Account = posting.Accounts[arg.AccountID]
+
+		if err := checkAccountStatus(result.Account, arg.Amount < 0); err != nil {
+			return err
+		}
+
+		// withdrawals are checked against the balance after the update which locks the account
+		if arg.Amount < 0 {
+			if err := checkFunds(result.Account); err != nil {
+				return err
+			}
+		}
+
+		return appendAuditEvent(ctx, q, arg.Actor, AuditEventEntry, AuditTargetAccount, strconv.FormatInt(arg.AccountID, 10), result)
+	})
+
+	return result, err
 }
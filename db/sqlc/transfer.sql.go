@@ -7,8 +7,134 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/lib/pq"
 )
 
+const addTransferRefund = `-- name: AddTransferRefund :one
+UPDATE transfers
+SET
+    refunded_amount = refunded_amount + $1,
+    status = CASE
+        WHEN refunded_amount + $1 >= amount THEN 'reversed'
+        ELSE 'partially_refunded'
+    END
+WHERE id = $2
+RETURNING id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+`
+
+type AddTransferRefundParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTransferRefund(ctx context.Context, arg AddTransferRefundParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, addTransferRefund, arg.Amount, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
+	)
+	return i, err
+}
+
+const createFxTransfer = `-- name: CreateFxTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    fx_rate,
+    converted_amount
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+`
+
+type CreateFxTransferParams struct {
+	FromAccountID   int64   `json:"from_account_id"`
+	ToAccountID     int64   `json:"to_account_id"`
+	Amount          int64   `json:"amount"`
+	FxRate          *string `json:"fx_rate"`
+	ConvertedAmount *int64  `json:"converted_amount"`
+}
+
+func (q *Queries) CreateFxTransfer(ctx context.Context, arg CreateFxTransferParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, createFxTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.FxRate,
+		arg.ConvertedAmount,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
+	)
+	return i, err
+}
+
+const createReversalTransfer = `-- name: CreateReversalTransfer :one
+INSERT INTO transfers(
+    from_account_id,
+    to_account_id,
+    amount,
+    reversal_of
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+`
+
+type CreateReversalTransferParams struct {
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	Amount        int64  `json:"amount"`
+	ReversalOf    *int64 `json:"reversal_of"`
+}
+
+func (q *Queries) CreateReversalTransfer(ctx context.Context, arg CreateReversalTransferParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, createReversalTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ReversalOf,
+	)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers(
     from_account_id,
@@ -16,7 +142,7 @@ INSERT INTO transfers(
     amount
 ) VALUES (
     $1, $2, $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+) RETURNING id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
 `
 
 type CreateTransferParams struct {
@@ -34,12 +160,18 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
 	)
 	return i, err
 }
 
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
 FROM transfers
 WHERE id = $1
 LIMIT 1
@@ -54,12 +186,129 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
 	)
 	return i, err
 }
 
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+FROM transfers
+WHERE id = $1
+LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
+	)
+	return i, err
+}
+
+const listTransferParties = `-- name: ListTransferParties :many
+SELECT accounts.id, accounts.owner, accounts.currency, users.full_name
+FROM accounts
+JOIN users ON users.username = accounts.owner
+WHERE accounts.id = ANY($1::bigint[])
+`
+
+type ListTransferPartiesRow struct {
+	ID       int64  `json:"id"`
+	Owner    string `json:"owner"`
+	Currency string `json:"currency"`
+	FullName string `json:"full_name"`
+}
+
+func (q *Queries) ListTransferParties(ctx context.Context, accountIds []int64) ([]ListTransferPartiesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferParties, pq.Array(accountIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTransferPartiesRow{}
+	for rows.Next() {
+		var i ListTransferPartiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Currency,
+			&i.FullName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransferReversals = `-- name: ListTransferReversals :many
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+FROM transfers
+WHERE reversal_of = $1
+ORDER BY id
+`
+
+func (q *Queries) ListTransferReversals(ctx context.Context, reversalOf *int64) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listTransferReversals, reversalOf)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.FxRate,
+			&i.ConvertedAmount,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.ReversalOf,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
 FROM transfers
 WHERE from_account_id = $1 OR to_account_id = $2
 ORDER BY id
@@ -94,6 +343,12 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.FxRate,
+			&i.ConvertedAmount,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.ReversalOf,
+			&i.JournalID,
 		); err != nil {
 			return nil, err
 		}
@@ -107,3 +362,192 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 	}
 	return items, nil
 }
+
+const listTransfersPageAsc = `-- name: ListTransfersPageAsc :many
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+    AND id > $2
+    AND created_at >= $3
+    AND created_at < $4
+    AND amount BETWEEN $5 AND $6
+    AND (
+        $7::varchar = ''
+        OR ($7 = 'outgoing' AND from_account_id = $1)
+        OR ($7 = 'incoming' AND to_account_id = $1)
+    )
+    AND (
+        $8::bigint = 0
+        OR (from_account_id = $1 AND to_account_id = $8)
+        OR (to_account_id = $1 AND from_account_id = $8)
+    )
+ORDER BY id
+LIMIT $9
+`
+
+type ListTransfersPageAscParams struct {
+	AccountID             int64     `json:"account_id"`
+	AfterID               int64     `json:"after_id"`
+	FromTime              time.Time `json:"from_time"`
+	ToTime                time.Time `json:"to_time"`
+	MinAmount             int64     `json:"min_amount"`
+	MaxAmount             int64     `json:"max_amount"`
+	Direction             string    `json:"direction"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id"`
+	LimitCount            int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListTransfersPageAsc(ctx context.Context, arg ListTransfersPageAscParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listTransfersPageAsc,
+		arg.AccountID,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.FxRate,
+			&i.ConvertedAmount,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.ReversalOf,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTransfersPageDesc = `-- name: ListTransfersPageDesc :many
+SELECT id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+    AND id < $2
+    AND created_at >= $3
+    AND created_at < $4
+    AND amount BETWEEN $5 AND $6
+    AND (
+        $7::varchar = ''
+        OR ($7 = 'outgoing' AND from_account_id = $1)
+        OR ($7 = 'incoming' AND to_account_id = $1)
+    )
+    AND (
+        $8::bigint = 0
+        OR (from_account_id = $1 AND to_account_id = $8)
+        OR (to_account_id = $1 AND from_account_id = $8)
+    )
+ORDER BY id DESC
+LIMIT $9
+`
+
+type ListTransfersPageDescParams struct {
+	AccountID             int64     `json:"account_id"`
+	AfterID               int64     `json:"after_id"`
+	FromTime              time.Time `json:"from_time"`
+	ToTime                time.Time `json:"to_time"`
+	MinAmount             int64     `json:"min_amount"`
+	MaxAmount             int64     `json:"max_amount"`
+	Direction             string    `json:"direction"`
+	CounterpartyAccountID int64     `json:"counterparty_account_id"`
+	LimitCount            int32     `json:"limit_count"`
+}
+
+func (q *Queries) ListTransfersPageDesc(ctx context.Context, arg ListTransfersPageDescParams) ([]Transfer, error) {
+	rows, err := q.db.QueryContext(ctx, listTransfersPageDesc,
+		arg.AccountID,
+		arg.AfterID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Direction,
+		arg.CounterpartyAccountID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.FxRate,
+			&i.ConvertedAmount,
+			&i.Status,
+			&i.RefundedAmount,
+			&i.ReversalOf,
+			&i.JournalID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setTransferJournal = `-- name: SetTransferJournal :one
+UPDATE transfers
+SET journal_id = $2
+WHERE id = $1
+RETURNING id, from_account_id, to_account_id, amount, created_at, fx_rate, converted_amount, status, refunded_amount, reversal_of, journal_id
+`
+
+type SetTransferJournalParams struct {
+	ID        int64  `json:"id"`
+	JournalID *int64 `json:"journal_id"`
+}
+
+func (q *Queries) SetTransferJournal(ctx context.Context, arg SetTransferJournalParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, setTransferJournal, arg.ID, arg.JournalID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.FxRate,
+		&i.ConvertedAmount,
+		&i.Status,
+		&i.RefundedAmount,
+		&i.ReversalOf,
+		&i.JournalID,
+	)
+	return i, err
+}
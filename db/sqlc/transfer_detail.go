@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"fmt"
+)
+
+// * TransferParty is the account on a side of a transfer with its owner
+type TransferParty struct {
+	AccountID int64  `json:"account_id"`
+	Owner     string `json:"owner"`
+	FullName  string `json:"full_name"`
+	Currency  string `json:"currency"`
+}
+
+// * TransferDetail is a transfer from the view of one of its sides, Counterparty is the other side
+type TransferDetail struct {
+	Transfer     Transfer      `json:"transfer"`
+	Direction    string        `json:"direction"`
+	Counterparty TransferParty `json:"counterparty"`
+}
+
+// * TransferDetailsParams holds the transfers and who sees them. Transfers are seen from the account if AccountID is set,
+// * otherwise from the accounts of the user. Transfers which the user is on neither side of are seen from the sender
+type TransferDetailsParams struct {
+	Transfers []Transfer
+	AccountID int64
+	Username  string
+}
+
+// * TransferDetails returns the transfers with their directions and counterparties in the same order
+func (store *SQLStore) TransferDetails(ctx context.Context, arg TransferDetailsParams) ([]TransferDetail, error) {
+	details := make([]TransferDetail, len(arg.Transfers))
+
+	if len(arg.Transfers) == 0 {
+		return details, nil
+	}
+
+	accountIDs := make([]int64, 0, 2*len(arg.Transfers))
+
+	for _, transfer := range arg.Transfers {
+		accountIDs = append(accountIDs, transfer.FromAccountID, transfer.ToAccountID)
+	}
+
+	rows, err := store.ListTransferParties(ctx, accountIDs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	parties := make(map[int64]TransferParty, len(rows))
+
+	for _, row := range rows {
+		parties[row.ID] = TransferParty{
+			AccountID: row.ID,
+			Owner:     row.Owner,
+			FullName:  row.FullName,
+			Currency:  row.Currency,
+		}
+	}
+
+	for i, transfer := range arg.Transfers {
+		from, ok := parties[transfer.FromAccountID]
+
+		if !ok {
+			return nil, fmt.Errorf("no owner of account [%d]", transfer.FromAccountID)
+		}
+
+		to, ok := parties[transfer.ToAccountID]
+
+		if !ok {
+			return nil, fmt.Errorf("no owner of account [%d]", transfer.ToAccountID)
+		}
+
+		details[i].Transfer = transfer
+
+		switch {
+		case arg.AccountID == transfer.FromAccountID:
+			details[i].Direction, details[i].Counterparty = DirectionOutgoing, to
+		case arg.AccountID == transfer.ToAccountID:
+			details[i].Direction, details[i].Counterparty = DirectionIncoming, from
+		case arg.AccountID == 0 && from.Owner == arg.Username && to.Owner == arg.Username:
+			details[i].Direction, details[i].Counterparty = DirectionInternal, to
+		case arg.AccountID == 0 && to.Owner == arg.Username:
+			details[i].Direction, details[i].Counterparty = DirectionIncoming, from
+		default:
+			details[i].Direction, details[i].Counterparty = DirectionOutgoing, to
+		}
+	}
+
+	return details, nil
+}
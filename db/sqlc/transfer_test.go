@@ -86,3 +86,50 @@ func TestListTransfers(t *testing.T) {
 		require.Equal(t, transfer.ToAccountID, acc2.ID)
 	}
 }
+
+// TestTransferDetails tests that transfers get their directions and counterparties from the view of an account or a user
+func TestTransferDetails(t *testing.T) {
+	store := NewStore(testDB)
+
+	sender := createFundedAccount(t)
+	recipient := createFundedAccount(t)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: sender.ID,
+		ToAccountID:   recipient.ID,
+		Amount:        10,
+	})
+	require.NoError(t, err)
+
+	recipientOwner, err := testQueries.GetUser(context.Background(), recipient.Owner)
+	require.NoError(t, err)
+
+	transfers := []Transfer{result.Transfer}
+
+	details, err := store.TransferDetails(context.Background(), TransferDetailsParams{Transfers: transfers, AccountID: sender.ID})
+	require.NoError(t, err)
+	require.Len(t, details, 1)
+	require.Equal(t, result.Transfer, details[0].Transfer)
+	require.Equal(t, DirectionOutgoing, details[0].Direction)
+	require.Equal(t, TransferParty{
+		AccountID: recipient.ID,
+		Owner:     recipient.Owner,
+		FullName:  recipientOwner.FullName,
+		Currency:  recipient.Currency,
+	}, details[0].Counterparty)
+
+	details, err = store.TransferDetails(context.Background(), TransferDetailsParams{Transfers: transfers, Username: recipient.Owner})
+	require.NoError(t, err)
+	require.Equal(t, DirectionIncoming, details[0].Direction)
+	require.Equal(t, sender.ID, details[0].Counterparty.AccountID)
+
+	//! a user who is on neither side sees the transfer from the sender
+	details, err = store.TransferDetails(context.Background(), TransferDetailsParams{Transfers: transfers, Username: util.RandomOwner()})
+	require.NoError(t, err)
+	require.Equal(t, DirectionOutgoing, details[0].Direction)
+	require.Equal(t, recipient.ID, details[0].Counterparty.AccountID)
+
+	details, err = store.TransferDetails(context.Background(), TransferDetailsParams{})
+	require.NoError(t, err)
+	require.Empty(t, details)
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"math/rand"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// txRetryMaxDelay is the longest wait before a retry, however many times the transaction is retried
+const txRetryMaxDelay = time.Second
+
+// DefaultTxRetryPolicy is used by the stores which are created with NewStore
+var DefaultTxRetryPolicy = TxRetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  20 * time.Millisecond,
+}
+
+// txMetrics counts the retries of the transactions, they are published with expvar as db_tx.
+// retries is every retry, retried_txs the transactions which committed after a retry and
+// exhausted_txs the ones which failed after their last retry
+var txMetrics = expvar.NewMap("db_tx")
+
+// * TxRetryPolicy is how many times a transaction which failed because of a deadlock or a serialization failure is retried,
+// * and how long the first retry waits. Each retry waits twice as long as the previous one with jitter, zero MaxRetries disables retries
+// * and zero BaseDelay retries at once
+type TxRetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+}
+
+// * isRetryableTxError returns true if the transaction failed only because of the concurrent transactions,
+// * so running it again can succeed
+func isRetryableTxError(err error) bool {
+	var pqErr *pq.Error
+
+	if !errors.As(err, &pqErr) {
+		return false
+	}
+
+	switch pqErr.Code.Name() {
+	case "serialization_failure", "deadlock_detected":
+		return true
+	}
+
+	return false
+}
+
+// * txRetryDelay returns how long to wait before the retry, between the half and the whole of the exponential backoff,
+// * so the transactions which failed together don't run again at the same time
+func txRetryDelay(policy TxRetryPolicy, retry int) time.Duration {
+	if policy.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := policy.BaseDelay << retry
+
+	// the shift overflows after enough retries
+	if delay <= 0 || delay > txRetryMaxDelay {
+		delay = txRetryMaxDelay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// * retryTx runs the transaction and retries it while it fails with a retryable error and the budget of the policy lasts.
+// * It stops waiting when ctx is done and returns the last error of the transaction
+func retryTx(ctx context.Context, policy TxRetryPolicy, run func() error) error {
+	err := run()
+
+	for retry := 0; err != nil && isRetryableTxError(err); retry++ {
+		if retry >= policy.MaxRetries {
+			txMetrics.Add("exhausted_txs", 1)
+			return err
+		}
+
+		select {
+		case <-time.After(txRetryDelay(policy, retry)):
+		case <-ctx.Done():
+			return err
+		}
+
+		txMetrics.Add("retries", 1)
+		err = run()
+
+		if err == nil {
+			txMetrics.Add("retried_txs", 1)
+		}
+	}
+
+	return err
+}
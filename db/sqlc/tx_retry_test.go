@@ -0,0 +1,164 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"expvar"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIsRetryableTxError tests that only deadlocks and serialization failures are retried
+func TestIsRetryableTxError(t *testing.T) {
+	require.True(t, isRetryableTxError(&pq.Error{Code: "40001"}))
+	require.True(t, isRetryableTxError(&pq.Error{Code: "40P01"}))
+
+	//! errors which are wrapped by the rollback are still retried
+	require.True(t, isRetryableTxError(fmt.Errorf("tx err: %w, rb err: %v", &pq.Error{Code: "40P01"}, sql.ErrTxDone)))
+
+	require.False(t, isRetryableTxError(&pq.Error{Code: "23505"}))
+	require.False(t, isRetryableTxError(ErrInsufficientFunds))
+	require.False(t, isRetryableTxError(sql.ErrNoRows))
+}
+
+// TestTxRetryDelay tests that the delays grow with jitter and never exceed the max delay
+func TestTxRetryDelay(t *testing.T) {
+	policy := TxRetryPolicy{MaxRetries: 10, BaseDelay: 20 * time.Millisecond}
+
+	for retry := 0; retry < 3; retry++ {
+		delay := txRetryDelay(policy, retry)
+		backoff := policy.BaseDelay << retry
+
+		require.GreaterOrEqual(t, delay, backoff/2)
+		require.LessOrEqual(t, delay, backoff)
+	}
+
+	require.LessOrEqual(t, txRetryDelay(policy, 40), txRetryMaxDelay)
+	require.LessOrEqual(t, txRetryDelay(policy, 80), txRetryMaxDelay)
+
+	//! zero base delay means no backoff
+	policy.BaseDelay = 0
+
+	for retry := 0; retry < 3; retry++ {
+		require.Zero(t, txRetryDelay(policy, retry))
+	}
+}
+
+// TestRetryTx tests that retryable errors are retried until the budget of the policy is used
+func TestRetryTx(t *testing.T) {
+	policy := TxRetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+	deadlock := &pq.Error{Code: "40P01"}
+
+	retries := txMetricValue("retries")
+	runs := 0
+
+	err := retryTx(context.Background(), policy, func() error {
+		runs++
+		if runs < 3 {
+			return deadlock
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+	require.Equal(t, retries+2, txMetricValue("retries"))
+
+	//! the budget is used up
+	runs = 0
+	err = retryTx(context.Background(), policy, func() error {
+		runs++
+		return deadlock
+	})
+	require.ErrorIs(t, err, deadlock)
+	require.Equal(t, 3, runs)
+
+	//! other errors are returned at once
+	runs = 0
+	err = retryTx(context.Background(), policy, func() error {
+		runs++
+		return ErrInsufficientFunds
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+	require.Equal(t, 1, runs)
+
+	//! retries stop when the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	runs = 0
+	err = retryTx(ctx, TxRetryPolicy{MaxRetries: 5, BaseDelay: time.Second}, func() error {
+		runs++
+		return deadlock
+	})
+	require.ErrorIs(t, err, deadlock)
+	require.Equal(t, 1, runs)
+}
+
+// TestExecTxRetriesSerializationFailures tests that serializable transactions which conflict are retried until both commit
+func TestExecTxRetriesSerializationFailures(t *testing.T) {
+	store := NewStoreWithTxRetry(testDB, TxRetryPolicy{MaxRetries: 5, BaseDelay: time.Millisecond}).(*SQLStore)
+
+	acc1 := createAccountWithBalance(t, 100)
+	acc2 := createAccountWithBalance(t, 100)
+
+	//! both transactions read the two balances before either of them writes, so one of them must fail on the first run
+	var firstRuns sync.WaitGroup
+	firstRuns.Add(2)
+
+	var runs int32
+	errs := make(chan error, 2)
+
+	for _, id := range []int64{acc1.ID, acc2.ID} {
+		go func(id int64) {
+			first := true
+
+			errs <- store.execTxWithIsolation(context.Background(), sql.LevelSerializable, func(q *Queries) error {
+				atomic.AddInt32(&runs, 1)
+
+				total := int64(0)
+
+				for _, accountID := range []int64{acc1.ID, acc2.ID} {
+					account, err := q.GetAccount(context.Background(), accountID)
+
+					if err != nil {
+						return err
+					}
+
+					total += account.Balance
+				}
+
+				if first {
+					first = false
+					firstRuns.Done()
+					firstRuns.Wait()
+				}
+
+				_, err := q.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: id, Amount: total / 10})
+				return err
+			})
+		}(id)
+	}
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	require.Greater(t, atomic.LoadInt32(&runs), int32(2))
+}
+
+// txMetricValue returns the value of the transaction metric with the key
+func txMetricValue(key string) int64 {
+	value, ok := txMetrics.Get(key).(*expvar.Int)
+
+	if !ok {
+		return 0
+	}
+
+	return value.Value()
+}
@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, arg.HashedPassword, user.HashedPassword)
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
+	require.Equal(t, util.DepositorRole, user.Role)
 
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
@@ -56,3 +58,38 @@ func TestGetUser(t *testing.T) {
 	require.WithinDuration(t, user1.CreatedAt, user2.CreatedAt, time.Second)
 	require.WithinDuration(t, user1.PasswordChangedAt, user2.PasswordChangedAt, time.Second)
 }
+
+// TestUpdateUserRole tests our UpdateUserRole DB func
+func TestUpdateUserRole(t *testing.T) {
+	user1 := createRandomUser(t)
+
+	user2, err := testQueries.UpdateUserRole(context.Background(), UpdateUserRoleParams{
+		Username: user1.Username,
+		Role:     util.AdminRole,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, user1.Username, user2.Username)
+	require.Equal(t, util.AdminRole, user2.Role)
+}
+
+// TestSearchUsers tests our SearchUsers DB func, email is matched partially and case insensitively
+func TestSearchUsers(t *testing.T) {
+	user1 := createRandomUser(t)
+
+	users, err := testQueries.SearchUsers(context.Background(), SearchUsersParams{
+		Email:  strings.ToUpper(user1.Email[:len(user1.Email)-4]),
+		Limit:  5,
+		Offset: 0,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, users)
+
+	found := false
+	for _, user := range users {
+		if user.Username == user1.Username {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
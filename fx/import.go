@@ -0,0 +1,117 @@
+package fx
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// ImportRatesFile reads the rates from a CSV file and upserts them into the fx_rates table.
+// Each line holds from_currency,to_currency,rate,effective_at where effective_at is in RFC3339 format
+// and the first line is the header
+func ImportRatesFile(ctx context.Context, querier db.Querier, path string) (int, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return 0, fmt.Errorf("cannot open rates file: %w", err)
+	}
+	defer file.Close()
+
+	return ImportRates(ctx, querier, file)
+}
+
+// ImportRates reads the rates in CSV format and upserts them into the fx_rates table. Every rate is validated
+// before any of them is upserted, so a malformed file doesn't leave half of its rates behind
+func ImportRates(ctx context.Context, querier db.Querier, r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	// we skip the header
+	if _, err := reader.Read(); err != nil {
+		return 0, fmt.Errorf("cannot read rates header: %w", err)
+	}
+
+	var rates []db.UpsertFxRateParams
+	seen := make(map[string]bool)
+
+	for {
+		record, err := reader.Read()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return 0, fmt.Errorf("cannot read rate: %w", err)
+		}
+
+		arg, err := parseRateRecord(record)
+
+		if err != nil {
+			return 0, err
+		}
+
+		// the same pair can't have two rates at the same time, otherwise the rate which wins depends on the order of the lines
+		key := fmt.Sprintf("%s/%s@%s", arg.FromCurrency, arg.ToCurrency, arg.EffectiveAt.UTC().Format(time.RFC3339))
+
+		if seen[key] {
+			return 0, fmt.Errorf("duplicate rate for %s/%s at %s", arg.FromCurrency, arg.ToCurrency, record[3])
+		}
+
+		seen[key] = true
+		rates = append(rates, arg)
+	}
+
+	count := 0
+
+	for _, arg := range rates {
+		if err := querier.UpsertFxRate(ctx, arg); err != nil {
+			return count, fmt.Errorf("cannot upsert rate: %w", err)
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// parseRateRecord validates a CSV record and converts it to db.UpsertFxRateParams
+func parseRateRecord(record []string) (db.UpsertFxRateParams, error) {
+	var arg db.UpsertFxRateParams
+
+	fromCurrency := strings.ToUpper(record[0])
+	toCurrency := strings.ToUpper(record[1])
+
+	if !util.IsSupportedCurrency(fromCurrency) || !util.IsSupportedCurrency(toCurrency) || fromCurrency == toCurrency {
+		return arg, fmt.Errorf("unsupported currency pair: %s/%s", record[0], record[1])
+	}
+
+	// rates are stored as numerics, so fractions like 1/3 which big.Rat accepts are rejected
+	if rate, ok := new(big.Rat).SetString(record[2]); !ok || rate.Sign() <= 0 || strings.Contains(record[2], "/") {
+		return arg, fmt.Errorf("invalid rate for %s/%s: %s", fromCurrency, toCurrency, record[2])
+	}
+
+	effectiveAt, err := time.Parse(time.RFC3339, record[3])
+
+	if err != nil {
+		return arg, fmt.Errorf("invalid effective time for %s/%s: %w", fromCurrency, toCurrency, err)
+	}
+
+	arg = db.UpsertFxRateParams{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		Rate:         record[2],
+		EffectiveAt:  effectiveAt,
+	}
+
+	return arg, nil
+}
@@ -0,0 +1,204 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// ratesHeader is the header line of the rates files
+const ratesHeader = "from_currency,to_currency,rate,effective_at\n"
+
+// TestImportRates tests ImportRates with multiple cases
+func TestImportRates(t *testing.T) {
+	effectiveAt := time.Date(2022, time.August, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		csv           string
+		buildStubs    func(querier *mockdb.MockStore)
+		expectedCount int
+		expectedErr   string
+	}{
+		{
+			name: "OK",
+			csv:  ratesHeader + "usd,eur,0.92,2022-08-01T00:00:00Z\nEUR, USD, 1.087, 2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				gomock.InOrder(
+					querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Eq(db.UpsertFxRateParams{
+						FromCurrency: "USD",
+						ToCurrency:   "EUR",
+						Rate:         "0.92",
+						EffectiveAt:  effectiveAt,
+					})).Times(1).Return(nil),
+					querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Eq(db.UpsertFxRateParams{
+						FromCurrency: "EUR",
+						ToCurrency:   "USD",
+						Rate:         "1.087",
+						EffectiveAt:  effectiveAt,
+					})).Times(1).Return(nil),
+				)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "Same pair at different times",
+			csv:  ratesHeader + "USD,EUR,0.92,2022-08-01T00:00:00Z\nUSD,EUR,0.93,2022-08-02T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "Header only",
+			csv:  ratesHeader,
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "Empty file",
+			csv:  "",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "cannot read rates header",
+		},
+		{
+			name: "Missing field",
+			csv:  ratesHeader + "USD,EUR,0.92\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "cannot read rate",
+		},
+		{
+			name: "Extra field",
+			csv:  ratesHeader + "USD,EUR,0.92,2022-08-01T00:00:00Z,1\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "cannot read rate",
+		},
+		{
+			name: "Unsupported currency",
+			csv:  ratesHeader + "USD,GBP,0.82,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "unsupported currency pair",
+		},
+		{
+			name: "Same currencies",
+			csv:  ratesHeader + "USD,USD,2,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "unsupported currency pair",
+		},
+		{
+			name: "Zero rate",
+			csv:  ratesHeader + "USD,EUR,0,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "invalid rate",
+		},
+		{
+			name: "Negative rate",
+			csv:  ratesHeader + "USD,EUR,-0.92,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "invalid rate",
+		},
+		{
+			name: "Non numeric rate",
+			csv:  ratesHeader + "USD,EUR,abc,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "invalid rate",
+		},
+		{
+			name: "Fraction rate",
+			csv:  ratesHeader + "USD,EUR,23/25,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "invalid rate",
+		},
+		{
+			name: "Invalid effective time",
+			csv:  ratesHeader + "USD,EUR,0.92,2022-08-01\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "invalid effective time",
+		},
+		{
+			//! nothing is upserted, even the rates before the duplicate
+			name: "Duplicate pair",
+			csv:  ratesHeader + "USD,EUR,0.92,2022-08-01T00:00:00Z\nEUR,USD,1.087,2022-08-01T00:00:00Z\nusd,eur,0.95,2022-08-01T03:00:00+03:00\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedErr: "duplicate rate for USD/EUR",
+		},
+		{
+			name: "DB error",
+			csv:  ratesHeader + "USD,EUR,0.92,2022-08-01T00:00:00Z\nEUR,USD,1.087,2022-08-01T00:00:00Z\n",
+			buildStubs: func(querier *mockdb.MockStore) {
+				gomock.InOrder(
+					querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(1).Return(nil),
+					querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("connection refused")),
+				)
+			},
+			expectedCount: 1,
+			expectedErr:   "cannot upsert rate",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			querier := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(querier)
+
+			count, err := ImportRates(context.Background(), querier, strings.NewReader(tc.csv))
+			require.Equal(t, tc.expectedCount, count)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestImportRatesFile tests that the rates file which is shipped with the app is valid
+func TestImportRatesFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	querier := mockdb.NewMockStore(ctrl)
+	querier.EXPECT().UpsertFxRate(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+	count, err := ImportRatesFile(context.Background(), querier, "rates.csv")
+	require.NoError(t, err)
+	require.Positive(t, count)
+
+	_, err = ImportRatesFile(context.Background(), querier, "missing.csv")
+	require.ErrorContains(t, err, "cannot open rates file")
+}
@@ -0,0 +1,69 @@
+package fx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+var ErrRateNotFound = errors.New("fx rate not found")
+
+// Rate holds a conversion rate from one currency to another
+type Rate struct {
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	Value        string    `json:"rate"`
+	EffectiveAt  time.Time `json:"effective_at"`
+}
+
+// RateProvider is an interface for getting fx rates so we can change between local and remote rate sources
+type RateProvider interface {
+	// GetRate returns the rate which is effective at the given time for converting fromCurrency to toCurrency
+	GetRate(ctx context.Context, fromCurrency, toCurrency string, at time.Time) (Rate, error)
+}
+
+// LocalRateProvider is a RateProvider which reads the rates from our own fx_rates table
+type LocalRateProvider struct {
+	querier db.Querier
+}
+
+// NewLocalRateProvider creates a new LocalRateProvider
+func NewLocalRateProvider(querier db.Querier) RateProvider {
+	return &LocalRateProvider{querier: querier}
+}
+
+// GetRate returns the latest rate which is effective at the given time
+func (provider *LocalRateProvider) GetRate(ctx context.Context, fromCurrency, toCurrency string, at time.Time) (Rate, error) {
+	// same currencies don't need a rate
+	if fromCurrency == toCurrency {
+		return Rate{
+			FromCurrency: fromCurrency,
+			ToCurrency:   toCurrency,
+			Value:        "1",
+			EffectiveAt:  at,
+		}, nil
+	}
+
+	rate, err := provider.querier.GetFxRate(ctx, db.GetFxRateParams{
+		FromCurrency: fromCurrency,
+		ToCurrency:   toCurrency,
+		At:           at,
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Rate{}, ErrRateNotFound
+		}
+		return Rate{}, err
+	}
+
+	return Rate{
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Value:        rate.Rate,
+		EffectiveAt:  rate.EffectiveAt,
+	}, nil
+}
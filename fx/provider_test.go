@@ -0,0 +1,107 @@
+package fx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalRateProviderGetRate tests GetRate of LocalRateProvider with multiple cases
+func TestLocalRateProviderGetRate(t *testing.T) {
+	at := time.Now()
+	effectiveAt := at.Add(-time.Hour)
+
+	testCases := []struct {
+		name          string
+		fromCurrency  string
+		toCurrency    string
+		buildStubs    func(querier *mockdb.MockStore)
+		checkResponse func(t *testing.T, rate Rate, err error)
+	}{
+		{
+			name:         "OK",
+			fromCurrency: "USD",
+			toCurrency:   "EUR",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().GetFxRate(gomock.Any(), gomock.Eq(db.GetFxRateParams{
+					FromCurrency: "USD",
+					ToCurrency:   "EUR",
+					At:           at,
+				})).Times(1).Return(db.FxRate{
+					FromCurrency: "USD",
+					ToCurrency:   "EUR",
+					Rate:         "0.92",
+					EffectiveAt:  effectiveAt,
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, rate Rate, err error) {
+				require.NoError(t, err)
+				require.Equal(t, Rate{
+					FromCurrency: "USD",
+					ToCurrency:   "EUR",
+					Value:        "0.92",
+					EffectiveAt:  effectiveAt,
+				}, rate)
+			},
+		},
+		{
+			name:         "Same currency",
+			fromCurrency: "USD",
+			toCurrency:   "USD",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, rate Rate, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1", rate.Value)
+				require.Equal(t, at, rate.EffectiveAt)
+			},
+		},
+		{
+			name:         "Not found",
+			fromCurrency: "USD",
+			toCurrency:   "CAD",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(1).Return(db.FxRate{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, rate Rate, err error) {
+				require.ErrorIs(t, err, ErrRateNotFound)
+				require.Equal(t, Rate{}, rate)
+			},
+		},
+		{
+			name:         "DB error",
+			fromCurrency: "USD",
+			toCurrency:   "CAD",
+			buildStubs: func(querier *mockdb.MockStore) {
+				querier.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(1).Return(db.FxRate{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, rate Rate, err error) {
+				require.ErrorIs(t, err, sql.ErrConnDone)
+				require.False(t, errors.Is(err, ErrRateNotFound))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			querier := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(querier)
+
+			provider := NewLocalRateProvider(querier)
+
+			rate, err := provider.GetRate(context.Background(), tc.fromCurrency, tc.toCurrency, at)
+			tc.checkResponse(t, rate, err)
+		})
+	}
+}
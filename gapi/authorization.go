@@ -0,0 +1,336 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader      = "authorization"
+	grpcGatewayAuthorization = "grpcgateway-authorization"
+	authorizationBearer      = "bearer"
+)
+
+// authPayloadKey is the context key of the access token payload
+type authPayloadKey struct{}
+
+// authorizeUser checks the authorization metadata of the request and returns the payload of the access token
+func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return nil, fmt.Errorf("missing metadata")
+	}
+
+	values := md.Get(authorizationHeader)
+
+	// gateway forwards the authorization header with its own prefix as well
+	if len(values) == 0 {
+		values = md.Get(grpcGatewayAuthorization)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing authorization header")
+	}
+
+	// if len of the header is less than 2 then header is not a valid format
+	fields := strings.Fields(values[0])
+
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid authorization header format")
+	}
+
+	authType := strings.ToLower(fields[0])
+
+	if authType != authorizationBearer {
+		return nil, fmt.Errorf("unsupported authorization type: %s", authType)
+	}
+
+	payload, err := server.tokenMaker.VerifyToken(fields[1])
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %s", err)
+	}
+
+	return payload, nil
+}
+
+// contextWithAuthPayload puts the access token payload into the context
+func contextWithAuthPayload(ctx context.Context, payload *token.Payload) context.Context {
+	return context.WithValue(ctx, authPayloadKey{}, payload)
+}
+
+// authPayloadFromContext returns the access token payload which is put into the context by the auth interceptor
+func authPayloadFromContext(ctx context.Context) (*token.Payload, error) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+
+	if !ok || payload == nil {
+		return nil, unauthenticatedError(fmt.Errorf("missing access token payload"))
+	}
+
+	return payload, nil
+}
+
+// getAccount gets the account from DB and converts the DB errors to gRPC errors
+func (server *Server) getAccount(ctx context.Context, accountID int64) (db.Account, error) {
+	account, err := server.store.GetAccount(ctx, accountID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return account, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		return account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	return account, nil
+}
+
+// adminActor returns the principal if it acts on a resource of owner through its role, those actions
+// are written to the admin audit logs. It returns an empty username when the principal acts on its own resource
+func adminActor(principal *token.Payload, owner string) string {
+	if principal.Username == owner {
+		return ""
+	}
+
+	return principal.Username
+}
+
+// authorizeAccount gets the account from DB and checks if the principal may do the action on it
+func (server *Server) authorizeAccount(ctx context.Context, accountID int64, principal *token.Payload, action policy.Action) (db.Account, error) {
+	account, err := server.getAccount(ctx, accountID)
+
+	if err != nil {
+		return account, err
+	}
+
+	if !policy.Can(principal, action, account.Owner) {
+		return account, status.Errorf(codes.PermissionDenied, "%s", ErrAccountIsNotAuthenticatedUsers)
+	}
+
+	return account, nil
+}
+
+// authorizeTransfer gets the transfer from DB and checks if the principal may read the account on either side of it
+func (server *Server) authorizeTransfer(ctx context.Context, id int64, principal *token.Payload) (db.Transfer, error) {
+	transfer, err := server.store.GetTransfer(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return transfer, status.Errorf(codes.NotFound, "transfer not found: %s", err)
+		}
+		return transfer, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	for _, accountID := range []int64{transfer.FromAccountID, transfer.ToAccountID} {
+		account, err := server.getAccount(ctx, accountID)
+
+		if err != nil {
+			return transfer, err
+		}
+
+		if policy.Can(principal, policy.ReadAccount, account.Owner) {
+			return transfer, nil
+		}
+	}
+
+	return transfer, status.Errorf(codes.PermissionDenied, "%s", ErrTransferIsNotAuthenticatedUsers)
+}
+
+// authorizeHold gets the hold from DB and checks if the principal may read the account on either side of it
+func (server *Server) authorizeHold(ctx context.Context, id int64, principal *token.Payload) (db.Hold, error) {
+	hold, err := server.getHold(ctx, id)
+
+	if err != nil {
+		return hold, err
+	}
+
+	for _, accountID := range []int64{hold.FromAccountID, hold.ToAccountID} {
+		account, err := server.getAccount(ctx, accountID)
+
+		if err != nil {
+			return hold, err
+		}
+
+		if policy.Can(principal, policy.ReadAccount, account.Owner) {
+			return hold, nil
+		}
+	}
+
+	return hold, status.Errorf(codes.PermissionDenied, "%s", ErrHoldIsNotAuthenticatedUsers)
+}
+
+// authorizePayeeHold gets the hold from DB and checks if the principal may do the action on the account which the hold is for
+func (server *Server) authorizePayeeHold(ctx context.Context, id int64, principal *token.Payload, action policy.Action) (db.Hold, error) {
+	hold, err := server.getHold(ctx, id)
+
+	if err != nil {
+		return hold, err
+	}
+
+	_, err = server.authorizeAccount(ctx, hold.ToAccountID, principal, action)
+
+	return hold, err
+}
+
+// getHold gets the hold from DB and converts the DB errors to gRPC errors
+func (server *Server) getHold(ctx context.Context, id int64) (db.Hold, error) {
+	hold, err := server.store.GetHold(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return hold, status.Errorf(codes.NotFound, "hold not found: %s", err)
+		}
+		return hold, status.Errorf(codes.Internal, "failed to get hold: %s", err)
+	}
+
+	return hold, nil
+}
+
+// authorizeScheduledTransfer gets the scheduled transfer from DB and checks if the principal may manage it
+func (server *Server) authorizeScheduledTransfer(ctx context.Context, id int64, principal *token.Payload) (db.ScheduledTransfer, error) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return scheduled, status.Errorf(codes.NotFound, "scheduled transfer not found: %s", err)
+		}
+		return scheduled, status.Errorf(codes.Internal, "failed to get scheduled transfer: %s", err)
+	}
+
+	if !policy.Can(principal, policy.ManageScheduledTransfer, scheduled.Owner) {
+		return scheduled, status.Errorf(codes.PermissionDenied, "%s", ErrScheduledTransferIsNotAuthenticatedUsers)
+	}
+
+	return scheduled, nil
+}
+
+// authorizeCurrentSession gets the session of the refresh token and checks if it is an unblocked session of the principal
+func (server *Server) authorizeCurrentSession(ctx context.Context, refreshToken string, principal *token.Payload) (db.Session, error) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken)
+
+	if err != nil {
+		return db.Session{}, status.Errorf(codes.Unauthenticated, "invalid refresh token: %s", err)
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return session, status.Errorf(codes.NotFound, "session not found: %s", err)
+		}
+		return session, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if !policy.Can(principal, policy.ManageSession, session.Username) {
+		return session, status.Errorf(codes.PermissionDenied, "%s", ErrSessionUserIsInvalid)
+	}
+
+	if session.RefreshToken != refreshToken {
+		return session, status.Errorf(codes.Unauthenticated, "%s", ErrInvalidSessionToken)
+	}
+
+	if session.IsBlocked {
+		return session, status.Errorf(codes.Unauthenticated, "%s", ErrSessionBlocked)
+	}
+
+	return session, nil
+}
+
+// auditAdminAction writes the audit log of an admin action which only reads data,
+// the data must not be returned if the action can't be audited
+func (server *Server) auditAdminAction(ctx context.Context, action, targetType, targetID string) error {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = server.store.CreateAdminAuditLog(ctx, db.CreateAdminAuditLogParams{
+		AdminUsername: authPayload.Username,
+		Action:        action,
+		TargetType:    targetType,
+		TargetID:      targetID,
+	})
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to write admin audit log: %s", err)
+	}
+
+	return nil
+}
+
+// authorizePaymentRequest gets the payment request from DB and checks if the principal may read it as its requester or its payer
+func (server *Server) authorizePaymentRequest(ctx context.Context, id int64, principal *token.Payload) (db.PaymentRequest, error) {
+	request, err := server.store.GetPaymentRequest(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return request, status.Errorf(codes.NotFound, "payment request not found: %s", err)
+		}
+		return request, status.Errorf(codes.Internal, "failed to get payment request: %s", err)
+	}
+
+	if !policy.Can(principal, policy.ReadPaymentRequest, request.Requester) && !policy.Can(principal, policy.ReadPaymentRequest, request.Payer) {
+		return request, status.Errorf(codes.PermissionDenied, "%s", ErrPaymentRequestIsNotAuthenticatedUsers)
+	}
+
+	return request, nil
+}
+
+// authorizePaymentRequestUser gets the payment request from DB and checks if the principal may manage it as the user
+// on the side which answers it, the payer accepts or declines it and the requester cancels it
+func (server *Server) authorizePaymentRequestUser(ctx context.Context, id int64, principal *token.Payload, user func(request db.PaymentRequest) string) (db.PaymentRequest, error) {
+	request, err := server.authorizePaymentRequest(ctx, id, principal)
+
+	if err != nil {
+		return request, err
+	}
+
+	if !policy.Can(principal, policy.ManagePaymentRequest, user(request)) {
+		return request, status.Errorf(codes.PermissionDenied, "%s", ErrPaymentRequestIsNotAuthenticatedUsers)
+	}
+
+	return request, nil
+}
+
+// authorizeBillSplit gets the bill split with its payment requests from DB and checks if the principal may read it
+// as its initiator or as the payer of one of its payment requests
+func (server *Server) authorizeBillSplit(ctx context.Context, id int64, principal *token.Payload) (db.BillSplitDetail, error) {
+	var detail db.BillSplitDetail
+
+	billSplit, err := server.store.GetBillSplit(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return detail, status.Errorf(codes.NotFound, "bill split not found: %s", err)
+		}
+		return detail, status.Errorf(codes.Internal, "failed to get bill split: %s", err)
+	}
+
+	requests, err := server.store.ListSplitPaymentRequests(ctx, &billSplit.ID)
+
+	if err != nil {
+		return detail, status.Errorf(codes.Internal, "failed to list payment requests of bill split: %s", err)
+	}
+
+	valid := policy.Can(principal, policy.ReadPaymentRequest, billSplit.Initiator)
+
+	for _, request := range requests {
+		valid = valid || policy.Can(principal, policy.ReadPaymentRequest, request.Payer)
+	}
+
+	if !valid {
+		return detail, status.Errorf(codes.PermissionDenied, "%s", ErrBillSplitIsNotAuthenticatedUsers)
+	}
+
+	return db.NewBillSplitDetail(billSplit, requests), nil
+}
@@ -1,6 +1,8 @@
 package gapi
 
 import (
+	"encoding/json"
+
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
 	"github.com/burakkarasel/Bank-App/pb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -12,7 +14,426 @@ func convertUser(user db.User) *pb.User {
 		Username:          user.Username,
 		FullName:          user.FullName,
 		Email:             user.Email,
+		Role:              user.Role,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
 	}
 }
+
+// convertSession converts db.Session to pb.Session without its refresh token
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		Username:  session.Username,
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		IsBlocked: session.IsBlocked,
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+		CreatedAt: timestamppb.New(session.CreatedAt),
+	}
+}
+
+// convertSessions converts a slice of db.Session to a slice of pb.Session
+func convertSessions(sessions []db.Session) []*pb.Session {
+	result := make([]*pb.Session, len(sessions))
+
+	for i, session := range sessions {
+		result[i] = convertSession(session)
+	}
+
+	return result
+}
+
+// convertAccount converts db.Account to pb.Account
+func convertAccount(account db.Account) *pb.Account {
+	result := &pb.Account{
+		Id:               account.ID,
+		Owner:            account.Owner,
+		Balance:          account.Balance,
+		Currency:         account.Currency,
+		CreatedAt:        timestamppb.New(account.CreatedAt),
+		Status:           account.Status,
+		OverdraftLimit:   account.OverdraftLimit,
+		HeldAmount:       account.HeldAmount,
+		AvailableBalance: account.AvailableBalance,
+	}
+
+	if account.ClosedAt != nil {
+		result.ClosedAt = timestamppb.New(*account.ClosedAt)
+	}
+
+	return result
+}
+
+// convertAccounts converts a slice of db.Account to a slice of pb.Account
+func convertAccounts(accounts []db.Account) []*pb.Account {
+	result := make([]*pb.Account, len(accounts))
+
+	for i, account := range accounts {
+		result[i] = convertAccount(account)
+	}
+
+	return result
+}
+
+// convertEntry converts db.Entry to pb.Entry
+func convertEntry(entry db.Entry) *pb.Entry {
+	return &pb.Entry{
+		Id:        entry.ID,
+		AccountId: entry.AccountID,
+		Amount:    entry.Amount,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
+
+// convertEntries converts a slice of db.Entry to a slice of pb.Entry
+func convertEntries(entries []db.Entry) []*pb.Entry {
+	result := make([]*pb.Entry, len(entries))
+
+	for i, entry := range entries {
+		result[i] = convertEntry(entry)
+	}
+
+	return result
+}
+
+// convertTransfer converts db.Transfer to pb.Transfer
+func convertTransfer(transfer db.Transfer) *pb.Transfer {
+	result := &pb.Transfer{
+		Id:             transfer.ID,
+		FromAccountId:  transfer.FromAccountID,
+		ToAccountId:    transfer.ToAccountID,
+		Amount:         transfer.Amount,
+		Status:         transfer.Status,
+		RefundedAmount: transfer.RefundedAmount,
+		CreatedAt:      timestamppb.New(transfer.CreatedAt),
+	}
+
+	// only reversals reference the original transfer
+	if transfer.ReversalOf != nil {
+		result.ReversalOf = *transfer.ReversalOf
+	}
+
+	// only cross-currency transfers have a rate and a converted amount
+	if transfer.FxRate != nil {
+		result.FxRate = *transfer.FxRate
+	}
+
+	if transfer.ConvertedAmount != nil {
+		result.ConvertedAmount = *transfer.ConvertedAmount
+	}
+
+	return result
+}
+
+// convertTransferDetail converts db.TransferDetail to pb.TransferDetail
+func convertTransferDetail(detail db.TransferDetail) *pb.TransferDetail {
+	return &pb.TransferDetail{
+		Transfer:  convertTransfer(detail.Transfer),
+		Direction: detail.Direction,
+		Counterparty: &pb.TransferParty{
+			AccountId: detail.Counterparty.AccountID,
+			Owner:     detail.Counterparty.Owner,
+			FullName:  detail.Counterparty.FullName,
+			Currency:  detail.Counterparty.Currency,
+		},
+	}
+}
+
+// convertTransferDetails converts a slice of db.TransferDetail to a slice of pb.TransferDetail
+func convertTransferDetails(details []db.TransferDetail) []*pb.TransferDetail {
+	result := make([]*pb.TransferDetail, len(details))
+
+	for i, detail := range details {
+		result[i] = convertTransferDetail(detail)
+	}
+
+	return result
+}
+
+// convertHold converts db.Hold to pb.Hold
+func convertHold(hold db.Hold) *pb.Hold {
+	result := &pb.Hold{
+		Id:             hold.ID,
+		FromAccountId:  hold.FromAccountID,
+		ToAccountId:    hold.ToAccountID,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Status:         hold.Status,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt),
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+	}
+
+	// only captured holds have a transfer
+	if hold.TransferID != nil {
+		result.TransferId = *hold.TransferID
+	}
+
+	if hold.ClosedAt != nil {
+		result.ClosedAt = timestamppb.New(*hold.ClosedAt)
+	}
+
+	return result
+}
+
+// convertPaymentRequest converts db.PaymentRequest to pb.PaymentRequest
+func convertPaymentRequest(request db.PaymentRequest) *pb.PaymentRequest {
+	result := &pb.PaymentRequest{
+		Id:          request.ID,
+		Requester:   request.Requester,
+		Payer:       request.Payer,
+		ToAccountId: request.ToAccountID,
+		Amount:      request.Amount,
+		Currency:    request.Currency,
+		Memo:        request.Memo,
+		Status:      request.Status,
+		ExpiresAt:   timestamppb.New(request.ExpiresAt),
+		UpdatedAt:   timestamppb.New(request.UpdatedAt),
+		CreatedAt:   timestamppb.New(request.CreatedAt),
+	}
+
+	// only accepted requests have a transfer
+	if request.TransferID != nil {
+		result.TransferId = *request.TransferID
+	}
+
+	if request.SplitID != nil {
+		result.SplitId = *request.SplitID
+	}
+
+	return result
+}
+
+// convertPaymentRequests converts a slice of db.PaymentRequest to a slice of pb.PaymentRequest
+func convertPaymentRequests(requests []db.PaymentRequest) []*pb.PaymentRequest {
+	result := make([]*pb.PaymentRequest, len(requests))
+
+	for i, request := range requests {
+		result[i] = convertPaymentRequest(request)
+	}
+
+	return result
+}
+
+// convertPaymentRequestEvents converts a slice of db.PaymentRequestEvent to a slice of pb.PaymentRequestEvent
+func convertPaymentRequestEvents(events []db.PaymentRequestEvent) []*pb.PaymentRequestEvent {
+	result := make([]*pb.PaymentRequestEvent, len(events))
+
+	for i, event := range events {
+		result[i] = &pb.PaymentRequestEvent{
+			Id:               event.ID,
+			PaymentRequestId: event.PaymentRequestID,
+			Status:           event.Status,
+			CreatedAt:        timestamppb.New(event.CreatedAt),
+		}
+
+		// expired requests aren't changed by a user
+		if event.Actor != nil {
+			result[i].Actor = *event.Actor
+		}
+	}
+
+	return result
+}
+
+// convertBillSplit converts db.BillSplit to pb.BillSplit
+func convertBillSplit(billSplit db.BillSplit) *pb.BillSplit {
+	return &pb.BillSplit{
+		Id:          billSplit.ID,
+		Initiator:   billSplit.Initiator,
+		ToAccountId: billSplit.ToAccountID,
+		TotalAmount: billSplit.TotalAmount,
+		Currency:    billSplit.Currency,
+		Memo:        billSplit.Memo,
+		Rule:        billSplit.Rule,
+		CreatedAt:   timestamppb.New(billSplit.CreatedAt),
+	}
+}
+
+// convertBillSplits converts a slice of db.BillSplit to a slice of pb.BillSplit
+func convertBillSplits(splits []db.BillSplit) []*pb.BillSplit {
+	result := make([]*pb.BillSplit, len(splits))
+
+	for i, billSplit := range splits {
+		result[i] = convertBillSplit(billSplit)
+	}
+
+	return result
+}
+
+// convertBillSplitProgress converts db.BillSplitProgress to pb.BillSplitProgress
+func convertBillSplitProgress(progress db.BillSplitProgress) *pb.BillSplitProgress {
+	return &pb.BillSplitProgress{
+		Status:            progress.Status,
+		Requests:          int32(progress.Requests),
+		Accepted:          int32(progress.Accepted),
+		PaidAmount:        progress.PaidAmount,
+		OutstandingAmount: progress.OutstandingAmount,
+	}
+}
+
+// convertFxQuote converts db.FxQuote to pb.FxQuote
+func convertFxQuote(quote db.FxQuote) *pb.FxQuote {
+	return &pb.FxQuote{
+		Id:           quote.ID.String(),
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         quote.Rate,
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+	}
+}
+
+// convertScheduledTransfer converts db.ScheduledTransfer to pb.ScheduledTransfer
+func convertScheduledTransfer(scheduled db.ScheduledTransfer) *pb.ScheduledTransfer {
+	result := &pb.ScheduledTransfer{
+		Id:            scheduled.ID,
+		Owner:         scheduled.Owner,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     scheduled.Frequency,
+		StartAt:       timestamppb.New(scheduled.StartAt),
+		ScheduledAt:   timestamppb.New(scheduled.ScheduledAt),
+		RunAt:         timestamppb.New(scheduled.RunAt),
+		Occurrences:   scheduled.Occurrences,
+		MaxRetries:    scheduled.MaxRetries,
+		RetryInterval: scheduled.RetryInterval,
+		Retries:       scheduled.Retries,
+		Status:        scheduled.Status,
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt),
+	}
+
+	if scheduled.EndAt != nil {
+		result.EndAt = timestamppb.New(*scheduled.EndAt)
+	}
+
+	if scheduled.MaxOccurrences != nil {
+		result.MaxOccurrences = *scheduled.MaxOccurrences
+	}
+
+	return result
+}
+
+// convertScheduledTransfers converts a slice of db.ScheduledTransfer to a slice of pb.ScheduledTransfer
+func convertScheduledTransfers(scheduledTransfers []db.ScheduledTransfer) []*pb.ScheduledTransfer {
+	result := make([]*pb.ScheduledTransfer, len(scheduledTransfers))
+
+	for i, scheduled := range scheduledTransfers {
+		result[i] = convertScheduledTransfer(scheduled)
+	}
+
+	return result
+}
+
+// convertScheduledTransferExecutions converts a slice of db.ScheduledTransferExecution to a slice of pb.ScheduledTransferExecution
+func convertScheduledTransferExecutions(executions []db.ScheduledTransferExecution) []*pb.ScheduledTransferExecution {
+	result := make([]*pb.ScheduledTransferExecution, len(executions))
+
+	for i, execution := range executions {
+		result[i] = &pb.ScheduledTransferExecution{
+			Id:                  execution.ID,
+			ScheduledTransferId: execution.ScheduledTransferID,
+			Status:              execution.Status,
+			ExecutedAt:          timestamppb.New(execution.ExecutedAt),
+		}
+
+		if execution.TransferID != nil {
+			result[i].TransferId = *execution.TransferID
+		}
+
+		if execution.FailureReason != nil {
+			result[i].FailureReason = *execution.FailureReason
+		}
+	}
+
+	return result
+}
+
+// convertAdminAuditLog converts db.AdminAuditLog to pb.AdminAuditLog
+func convertAdminAuditLog(log db.AdminAuditLog) *pb.AdminAuditLog {
+	return &pb.AdminAuditLog{
+		Id:            log.ID,
+		AdminUsername: log.AdminUsername,
+		Action:        log.Action,
+		TargetType:    log.TargetType,
+		TargetId:      log.TargetID,
+		Reason:        log.Reason,
+		CreatedAt:     timestamppb.New(log.CreatedAt),
+	}
+}
+
+// convertAdminAuditLogs converts a slice of db.AdminAuditLog to a slice of pb.AdminAuditLog
+func convertAdminAuditLogs(logs []db.AdminAuditLog) []*pb.AdminAuditLog {
+	result := make([]*pb.AdminAuditLog, len(logs))
+
+	for i, log := range logs {
+		result[i] = convertAdminAuditLog(log)
+	}
+
+	return result
+}
+
+// convertReconciliationReport converts db.ReconciliationReport to pb.ReconciliationReport,
+// the discrepancies are decoded from the JSON which is stored with the report
+func convertReconciliationReport(report db.ReconciliationReport) (*pb.ReconciliationReport, error) {
+	var discrepancies []db.Discrepancy
+
+	if err := json.Unmarshal(report.Discrepancies, &discrepancies); err != nil {
+		return nil, err
+	}
+
+	result := &pb.ReconciliationReport{
+		Id:                report.ID,
+		AccountsChecked:   report.AccountsChecked,
+		TransfersChecked:  report.TransfersChecked,
+		CurrenciesChecked: report.CurrenciesChecked,
+		DiscrepancyCount:  report.DiscrepancyCount,
+		Discrepancies:     make([]*pb.Discrepancy, len(discrepancies)),
+		StartedAt:         timestamppb.New(report.StartedAt),
+		CreatedAt:         timestamppb.New(report.CreatedAt),
+	}
+
+	for i, discrepancy := range discrepancies {
+		result.Discrepancies[i] = &pb.Discrepancy{
+			Kind:     discrepancy.Kind,
+			Currency: discrepancy.Currency,
+			Expected: discrepancy.Expected,
+			Actual:   discrepancy.Actual,
+		}
+
+		// each kind only references the record it belongs to
+		if discrepancy.AccountID != nil {
+			result.Discrepancies[i].AccountId = *discrepancy.AccountID
+		}
+
+		if discrepancy.TransferID != nil {
+			result.Discrepancies[i].TransferId = *discrepancy.TransferID
+		}
+
+		if discrepancy.JournalID != nil {
+			result.Discrepancies[i].JournalId = *discrepancy.JournalID
+		}
+	}
+
+	return result, nil
+}
+
+// convertMonthlyStatements converts a slice of db.ListStatementsRow to a slice of pb.MonthlyStatement
+func convertMonthlyStatements(statements []db.ListStatementsRow) []*pb.MonthlyStatement {
+	result := make([]*pb.MonthlyStatement, len(statements))
+
+	for i, statement := range statements {
+		result[i] = &pb.MonthlyStatement{
+			Id:             statement.ID,
+			AccountId:      statement.AccountID,
+			PeriodStart:    timestamppb.New(statement.PeriodStart),
+			PeriodEnd:      timestamppb.New(statement.PeriodEnd),
+			OpeningBalance: statement.OpeningBalance,
+			ClosingBalance: statement.ClosingBalance,
+			FileName:       statement.FileName,
+			CreatedAt:      timestamppb.New(statement.CreatedAt),
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyKeyHeader is the metadata key of the idempotency key, gateway maps the Idempotency-Key header to it
+const idempotencyKeyHeader = "idempotency-key"
+
+// idempotencyParams returns the idempotency params of the request for the given user,
+// if the request has no idempotency key it returns nil so the request is executed as usual
+func idempotencyParams(ctx context.Context, username string) (*db.IdempotencyParams, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+
+	if !ok {
+		return nil, nil
+	}
+
+	values := md.Get(idempotencyKeyHeader)
+
+	if len(values) == 0 || values[0] == "" {
+		return nil, nil
+	}
+
+	if err := val.ValidateIdempotencyKey(values[0]); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(idempotencyKeyHeader, err)})
+	}
+
+	return &db.IdempotencyParams{
+		Username: username,
+		Key:      values[0],
+	}, nil
+}
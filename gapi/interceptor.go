@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/burakkarasel/Bank-App/policy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminServicePrefix is the prefix of the RPCs of the back-office service which only admins can call
+const adminServicePrefix = "/pb.AdminService/"
+
+// publicMethods holds the RPCs which can be called without an access token, every other RPC requires one
+var publicMethods = map[string]bool{
+	"/pb.BankApp/CreateUser":       true,
+	"/pb.BankApp/LoginUser":        true,
+	"/pb.BankApp/RenewAccessToken": true,
+}
+
+// authenticate verifies the access token of the request unless the method is public and checks the role
+// for the admin service, then returns a new context which carries the payload of the token
+func (server *Server) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if publicMethods[fullMethod] {
+		return ctx, nil
+	}
+
+	payload, err := server.authorizeUser(ctx)
+
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	if strings.HasPrefix(fullMethod, adminServicePrefix) && !policy.Allowed(payload, policy.UseAdminAPI) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s", ErrAdminRoleRequired)
+	}
+
+	return contextWithAuthPayload(ctx, payload), nil
+}
+
+// UnaryAuthInterceptor authenticates unary RPCs before they reach their handlers
+func (server *Server) UnaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := server.authenticate(ctx, info.FullMethod)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// authServerStream wraps grpc.ServerStream to carry the authenticated context
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the authenticated context of the stream
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// StreamAuthInterceptor authenticates streaming RPCs before they reach their handlers
+func (server *Server) StreamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := server.authenticate(stream.Context(), info.FullMethod)
+
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ServerStream: stream, ctx: ctx})
+}
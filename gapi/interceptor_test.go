@@ -0,0 +1,278 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// contextWithBearerToken returns an incoming context with the authorization metadata of a new access token of the user
+func contextWithBearerToken(t *testing.T, tokenMaker token.Maker, username, role string, duration time.Duration) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+
+	return contextWithAuthorization(fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+}
+
+// contextWithAuthorization returns an incoming context with the authorization metadata
+func contextWithAuthorization(authorization string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, authorization))
+}
+
+// testServerStream is a grpc.ServerStream which only has a context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream
+func (stream *testServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// authInterceptorTestCase is a case of the tests of the auth interceptors, the same cases run on the unary and the stream path
+type authInterceptorTestCase struct {
+	name       string
+	fullMethod string
+	buildCtx   func(t *testing.T, tokenMaker token.Maker) context.Context
+	checkAuth  func(t *testing.T, called bool, payload *token.Payload, err error)
+}
+
+// authInterceptorTestCases returns the cases of the auth interceptor tests for the user
+func authInterceptorTestCases(username string) []authInterceptorTestCase {
+	return []authInterceptorTestCase{
+		{
+			name:       "OK",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithBearerToken(t, tokenMaker, username, util.DepositorRole, time.Minute)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, username, payload.Username)
+				require.Equal(t, util.DepositorRole, payload.Role)
+			},
+		},
+		{
+			name:       "Gateway authorization",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				accessToken, _, err := tokenMaker.CreateToken(username, util.DepositorRole, time.Minute)
+				require.NoError(t, err)
+
+				md := metadata.Pairs(grpcGatewayAuthorization, fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+				return metadata.NewIncomingContext(context.Background(), md)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, username, payload.Username)
+			},
+		},
+		{
+			name:       "Public method without token",
+			fullMethod: "/pb.BankApp/LoginUser",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Nil(t, payload)
+			},
+		},
+		{
+			name:       "No metadata",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Missing authorization",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(userAgentHeader, "test"))
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Invalid authorization format",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithAuthorization(authorizationBearer)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Unsupported authorization type",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				accessToken, _, err := tokenMaker.CreateToken(username, util.DepositorRole, time.Minute)
+				require.NoError(t, err)
+
+				return contextWithAuthorization(fmt.Sprintf("basic %s", accessToken))
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Malformed token",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithAuthorization(fmt.Sprintf("%s %s", authorizationBearer, util.RandomString(32)))
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Expired token",
+			fullMethod: "/pb.BankApp/GetAccount",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithBearerToken(t, tokenMaker, username, util.DepositorRole, -time.Minute)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Admin method as depositor",
+			fullMethod: adminServicePrefix + "SearchUsers",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithBearerToken(t, tokenMaker, username, util.DepositorRole, time.Minute)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+				require.False(t, called)
+			},
+		},
+		{
+			name:       "Admin method as admin",
+			fullMethod: adminServicePrefix + "SearchUsers",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return contextWithBearerToken(t, tokenMaker, username, util.AdminRole, time.Minute)
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				require.NoError(t, err)
+				require.True(t, called)
+				require.Equal(t, util.AdminRole, payload.Role)
+			},
+		},
+		{
+			name:       "Admin method without token",
+			fullMethod: adminServicePrefix + "SearchUsers",
+			buildCtx: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkAuth: func(t *testing.T, called bool, payload *token.Payload, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			},
+		},
+	}
+}
+
+// TestPublicMethods tests that every public method is an RPC of our services, so a typo can't make an RPC require a token
+// and no RPC of the admin service is public
+func TestPublicMethods(t *testing.T) {
+	registered := map[string]bool{}
+
+	for _, desc := range []grpc.ServiceDesc{pb.BankApp_ServiceDesc, pb.AdminService_ServiceDesc} {
+		for _, method := range desc.Methods {
+			registered[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = true
+		}
+
+		for _, stream := range desc.Streams {
+			registered[fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)] = true
+		}
+	}
+
+	require.NotEmpty(t, publicMethods)
+
+	for method := range publicMethods {
+		require.True(t, registered[method], "public method %s isn't registered", method)
+		require.False(t, strings.HasPrefix(method, adminServicePrefix), "admin method %s is public", method)
+	}
+}
+
+// TestUnaryAuthInterceptor tests UnaryAuthInterceptor with multiple cases
+func TestUnaryAuthInterceptor(t *testing.T) {
+	for _, tc := range authInterceptorTestCases(util.RandomOwner()) {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+			var called bool
+			var payload *token.Payload
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				payload, _ = ctx.Value(authPayloadKey{}).(*token.Payload)
+				return req, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}
+			_, err := server.UnaryAuthInterceptor(tc.buildCtx(t, server.tokenMaker), nil, info, handler)
+
+			tc.checkAuth(t, called, payload, err)
+		})
+	}
+}
+
+// TestStreamAuthInterceptor tests StreamAuthInterceptor with the same cases as the unary interceptor
+func TestStreamAuthInterceptor(t *testing.T) {
+	for _, tc := range authInterceptorTestCases(util.RandomOwner()) {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+			var called bool
+			var payload *token.Payload
+
+			//! the handler must get the authenticated context from the stream
+			handler := func(srv interface{}, stream grpc.ServerStream) error {
+				called = true
+				payload, _ = stream.Context().Value(authPayloadKey{}).(*token.Payload)
+				return nil
+			}
+
+			stream := &testServerStream{ctx: tc.buildCtx(t, server.tokenMaker)}
+			info := &grpc.StreamServerInfo{FullMethod: tc.fullMethod, IsServerStream: true}
+			err := server.StreamAuthInterceptor(nil, stream, info, handler)
+
+			tc.checkAuth(t, called, payload, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestServer returns a new test server for tests
+func newTestServer(t *testing.T, store db.Store) *Server {
+	config := util.Config{
+		TokenSymmetricKey:   util.RandomString(32),
+		AccessTokenDuration: time.Minute,
+		FxQuoteDuration:     time.Minute,
+	}
+
+	server, err := NewServer(config, store)
+	require.NoError(t, err)
+
+	return server
+}
+
+// contextWithUser returns a context which carries the payload of an access token of the user with the role,
+// as the auth interceptor puts it for the handlers
+func contextWithUser(t *testing.T, username, role string) context.Context {
+	payload, err := token.NewPayload(username, role, time.Minute)
+	require.NoError(t, err)
+
+	return contextWithAuthPayload(context.Background(), payload)
+}
+
+// randomAccount returns a random account of the owner in the currency
+func randomAccount(owner, currency string) db.Account {
+	return db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    owner,
+		Balance:  util.RandomMoney(),
+		Currency: currency,
+		Status:   db.AccountActive,
+	}
+}
+
+// testAuditActor returns the audit actor of the test RPCs of the user, they have no peer or metadata
+func testAuditActor(username string) *db.AuditActor {
+	return &db.AuditActor{
+		Username: username,
+		Channel:  db.AuditChannelGRPC,
+	}
+}
+
+// requireStatusCode checks if the error is a gRPC status with the code
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+}
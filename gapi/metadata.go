@@ -3,6 +3,8 @@ package gapi
 import (
 	"context"
 
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -13,24 +15,41 @@ const (
 	xForwadedForHeader         = "x-forwarded-for"
 )
 
-// Metadata holds the metadata we need
+// gatewayNetwork is the network of the in-memory connection which the gateway sends its requests through
+const gatewayNetwork = "bufconn"
+
+// Metadata holds the metadata we need, Gateway is true if the request came through the gateway
 type Metadata struct {
 	UserAgent string
 	ClientIP  string
+	Gateway   bool
 }
 
-// extractMetadata parses the metadata we want from the request
+// extractMetadata parses the metadata we want from the request, the headers which are forwarded by the gateway
+// are only trusted if the request came through the in-memory connection of the gateway
 func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 	mtdt := &Metadata{}
 
+	// here we added peer for directli gRPC requests to parse client ip
+	if pr, ok := peer.FromContext(ctx); ok {
+		mtdt.ClientIP = pr.Addr.String()
+		mtdt.Gateway = pr.Addr.Network() == gatewayNetwork
+	}
+
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
-		// to parse user agent from gateway requests
-		if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
+		// to parse user agent from gRPC requests
+		if userAgents := md.Get(userAgentHeader); len(userAgents) > 0 {
 			mtdt.UserAgent = userAgents[0]
 		}
 
-		// to parse user agent from gRPC requests
-		if userAgents := md.Get(userAgentHeader); len(userAgents) > 0 {
+		// any direct gRPC client can send these headers, so they can't replace the user agent and the peer of the connection
+		if !mtdt.Gateway {
+			return mtdt
+		}
+
+		// gateway requests reach us through an in-memory gRPC connection, so the headers
+		// forwarded by the gateway take precedence over the connection's user agent and peer
+		if userAgents := md.Get(grpcGatewayUserAgentHeader); len(userAgents) > 0 {
 			mtdt.UserAgent = userAgents[0]
 		}
 
@@ -40,10 +59,23 @@ func (server *Server) extractMetadata(ctx context.Context) *Metadata {
 		}
 	}
 
-	// here we added peer for directli gRPC requests to parse client ip
-	if pr, ok := peer.FromContext(ctx); ok {
-		mtdt.ClientIP = pr.Addr.String()
+	return mtdt
+}
+
+// auditActor returns the given user and the client of the request, which are written to the audit events
+func (server *Server) auditActor(ctx context.Context, username string) *db.AuditActor {
+	mtdt := server.extractMetadata(ctx)
+
+	actor := &db.AuditActor{
+		Username:  username,
+		Channel:   db.AuditChannelGRPC,
+		ClientIP:  mtdt.ClientIP,
+		UserAgent: mtdt.UserAgent,
 	}
 
-	return mtdt
+	if mtdt.Gateway {
+		actor.Channel = db.AuditChannelGateway
+	}
+
+	return actor
 }
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestAuditActor tests auditActor with multiple cases
+func TestAuditActor(t *testing.T) {
+	tcpAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.7"), Port: 50123}
+
+	//! the listener of the gateway's in-memory connection has the same address as its peers
+	gatewayAddr := bufconn.Listen(1).Addr()
+
+	forwarded := metadata.Pairs(
+		userAgentHeader, "grpc-go",
+		grpcGatewayUserAgentHeader, "Mozilla/5.0",
+		xForwadedForHeader, "203.0.113.9",
+	)
+
+	testCases := []struct {
+		name     string
+		addr     net.Addr
+		md       metadata.MD
+		expected *db.AuditActor
+	}{
+		{
+			name: "Direct call",
+			addr: tcpAddr,
+			md:   metadata.Pairs(userAgentHeader, "grpc-go"),
+			expected: &db.AuditActor{
+				Username:  "user",
+				Channel:   db.AuditChannelGRPC,
+				ClientIP:  tcpAddr.String(),
+				UserAgent: "grpc-go",
+			},
+		},
+		{
+			name: "Direct call with spoofed gateway headers",
+			addr: tcpAddr,
+			md:   forwarded,
+			expected: &db.AuditActor{
+				Username:  "user",
+				Channel:   db.AuditChannelGRPC,
+				ClientIP:  tcpAddr.String(),
+				UserAgent: "grpc-go",
+			},
+		},
+		{
+			name: "Gateway call",
+			addr: gatewayAddr,
+			md:   forwarded,
+			expected: &db.AuditActor{
+				Username:  "user",
+				Channel:   db.AuditChannelGateway,
+				ClientIP:  "203.0.113.9",
+				UserAgent: "Mozilla/5.0",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			server := newTestServer(t, mockdb.NewMockStore(ctrl))
+
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: tc.addr})
+			ctx = metadata.NewIncomingContext(ctx, tc.md)
+
+			require.Equal(t, tc.expected, server.auditActor(ctx, "user"))
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AcceptPaymentRequest handles gRPC accept payment request requests, only the payer can accept it
+// and it is paid from the payer's account in its currency
+func (server *Server) AcceptPaymentRequest(ctx context.Context, req *pb.AcceptPaymentRequestRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAcceptPaymentRequestRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// retries with the same idempotency key get the result of the first payment
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	payer := func(request db.PaymentRequest) string { return request.Payer }
+
+	if _, err := server.authorizePaymentRequestUser(ctx, req.GetPaymentRequestId(), authPayload, payer); err != nil {
+		return nil, err
+	}
+
+	arg := db.AcceptPaymentRequestTxParams{
+		PaymentRequestID: req.GetPaymentRequestId(),
+		Idempotency:      idempotency,
+		Actor:            server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrPaymentRequestNotPending, db.ErrPaymentRequestExpired, db.ErrPayerHasNoAccount, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to accept payment request: %s", err)
+	}
+
+	rsp := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(result.PaymentRequest),
+		Transfer:       convertTransfer(result.Transfer),
+		FromAccount:    convertAccount(result.FromAccount),
+		ToAccount:      convertAccount(result.ToAccount),
+		FromEntry:      convertEntry(result.FromEntry),
+		ToEntry:        convertEntry(result.ToEntry),
+	}
+
+	return rsp, nil
+}
+
+// validateAcceptPaymentRequestRequest checks validations for the AcceptPaymentRequestRequest
+func validateAcceptPaymentRequestRequest(req *pb.AcceptPaymentRequestRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetPaymentRequestId()); err != nil {
+		violations = append(violations, fieldViolation("payment_request_id", err))
+	}
+
+	return violations
+}
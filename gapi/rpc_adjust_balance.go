@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdjustBalance handles gRPC adjust balance requests of admins, the reason is written to the audit log
+func (server *Server) AdjustBalance(ctx context.Context, req *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateAdjustBalanceRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.AdjustBalanceTx(ctx, db.AdjustBalanceTxParams{
+		AdminUsername: authPayload.Username,
+		AccountID:     req.GetAccountId(),
+		Amount:        req.GetAmount(),
+		Reason:        req.GetReason(),
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		if err == db.ErrAccountClosed || err == db.ErrInsufficientFunds || err == db.ErrSystemAccount {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to adjust balance: %s", err)
+	}
+
+	resp := &pb.AdjustBalanceResponse{
+		Account:  convertAccount(result.Account),
+		Entry:    convertEntry(result.Entry),
+		AuditLog: convertAdminAuditLog(result.AuditLog),
+	}
+
+	return resp, nil
+}
+
+// validateAdjustBalanceRequest checks validations for the AdjustBalanceRequest
+func validateAdjustBalanceRequest(req *pb.AdjustBalanceRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateEntryAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
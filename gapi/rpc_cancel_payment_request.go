@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CancelPaymentRequest handles gRPC cancel payment request requests, only the requester can cancel it
+func (server *Server) CancelPaymentRequest(ctx context.Context, req *pb.CancelPaymentRequestRequest) (*pb.CancelPaymentRequestResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCancelPaymentRequestRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	requester := func(request db.PaymentRequest) string { return request.Requester }
+
+	if _, err := server.authorizePaymentRequestUser(ctx, req.GetPaymentRequestId(), authPayload, requester); err != nil {
+		return nil, err
+	}
+
+	request, err := server.store.CancelPaymentRequestTx(ctx, db.ClosePaymentRequestTxParams{
+		PaymentRequestID: req.GetPaymentRequestId(),
+		Actor:            server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == db.ErrPaymentRequestNotPending || err == db.ErrPaymentRequestExpired {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel payment request: %s", err)
+	}
+
+	rsp := &pb.CancelPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request),
+	}
+
+	return rsp, nil
+}
+
+// validateCancelPaymentRequestRequest checks validations for the CancelPaymentRequestRequest
+func validateCancelPaymentRequestRequest(req *pb.CancelPaymentRequestRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetPaymentRequestId()); err != nil {
+		violations = append(violations, fieldViolation("payment_request_id", err))
+	}
+
+	return violations
+}
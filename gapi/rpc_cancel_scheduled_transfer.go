@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CancelScheduledTransfer handles gRPC cancel scheduled transfer requests, executions of it are kept
+func (server *Server) CancelScheduledTransfer(ctx context.Context, req *pb.CancelScheduledTransferRequest) (*pb.CancelScheduledTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCancelScheduledTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.authorizeScheduledTransfer(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled, err := server.store.CancelScheduledTransfer(ctx, req.GetId())
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", ErrScheduledTransferNotActive)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to cancel scheduled transfer: %s", err)
+	}
+
+	resp := &pb.CancelScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+
+	return resp, nil
+}
+
+// validateCancelScheduledTransferRequest checks validations for the CancelScheduledTransferRequest
+func validateCancelScheduledTransferRequest(req *pb.CancelScheduledTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CaptureHold handles gRPC capture hold requests, only the owner of the account which the hold is for can capture it
+func (server *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCaptureHoldRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// retries with the same idempotency key get the result of the first capture
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := server.authorizePayeeHold(ctx, req.GetHoldId(), authPayload, policy.CaptureHold); err != nil {
+		return nil, err
+	}
+
+	arg := db.CaptureHoldTxParams{
+		HoldID:      req.GetHoldId(),
+		Amount:      req.GetAmount(),
+		Idempotency: idempotency,
+		Actor:       server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrHoldNotPending, db.ErrHoldExpired, db.ErrCaptureExceedsHold, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to capture hold: %s", err)
+	}
+
+	rsp := &pb.CaptureHoldResponse{
+		Hold:        convertHold(result.Hold),
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+	}
+
+	return rsp, nil
+}
+
+// validateCaptureHoldRequest checks validations for the CaptureHoldRequest, zero amount captures the whole hold
+func validateCaptureHoldRequest(req *pb.CaptureHoldRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	if req.GetAmount() != 0 {
+		if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
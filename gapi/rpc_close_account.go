@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CloseAccount handles gRPC close account requests, the account must be empty or its balance is swept
+// to another account of the user. Closed accounts are kept with their entries and transfers
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCloseAccountRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetId(), authPayload, policy.CloseAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CloseAccountTxParams{
+		AccountID:      req.GetId(),
+		SweepAccountID: req.GetSweepAccountId(),
+		Actor:          server.auditActor(ctx, authPayload.Username),
+	}
+
+	if req.GetSweepAccountId() != 0 {
+		sweepAccount, err := server.authorizeAccount(ctx, req.GetSweepAccountId(), authPayload, policy.CloseAccount)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if req.GetFxQuoteId() != "" {
+			quoteID, err := server.authorizeSweepFxQuote(ctx, req.GetFxQuoteId(), authPayload, account, sweepAccount)
+
+			if err != nil {
+				return nil, err
+			}
+
+			arg.QuoteID = &quoteID
+		}
+	}
+
+	result, err := server.store.CloseAccountTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrAccountClosed, db.ErrAccountFrozen, db.ErrAccountBalanceNotZero, db.ErrAccountHasHolds,
+			db.ErrInvalidSweepAccount, db.ErrSweepNeedsFxQuote, db.ErrFxQuoteUnavailable,
+			db.ErrConvertedAmountTooSmall, db.ErrFxQuoteCurrencyMismatch, db.ErrFxQuoteNotOwned, db.ErrSystemAccount:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to close account: %s", err)
+	}
+
+	resp := &pb.CloseAccountResponse{
+		Account: convertAccount(result.Account),
+	}
+
+	if result.Sweep != nil {
+		resp.SweepTransfer = convertTransfer(result.Sweep.Transfer)
+		resp.SweepAccount = convertAccount(result.Sweep.ToAccount)
+	}
+
+	return resp, nil
+}
+
+// authorizeSweepFxQuote checks if the principal may use the quote and it converts between the currencies of the accounts
+func (server *Server) authorizeSweepFxQuote(ctx context.Context, id string, principal *token.Payload, account, sweepAccount db.Account) (uuid.UUID, error) {
+	quote, err := server.store.GetFxQuote(ctx, uuid.MustParse(id))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return quote.ID, status.Errorf(codes.NotFound, "fx quote not found: %s", err)
+		}
+		return quote.ID, status.Errorf(codes.Internal, "failed to get fx quote: %s", err)
+	}
+
+	if !policy.Can(principal, policy.UseFxQuote, quote.Username) {
+		return quote.ID, status.Errorf(codes.PermissionDenied, "%s", ErrFxQuoteIsNotAuthenticatedUsers)
+	}
+
+	if quote.FromCurrency != account.Currency || quote.ToCurrency != sweepAccount.Currency {
+		return quote.ID, status.Errorf(codes.InvalidArgument, "fx quote currency mismatch: %s/%s vs %s/%s", quote.FromCurrency, quote.ToCurrency, account.Currency, sweepAccount.Currency)
+	}
+
+	return quote.ID, nil
+}
+
+// validateCloseAccountRequest checks validations for the CloseAccountRequest
+func validateCloseAccountRequest(req *pb.CloseAccountRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.GetSweepAccountId() != 0 {
+		if err := val.ValidateID(req.GetSweepAccountId()); err != nil {
+			violations = append(violations, fieldViolation("sweep_account_id", err))
+		}
+	}
+
+	if req.GetFxQuoteId() != "" {
+		if err := val.ValidateUUID(req.GetFxQuoteId()); err != nil {
+			violations = append(violations, fieldViolation("fx_quote_id", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,111 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// TestCreateAccountRPC tests CreateAccount RPC with multiple cases
+func TestCreateAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := randomAccount(username, util.USD)
+	account.Balance = 0
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateAccountRequest
+		ctx           context.Context
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateAccountRequest{Currency: util.USD},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				//! the account is always created for the authenticated user
+				arg := db.CreateAccountParams{
+					Owner:    username,
+					Currency: util.USD,
+				}
+				store.EXPECT().CreateAccount(gomock.Any(), gomock.Eq(arg)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+				require.Equal(t, username, res.GetAccount().GetOwner())
+				require.Equal(t, util.USD, res.GetAccount().GetCurrency())
+			},
+		},
+		{
+			name: "Already exists",
+			req:  &pb.CreateAccountRequest{Currency: util.USD},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.Account{}, &pq.Error{Code: "23505"})
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				requireStatusCode(t, err, codes.AlreadyExists)
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.CreateAccountRequest{Currency: util.USD},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.Account{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "Invalid currency",
+			req:  &pb.CreateAccountRequest{Currency: "XYZ"},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "No access token payload",
+			req:  &pb.CreateAccountRequest{Currency: util.USD},
+			ctx:  context.Background(),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateAccountResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			res, err := server.CreateAccount(tc.ctx, tc.req)
+
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/split"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateBillSplit handles gRPC create bill split requests, the bill is split between the participants by the rule
+// and each of them is asked for their share which is paid into the authenticated user's account in the currency
+func (server *Server) CreateBillSplit(ctx context.Context, req *pb.CreateBillSplitRequest) (*pb.CreateBillSplitResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateBillSplitRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	participants := make([]split.Participant, len(req.GetParticipants()))
+
+	for i, participant := range req.GetParticipants() {
+		participants[i] = split.Participant{Username: participant.GetUsername(), Value: participant.GetValue()}
+	}
+
+	shares, err := split.Shares(req.GetTotalAmount(), req.GetRule(), participants)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("participants", err)})
+	}
+
+	ttl := db.DefaultPaymentRequestTTL
+
+	if req.GetExpiresIn() > 0 {
+		ttl = time.Duration(req.GetExpiresIn()) * time.Second
+	}
+
+	arg := db.CreateBillSplitTxParams{
+		Initiator:   authPayload.Username,
+		TotalAmount: req.GetTotalAmount(),
+		Currency:    req.GetCurrency(),
+		Memo:        req.GetMemo(),
+		Rule:        req.GetRule(),
+		Shares:      make([]db.BillSplitShare, len(shares)),
+		ExpiresIn:   ttl,
+	}
+
+	for i, share := range shares {
+		arg.Shares[i] = db.BillSplitShare{Username: participants[i].Username, Amount: share}
+	}
+
+	detail, err := server.store.CreateBillSplitTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrParticipantNotFound:
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		case db.ErrRequesterHasNoAccount, db.ErrSplitWithoutPayers, db.ErrSplitSharesMismatch:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create bill split: %s", err)
+	}
+
+	rsp := &pb.CreateBillSplitResponse{
+		BillSplit:       convertBillSplit(detail.BillSplit),
+		PaymentRequests: convertPaymentRequests(detail.PaymentRequests),
+		Progress:        convertBillSplitProgress(detail.Progress),
+	}
+
+	return rsp, nil
+}
+
+// validateCreateBillSplitRequest checks validations for the CreateBillSplitRequest
+func validateCreateBillSplitRequest(req *pb.CreateBillSplitRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateTransferAmount(req.GetTotalAmount()); err != nil {
+		violations = append(violations, fieldViolation("total_amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateMemo(req.GetMemo()); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+
+	if err := val.ValidateSplitRule(req.GetRule()); err != nil {
+		violations = append(violations, fieldViolation("rule", err))
+	}
+
+	for _, participant := range req.GetParticipants() {
+		if err := val.ValidateUsername(participant.GetUsername()); err != nil {
+			violations = append(violations, fieldViolation("participants.username", err))
+		}
+	}
+
+	if err := val.ValidatePaymentRequestTTL(req.GetExpiresIn()); err != nil {
+		violations = append(violations, fieldViolation("expires_in", err))
+	}
+
+	return violations
+}
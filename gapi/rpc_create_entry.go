@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateEntry handles gRPC create entry requests
+func (server *Server) CreateEntry(ctx context.Context, req *pb.CreateEntryRequest) (*pb.CreateEntryResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateEntryRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// retries with the same idempotency key get the result of the first entry
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.MoveMoney)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.EntryTxParams{
+		AccountID:   req.GetAccountId(),
+		Amount:      req.GetAmount(),
+		Idempotency: idempotency,
+		Actor:       server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.EntryTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create entry: %s", err)
+	}
+
+	resp := &pb.CreateEntryResponse{
+		Entry:   convertEntry(result.Entry),
+		Account: convertAccount(result.Account),
+	}
+
+	return resp, nil
+}
+
+// validateCreateEntryRequest checks validations for the CreateEntryRequest
+func validateCreateEntryRequest(req *pb.CreateEntryRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateEntryAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
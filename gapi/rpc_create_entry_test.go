@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// TestCreateEntryRPC tests CreateEntry RPC with multiple cases
+func TestCreateEntryRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := randomAccount(username, util.USD)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateEntryRequest
+		ctx           context.Context
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.CreateEntryResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreateEntryRequest{AccountId: account.ID, Amount: -10},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.EntryTxParams{
+					AccountID: account.ID,
+					Amount:    -10,
+					Actor:     testAuditActor(username),
+				}
+				result := db.EntryTxResult{
+					Entry:   db.Entry{ID: 1, AccountID: account.ID, Amount: -10},
+					Account: account,
+				}
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(result, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateEntryResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetEntry().GetAccountId())
+				require.Equal(t, int64(-10), res.GetEntry().GetAmount())
+			},
+		},
+		{
+			name: "Account of other user",
+			req:  &pb.CreateEntryRequest{AccountId: account.ID, Amount: 10},
+			ctx:  contextWithUser(t, util.RandomOwner(), util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateEntryResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "Frozen account",
+			req:  &pb.CreateEntryRequest{AccountId: account.ID, Amount: -10},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Any()).Times(1).Return(db.EntryTxResult{}, db.ErrAccountFrozen)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateEntryResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "Zero amount",
+			req:  &pb.CreateEntryRequest{AccountId: account.ID, Amount: 0},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().EntryTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateEntryResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			res, err := server.CreateEntry(tc.ctx, tc.req)
+
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/fx"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateFxQuote handles gRPC create fx quote requests, it locks the current rate for the user for a short time
+func (server *Server) CreateFxQuote(ctx context.Context, req *pb.CreateFxQuoteRequest) (*pb.CreateFxQuoteResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateFxQuoteRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rate, err := server.rateProvider.GetRate(ctx, req.GetFromCurrency(), req.GetToCurrency(), time.Now())
+
+	if err != nil {
+		if err == fx.ErrRateNotFound {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get fx rate: %s", err)
+	}
+
+	quoteID, err := uuid.NewRandom()
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create quote id: %s", err)
+	}
+
+	quote, err := server.store.CreateFxQuote(ctx, db.CreateFxQuoteParams{
+		ID:           quoteID,
+		Username:     authPayload.Username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Value,
+		ExpiresAt:    time.Now().Add(server.config.FxQuoteDuration),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create fx quote: %s", err)
+	}
+
+	resp := &pb.CreateFxQuoteResponse{
+		Quote: convertFxQuote(quote),
+	}
+
+	return resp, nil
+}
+
+// validateCreateFxQuoteRequest checks validations for the CreateFxQuoteRequest
+func validateCreateFxQuoteRequest(req *pb.CreateFxQuoteRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	}
+
+	if req.GetFromCurrency() == req.GetToCurrency() {
+		violations = append(violations, fieldViolation("to_currency", ErrSameCurrencyQuote))
+	}
+
+	return violations
+}
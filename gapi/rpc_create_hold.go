@@ -0,0 +1,120 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateHold handles gRPC create hold requests, money is reserved on the authenticated user's account for another account
+func (server *Server) CreateHold(ctx context.Context, req *pb.CreateHoldRequest) (*pb.CreateHoldResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateHoldRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// retries with the same idempotency key get the result of the first hold
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// here we check if the authenticated user may send money from the from account
+	fromAccount, err := server.authorizeAccount(ctx, req.GetFromAccountId(), authPayload, policy.MoveMoney)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(fromAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(toAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	ttl := db.DefaultHoldTTL
+
+	if req.GetExpiresIn() > 0 {
+		ttl = time.Duration(req.GetExpiresIn()) * time.Second
+	}
+
+	arg := db.CreateHoldTxParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		ExpiresIn:     ttl,
+		Idempotency:   idempotency,
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.CreateHoldTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed,
+			db.ErrSystemAccount:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create hold: %s", err)
+	}
+
+	rsp := &pb.CreateHoldResponse{
+		Hold:        convertHold(result.Hold),
+		FromAccount: convertAccount(result.FromAccount),
+	}
+
+	return rsp, nil
+}
+
+// validateCreateHoldRequest checks validations for the CreateHoldRequest
+func validateCreateHoldRequest(req *pb.CreateHoldRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be different from from_account_id")))
+	}
+
+	if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateHoldTTL(req.GetExpiresIn()); err != nil {
+		violations = append(violations, fieldViolation("expires_in", err))
+	}
+
+	return violations
+}
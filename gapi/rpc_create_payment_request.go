@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreatePaymentRequest handles gRPC create payment request requests, another user is asked for money
+// which is paid into the authenticated user's account in the currency
+func (server *Server) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreatePaymentRequestRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	ttl := db.DefaultPaymentRequestTTL
+
+	if req.GetExpiresIn() > 0 {
+		ttl = time.Duration(req.GetExpiresIn()) * time.Second
+	}
+
+	arg := db.CreatePaymentRequestTxParams{
+		Requester: authPayload.Username,
+		Payer:     req.GetPayer(),
+		Amount:    req.GetAmount(),
+		Currency:  req.GetCurrency(),
+		Memo:      req.GetMemo(),
+		ExpiresIn: ttl,
+	}
+
+	request, err := server.store.CreatePaymentRequestTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrRecipientNotFound:
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		case db.ErrPaymentRequestToSelf, db.ErrRequesterHasNoAccount:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create payment request: %s", err)
+	}
+
+	rsp := &pb.CreatePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request),
+	}
+
+	return rsp, nil
+}
+
+// validateCreatePaymentRequestRequest checks validations for the CreatePaymentRequestRequest
+func validateCreatePaymentRequestRequest(req *pb.CreatePaymentRequestRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateRecipient(req.GetPayer()); err != nil {
+		violations = append(violations, fieldViolation("payer", err))
+	}
+
+	if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateMemo(req.GetMemo()); err != nil {
+		violations = append(violations, fieldViolation("memo", err))
+	}
+
+	if err := val.ValidatePaymentRequestTTL(req.GetExpiresIn()); err != nil {
+		violations = append(violations, fieldViolation("expires_in", err))
+	}
+
+	return violations
+}
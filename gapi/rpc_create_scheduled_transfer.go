@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateScheduledTransfer handles gRPC create scheduled transfer requests
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateScheduledTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check if the from account belongs to the authenticated user
+	fromAccount, err := server.authorizeAccount(ctx, req.GetFromAccountId(), authPayload, policy.MoveMoney)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(fromAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(toAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateScheduledTransferParams{
+		Owner:         authPayload.Username,
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   req.GetToAccountId(),
+		Amount:        req.GetAmount(),
+		Frequency:     req.GetFrequency(),
+		StartAt:       req.GetStartAt().AsTime(),
+		MaxRetries:    req.GetMaxRetries(),
+		RetryInterval: req.GetRetryInterval(),
+	}
+
+	if req.GetEndAt() != nil {
+		endAt := req.GetEndAt().AsTime()
+		arg.EndAt = &endAt
+	}
+
+	if req.GetMaxOccurrences() > 0 {
+		maxOccurrences := req.GetMaxOccurrences()
+		arg.MaxOccurrences = &maxOccurrences
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled transfer: %s", err)
+	}
+
+	resp := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+
+	return resp, nil
+}
+
+// validateCreateScheduledTransferRequest checks validations for the CreateScheduledTransferRequest
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if err := val.ValidateFrequency(req.GetFrequency()); err != nil {
+		violations = append(violations, fieldViolation("frequency", err))
+	}
+
+	if req.GetStartAt() == nil {
+		violations = append(violations, fieldViolation("start_at", fmt.Errorf("must be set")))
+	}
+
+	if req.GetEndAt() != nil && req.GetEndAt().AsTime().Before(req.GetStartAt().AsTime()) {
+		violations = append(violations, fieldViolation("end_at", ErrEndBeforeStart))
+	}
+
+	if req.GetMaxOccurrences() < 0 {
+		violations = append(violations, fieldViolation("max_occurrences", fmt.Errorf("must not be negative")))
+	}
+
+	if err := val.ValidateMaxRetries(req.GetMaxRetries()); err != nil {
+		violations = append(violations, fieldViolation("max_retries", err))
+	}
+
+	if err := val.ValidateRetryInterval(req.GetRetryInterval(), req.GetMaxRetries()); err != nil {
+		violations = append(violations, fieldViolation("retry_interval", err))
+	}
+
+	return violations
+}
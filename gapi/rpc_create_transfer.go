@@ -0,0 +1,224 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateTransfer handles gRPC create transfer requests
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// retries with the same idempotency key get the result of the first transfer
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// transfers between different currencies need a quote which locks the rate
+	if req.GetFxQuoteId() != "" {
+		return server.createFxTransfer(ctx, req, authPayload, idempotency)
+	}
+
+	// here we check if the authenticated user may send money from the from account
+	fromAccount, err := server.authorizeAccount(ctx, req.GetFromAccountId(), authPayload, policy.MoveMoney)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(fromAccount, req.GetCurrency()); err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := server.recipientAccount(ctx, req, req.GetCurrency())
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.TransferTxParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   toAccountID,
+		Amount:        req.GetAmount(),
+		Idempotency:   idempotency,
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.TransferTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed,
+			db.ErrSystemAccount:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer: %s", err)
+	}
+
+	return convertTransferTxResult(result), nil
+}
+
+// createFxTransfer transfers money between accounts with different currencies using the quote in the request
+func (server *Server) createFxTransfer(ctx context.Context, req *pb.CreateTransferRequest, principal *token.Payload, idempotency *db.IdempotencyParams) (*pb.CreateTransferResponse, error) {
+	quote, err := server.store.GetFxQuote(ctx, uuid.MustParse(req.GetFxQuoteId()))
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "fx quote not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get fx quote: %s", err)
+	}
+
+	if !policy.Can(principal, policy.UseFxQuote, quote.Username) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s", ErrFxQuoteIsNotAuthenticatedUsers)
+	}
+
+	// amount of the transfer is always in the currency of the from account
+	if quote.FromCurrency != req.GetCurrency() {
+		return nil, status.Errorf(codes.InvalidArgument, "fx quote currency mismatch: %s vs %s", quote.FromCurrency, req.GetCurrency())
+	}
+
+	fromAccount, err := server.authorizeAccount(ctx, req.GetFromAccountId(), principal, policy.MoveMoney)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.validAccountCurrency(fromAccount, quote.FromCurrency); err != nil {
+		return nil, err
+	}
+
+	toAccountID, err := server.recipientAccount(ctx, req, quote.ToCurrency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.FxTransferTxParams{
+		FromAccountID: req.GetFromAccountId(),
+		ToAccountID:   toAccountID,
+		Amount:        req.GetAmount(),
+		QuoteID:       quote.ID,
+		Idempotency:   idempotency,
+		Actor:         server.auditActor(ctx, principal.Username),
+	}
+
+	result, err := server.store.FxTransferTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrConvertedAmountTooSmall:
+			violations := []*errdetails.BadRequest_FieldViolation{fieldViolation("amount", err)}
+			return nil, invalidArgumentError(violations)
+		case db.ErrFxQuoteUnavailable, db.ErrFxQuoteCurrencyMismatch, db.ErrFxQuoteNotOwned, db.ErrInsufficientFunds,
+			db.ErrIdempotencyKeyMismatch, db.ErrAccountFrozen, db.ErrAccountClosed, db.ErrSystemAccount:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to transfer: %s", err)
+	}
+
+	return convertTransferTxResult(result), nil
+}
+
+// recipientAccount returns the ID of the account which the transfer goes to, transfers which are sent to a recipient
+// go to the account of the recipient in the currency
+func (server *Server) recipientAccount(ctx context.Context, req *pb.CreateTransferRequest, currency string) (int64, error) {
+	if req.GetRecipient() != "" {
+		payee, err := server.resolvePayee(ctx, req.GetRecipient(), currency)
+		return payee.Account.ID, err
+	}
+
+	toAccount, err := server.getAccount(ctx, req.GetToAccountId())
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := server.validAccountCurrency(toAccount, currency); err != nil {
+		return 0, err
+	}
+
+	return toAccount.ID, nil
+}
+
+// convertTransferTxResult converts db.TransferTxResult to pb.CreateTransferResponse
+func convertTransferTxResult(result db.TransferTxResult) *pb.CreateTransferResponse {
+	return &pb.CreateTransferResponse{
+		Transfer:    convertTransfer(result.Transfer),
+		FromAccount: convertAccount(result.FromAccount),
+		ToAccount:   convertAccount(result.ToAccount),
+		FromEntry:   convertEntry(result.FromEntry),
+		ToEntry:     convertEntry(result.ToEntry),
+	}
+}
+
+// validAccountCurrency checks if the given currency matches with the account's currency
+func (server *Server) validAccountCurrency(account db.Account, currency string) error {
+	if account.Currency != currency {
+		return status.Errorf(codes.InvalidArgument, "account [%d] currency mismatch: %s vs %s", account.ID, account.Currency, currency)
+	}
+
+	return nil
+}
+
+// validateCreateTransferRequest checks validations for the CreateTransferRequest
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	// money goes either to the account or to the recipient
+	switch {
+	case req.GetRecipient() == "":
+		if err := val.ValidateID(req.GetToAccountId()); err != nil {
+			violations = append(violations, fieldViolation("to_account_id", err))
+		}
+	case req.GetToAccountId() != 0:
+		violations = append(violations, fieldViolation("recipient", fmt.Errorf("can't be set with to_account_id")))
+	default:
+		if err := val.ValidateRecipient(req.GetRecipient()); err != nil {
+			violations = append(violations, fieldViolation("recipient", err))
+		}
+	}
+
+	if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.GetFxQuoteId() != "" {
+		if err := val.ValidateUUID(req.GetFxQuoteId()); err != nil {
+			violations = append(violations, fieldViolation("fx_quote_id", err))
+		}
+	}
+
+	return violations
+}
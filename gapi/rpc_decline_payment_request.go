@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeclinePaymentRequest handles gRPC decline payment request requests, only the payer can decline it
+func (server *Server) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.DeclinePaymentRequestResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDeclinePaymentRequestRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payer := func(request db.PaymentRequest) string { return request.Payer }
+
+	if _, err := server.authorizePaymentRequestUser(ctx, req.GetPaymentRequestId(), authPayload, payer); err != nil {
+		return nil, err
+	}
+
+	request, err := server.store.DeclinePaymentRequestTx(ctx, db.ClosePaymentRequestTxParams{
+		PaymentRequestID: req.GetPaymentRequestId(),
+		Actor:            server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == db.ErrPaymentRequestNotPending || err == db.ErrPaymentRequestExpired {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to decline payment request: %s", err)
+	}
+
+	rsp := &pb.DeclinePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request),
+	}
+
+	return rsp, nil
+}
+
+// validateDeclinePaymentRequestRequest checks validations for the DeclinePaymentRequestRequest
+func validateDeclinePaymentRequestRequest(req *pb.DeclinePaymentRequestRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetPaymentRequestId()); err != nil {
+		violations = append(violations, fieldViolation("payment_request_id", err))
+	}
+
+	return violations
+}
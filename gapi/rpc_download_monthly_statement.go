@@ -0,0 +1,72 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/statement"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DownloadMonthlyStatement handles gRPC download monthly statement requests
+func (server *Server) DownloadMonthlyStatement(ctx context.Context, req *pb.DownloadMonthlyStatementRequest) (*pb.DownloadMonthlyStatementResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateDownloadMonthlyStatementRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.GetStatement(ctx, db.GetStatementParams{
+		ID:        req.GetId(),
+		AccountID: req.GetAccountId(),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "statement not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get statement: %s", err)
+	}
+
+	resp := &pb.DownloadMonthlyStatementResponse{
+		FileName:    result.FileName,
+		ContentType: statement.ContentType(statement.FormatPDF),
+		Data:        result.Content,
+	}
+
+	return resp, nil
+}
+
+// validateDownloadMonthlyStatementRequest checks validations for the DownloadMonthlyStatementRequest
+func validateDownloadMonthlyStatementRequest(req *pb.DownloadMonthlyStatementRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,118 @@
+package gapi
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/statement"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statementChunkSize is the size of the chunks which the statements are streamed in
+const statementChunkSize = 32 * 1024
+
+// statementChunkWriter sends everything written to it as a chunk of the statement,
+// the first chunk carries the file name and the content type
+type statementChunkWriter struct {
+	stream      pb.BankApp_ExportStatementServer
+	fileName    string
+	contentType string
+	sent        bool
+}
+
+// Write sends p as a chunk of the statement
+func (writer *statementChunkWriter) Write(p []byte) (int, error) {
+	chunk := &pb.ExportStatementResponse{
+		Data: p,
+	}
+
+	if !writer.sent {
+		chunk.FileName = writer.fileName
+		chunk.ContentType = writer.contentType
+		writer.sent = true
+	}
+
+	if err := writer.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// ExportStatement handles gRPC export statement requests, the rendered statement is streamed in chunks
+func (server *Server) ExportStatement(req *pb.ExportStatementRequest, stream pb.BankApp_ExportStatementServer) error {
+	ctx := stream.Context()
+
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	violations := validateExportStatementRequest(req)
+
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	account, err := server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return err
+	}
+
+	generator := statement.NewGenerator(server.store)
+
+	result, err := generator.Generate(ctx, account, req.GetFrom().AsTime(), req.GetTo().AsTime())
+
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate statement: %s", err)
+	}
+
+	writer := bufio.NewWriterSize(&statementChunkWriter{
+		stream:      stream,
+		fileName:    statement.FileName(result, req.GetFormat()),
+		contentType: statement.ContentType(req.GetFormat()),
+	}, statementChunkSize)
+
+	if err := generator.Write(ctx, writer, req.GetFormat(), result); err != nil {
+		return status.Errorf(codes.Internal, "failed to write statement: %s", err)
+	}
+
+	if err := writer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to send statement: %s", err)
+	}
+
+	return nil
+}
+
+// validateExportStatementRequest checks validations for the ExportStatementRequest
+func validateExportStatementRequest(req *pb.ExportStatementRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateStatementFormat(req.GetFormat()); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+
+	if req.GetFrom() == nil {
+		violations = append(violations, fieldViolation("from", fmt.Errorf("must be set")))
+	}
+
+	if req.GetTo() == nil {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be set")))
+	} else if !req.GetTo().AsTime().After(req.GetFrom().AsTime()) {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	}
+
+	return violations
+}
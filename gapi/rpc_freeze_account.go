@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FreezeAccount handles gRPC freeze account requests, frozen accounts can receive money but can't send it
+func (server *Server) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateFreezeAccountRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetId(), authPayload, policy.FreezeAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	account, err = server.store.FreezeAccountTx(ctx, db.FreezeAccountTxParams{
+		AccountID:     req.GetId(),
+		FrozenBy:      authPayload.Username,
+		AdminUsername: adminActor(authPayload, account.Owner),
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", ErrAccountNotActive)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to freeze account: %s", err)
+	}
+
+	resp := &pb.FreezeAccountResponse{
+		Account: convertAccount(account),
+	}
+
+	return resp, nil
+}
+
+// validateFreezeAccountRequest checks validations for the FreezeAccountRequest
+func validateFreezeAccountRequest(req *pb.FreezeAccountRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,49 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// GetAccount handles gRPC get account requests
+func (server *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetAccountRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we prevent users to get other user's accounts
+	account, err := server.authorizeAccount(ctx, req.GetId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetAccountResponse{
+		Account: convertAccount(account),
+	}
+
+	return resp, nil
+}
+
+// validateGetAccountRequest checks validations for the GetAccountRequest
+func validateGetAccountRequest(req *pb.GetAccountRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
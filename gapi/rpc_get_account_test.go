@@ -0,0 +1,114 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// TestGetAccountRPC tests GetAccount RPC with multiple cases
+func TestGetAccountRPC(t *testing.T) {
+	username := util.RandomOwner()
+	account := randomAccount(username, util.USD)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetAccountRequest
+		ctx           context.Context
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.GetAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+				require.Equal(t, account.Balance, res.GetAccount().GetBalance())
+			},
+		},
+		{
+			name: "Admin",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			ctx:  contextWithUser(t, util.RandomOwner(), util.AdminRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+			},
+		},
+		{
+			name: "Other user",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			ctx:  contextWithUser(t, util.RandomOwner(), util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "Not found",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "Internal error",
+			req:  &pb.GetAccountRequest{Id: account.ID},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrConnDone)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				requireStatusCode(t, err, codes.Internal)
+			},
+		},
+		{
+			name: "Invalid ID",
+			req:  &pb.GetAccountRequest{Id: 0},
+			ctx:  contextWithUser(t, username, util.DepositorRole),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetAccountResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			res, err := server.GetAccount(tc.ctx, tc.req)
+
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// GetBillSplit handles gRPC get bill split requests, the bill split is returned with its payment requests
+// and how much of it is paid
+func (server *Server) GetBillSplit(ctx context.Context, req *pb.GetBillSplitRequest) (*pb.GetBillSplitResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetBillSplitRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user is the initiator or one of the payers, admins can get every bill split
+	detail, err := server.authorizeBillSplit(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetBillSplitResponse{
+		BillSplit:       convertBillSplit(detail.BillSplit),
+		PaymentRequests: convertPaymentRequests(detail.PaymentRequests),
+		Progress:        convertBillSplitProgress(detail.Progress),
+	}
+
+	return resp, nil
+}
+
+// validateGetBillSplitRequest checks validations for the GetBillSplitRequest
+func validateGetBillSplitRequest(req *pb.GetBillSplitRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
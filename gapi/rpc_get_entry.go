@@ -0,0 +1,61 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEntry handles gRPC get entry requests
+func (server *Server) GetEntry(ctx context.Context, req *pb.GetEntryRequest) (*pb.GetEntryResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetEntryRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	entry, err := server.store.GetEntry(ctx, req.GetId())
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "entry not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get entry: %s", err)
+	}
+
+	// here we check the authenticated user and the entry's account is associated or not
+	_, err = server.authorizeAccount(ctx, entry.AccountID, authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetEntryResponse{
+		Entry: convertEntry(entry),
+	}
+
+	return resp, nil
+}
+
+// validateGetEntryRequest checks validations for the GetEntryRequest
+func validateGetEntryRequest(req *pb.GetEntryRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
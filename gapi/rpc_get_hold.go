@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// GetHold handles gRPC get hold requests
+func (server *Server) GetHold(ctx context.Context, req *pb.GetHoldRequest) (*pb.GetHoldResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetHoldRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user is on either side of the hold, admins can get every hold
+	hold, err := server.authorizeHold(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetHoldResponse{
+		Hold: convertHold(hold),
+	}
+
+	return resp, nil
+}
+
+// validateGetHoldRequest checks validations for the GetHoldRequest
+func validateGetHoldRequest(req *pb.GetHoldRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
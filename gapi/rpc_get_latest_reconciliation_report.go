@@ -0,0 +1,35 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLatestReconciliationReport handles gRPC get latest reconciliation report requests
+func (server *Server) GetLatestReconciliationReport(ctx context.Context, req *pb.GetLatestReconciliationReportRequest) (*pb.GetLatestReconciliationReportResponse, error) {
+	report, err := server.store.GetLatestReconciliationReport(ctx)
+
+	if err != nil {
+		// the ledger isn't reconciled yet
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "no reconciliation report found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reconciliation report: %s", err)
+	}
+
+	converted, err := convertReconciliationReport(report)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode discrepancies: %s", err)
+	}
+
+	resp := &pb.GetLatestReconciliationReportResponse{
+		Report: converted,
+	}
+
+	return resp, nil
+}
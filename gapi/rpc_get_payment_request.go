@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPaymentRequest handles gRPC get payment request requests, the payment request is returned with its history
+func (server *Server) GetPaymentRequest(ctx context.Context, req *pb.GetPaymentRequestRequest) (*pb.GetPaymentRequestResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetPaymentRequestRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user is the requester or the payer, admins can get every payment request
+	request, err := server.authorizePaymentRequest(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := server.store.ListPaymentRequestEvents(ctx, request.ID)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment request history: %s", err)
+	}
+
+	resp := &pb.GetPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(request),
+		History:        convertPaymentRequestEvents(history),
+	}
+
+	return resp, nil
+}
+
+// validateGetPaymentRequestRequest checks validations for the GetPaymentRequestRequest
+func validateGetPaymentRequestRequest(req *pb.GetPaymentRequestRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
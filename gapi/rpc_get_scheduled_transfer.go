@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// GetScheduledTransfer handles gRPC get scheduled transfer requests
+func (server *Server) GetScheduledTransfer(ctx context.Context, req *pb.GetScheduledTransferRequest) (*pb.GetScheduledTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetScheduledTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.authorizeScheduledTransfer(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+
+	return resp, nil
+}
+
+// validateGetScheduledTransferRequest checks validations for the GetScheduledTransferRequest
+func validateGetScheduledTransferRequest(req *pb.GetScheduledTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
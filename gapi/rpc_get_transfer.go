@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetTransfer handles gRPC get transfer requests
+func (server *Server) GetTransfer(ctx context.Context, req *pb.GetTransferRequest) (*pb.GetTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateGetTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user is on either side of the transfer, admins can get every transfer
+	transfer, err := server.authorizeTransfer(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := server.store.TransferDetails(ctx, db.TransferDetailsParams{
+		Transfers: []db.Transfer{transfer},
+		Username:  authPayload.Username,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer details: %s", err)
+	}
+
+	resp := &pb.GetTransferResponse{
+		Transfer: convertTransferDetail(details[0]),
+	}
+
+	return resp, nil
+}
+
+// validateGetTransferRequest checks validations for the GetTransferRequest
+func validateGetTransferRequest(req *pb.GetTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
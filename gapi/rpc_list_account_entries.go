@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"strconv"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAccountEntries handles gRPC list account entries requests of admins
+func (server *Server) ListAccountEntries(ctx context.Context, req *pb.ListAccountEntriesRequest) (*pb.ListAccountEntriesResponse, error) {
+	violations := validateListAccountEntriesRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.getAccount(ctx, req.GetAccountId()); err != nil {
+		return nil, err
+	}
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID: req.GetAccountId(),
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	targetID := strconv.FormatInt(req.GetAccountId(), 10)
+
+	if err := server.auditAdminAction(ctx, db.AdminActionListAccountEntries, db.AuditTargetAccount, targetID); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListAccountEntriesResponse{
+		Entries: convertEntries(entries),
+	}
+
+	return resp, nil
+}
+
+// validateListAccountEntriesRequest checks validations for the ListAccountEntriesRequest
+func validateListAccountEntriesRequest(req *pb.ListAccountEntriesRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,147 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAccounts handles gRPC list accounts requests
+func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// requests without a page ID are paged by cursor
+	if req.GetPageId() == 0 {
+		return server.listAccountsPage(ctx, req, authPayload)
+	}
+
+	violations := validateListAccountsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we prevent users to list other user's accounts
+	accounts, err := server.store.ListAccounts(ctx, db.ListAccountsParams{
+		Owner:  authPayload.Username,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	resp := &pb.ListAccountsResponse{
+		Accounts: convertAccounts(accounts),
+	}
+
+	return resp, nil
+}
+
+// listAccountsPage returns a page of the accounts of the authenticated user which match the filters of the request
+func (server *Server) listAccountsPage(ctx context.Context, req *pb.ListAccountsRequest, authPayload *token.Payload) (*pb.ListAccountsResponse, error) {
+	violations := validateListAccountsPageRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListAccountsPageParams{
+		Owner:    authPayload.Username,
+		Currency: req.GetCurrency(),
+	}
+
+	if req.GetFrom() != nil {
+		arg.From = req.GetFrom().AsTime()
+	}
+
+	if req.GetTo() != nil {
+		arg.To = req.GetTo().AsTime()
+	}
+
+	if req.GetMinBalance() != nil {
+		minBalance := req.GetMinBalance().GetValue()
+		arg.MinBalance = &minBalance
+	}
+
+	if req.GetMaxBalance() != nil {
+		maxBalance := req.GetMaxBalance().GetValue()
+		arg.MaxBalance = &maxBalance
+	}
+
+	page, err := pagination.New(req.GetCursor(), req.GetPageSize(), req.GetSort(), arg)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("cursor", err)})
+	}
+
+	arg.PageParams = page.Params()
+
+	accounts, err := server.store.ListAccountsPage(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
+	}
+
+	accounts, nextCursor := pagination.Cut(page, accounts, func(account db.Account) int64 { return account.ID })
+
+	resp := &pb.ListAccountsResponse{
+		Accounts:   convertAccounts(accounts),
+		NextCursor: nextCursor,
+	}
+
+	return resp, nil
+}
+
+// validateListAccountsRequest checks validations for the ListAccountsRequest
+func validateListAccountsRequest(req *pb.ListAccountsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+// validateListAccountsPageRequest checks validations for the ListAccountsRequest which is paged by cursor
+func validateListAccountsPageRequest(req *pb.ListAccountsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateCursorPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateSort(req.GetSort()); err != nil {
+		violations = append(violations, fieldViolation("sort", err))
+	}
+
+	if req.GetCurrency() != "" {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if req.GetFrom() != nil && req.GetTo() != nil && !req.GetTo().AsTime().After(req.GetFrom().AsTime()) {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	}
+
+	return violations
+}
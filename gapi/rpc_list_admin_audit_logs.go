@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAdminAuditLogs handles gRPC list admin audit logs requests, the latest logs are listed first
+func (server *Server) ListAdminAuditLogs(ctx context.Context, req *pb.ListAdminAuditLogsRequest) (*pb.ListAdminAuditLogsResponse, error) {
+	violations := validateListAdminAuditLogsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	logs, err := server.store.ListAdminAuditLogs(ctx, db.ListAdminAuditLogsParams{
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list admin audit logs: %s", err)
+	}
+
+	resp := &pb.ListAdminAuditLogsResponse{
+		AuditLogs: convertAdminAuditLogs(logs),
+	}
+
+	return resp, nil
+}
+
+// validateListAdminAuditLogsRequest checks validations for the ListAdminAuditLogsRequest
+func validateListAdminAuditLogsRequest(req *pb.ListAdminAuditLogsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListBillSplits handles gRPC list bill splits requests, only the bill splits which the authenticated user
+// initiated are listed
+func (server *Server) ListBillSplits(ctx context.Context, req *pb.ListBillSplitsRequest) (*pb.ListBillSplitsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListBillSplitsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListBillSplitsPageParams{
+		Initiator: authPayload.Username,
+	}
+
+	page, err := pagination.New(req.GetCursor(), req.GetPageSize(), req.GetSort(), arg)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("cursor", err)})
+	}
+
+	arg.PageParams = page.Params()
+
+	splits, err := server.store.ListBillSplitsPage(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bill splits: %s", err)
+	}
+
+	splits, nextCursor := pagination.Cut(page, splits, func(billSplit db.BillSplit) int64 { return billSplit.ID })
+
+	resp := &pb.ListBillSplitsResponse{
+		BillSplits: convertBillSplits(splits),
+		NextCursor: nextCursor,
+	}
+
+	return resp, nil
+}
+
+// validateListBillSplitsRequest checks validations for the ListBillSplitsRequest
+func validateListBillSplitsRequest(req *pb.ListBillSplitsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateCursorPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateSort(req.GetSort()); err != nil {
+		violations = append(violations, fieldViolation("sort", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,174 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListEntries handles gRPC list entries requests
+func (server *Server) ListEntries(ctx context.Context, req *pb.ListEntriesRequest) (*pb.ListEntriesResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// requests without a page ID are paged by cursor
+	if req.GetPageId() == 0 {
+		return server.listEntriesPage(ctx, req, authPayload)
+	}
+
+	violations := validateListEntriesRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := server.store.ListEntries(ctx, db.ListEntriesParams{
+		AccountID: req.GetAccountId(),
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	resp := &pb.ListEntriesResponse{
+		Entries: convertEntries(entries),
+	}
+
+	return resp, nil
+}
+
+// listEntriesPage returns a page of the entries of an account which match the filters of the request
+func (server *Server) listEntriesPage(ctx context.Context, req *pb.ListEntriesRequest, authPayload *token.Payload) (*pb.ListEntriesResponse, error) {
+	violations := validateListEntriesPageRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListEntriesPageParams{
+		AccountID:             req.GetAccountId(),
+		Direction:             req.GetDirection(),
+		CounterpartyAccountID: req.GetCounterpartyAccountId(),
+	}
+
+	if req.GetFrom() != nil {
+		arg.From = req.GetFrom().AsTime()
+	}
+
+	if req.GetTo() != nil {
+		arg.To = req.GetTo().AsTime()
+	}
+
+	if req.GetMinAmount() != nil {
+		minAmount := req.GetMinAmount().GetValue()
+		arg.MinAmount = &minAmount
+	}
+
+	if req.GetMaxAmount() != nil {
+		maxAmount := req.GetMaxAmount().GetValue()
+		arg.MaxAmount = &maxAmount
+	}
+
+	page, err := pagination.New(req.GetCursor(), req.GetPageSize(), req.GetSort(), arg)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("cursor", err)})
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg.PageParams = page.Params()
+
+	entries, err := server.store.ListEntriesPage(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	entries, nextCursor := pagination.Cut(page, entries, func(entry db.Entry) int64 { return entry.ID })
+
+	resp := &pb.ListEntriesResponse{
+		Entries:    convertEntries(entries),
+		NextCursor: nextCursor,
+	}
+
+	return resp, nil
+}
+
+// validateListEntriesRequest checks validations for the ListEntriesRequest
+func validateListEntriesRequest(req *pb.ListEntriesRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+// validateListEntriesPageRequest checks validations for the ListEntriesRequest which is paged by cursor
+func validateListEntriesPageRequest(req *pb.ListEntriesRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateCursorPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateSort(req.GetSort()); err != nil {
+		violations = append(violations, fieldViolation("sort", err))
+	}
+
+	if err := val.ValidateEntryDirection(req.GetDirection()); err != nil {
+		violations = append(violations, fieldViolation("direction", err))
+	}
+
+	if req.GetCounterpartyAccountId() != 0 {
+		if err := val.ValidateID(req.GetCounterpartyAccountId()); err != nil {
+			violations = append(violations, fieldViolation("counterparty_account_id", err))
+		}
+	}
+
+	if req.GetFrom() != nil && req.GetTo() != nil && !req.GetTo().AsTime().After(req.GetFrom().AsTime()) {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	}
+
+	return violations
+}
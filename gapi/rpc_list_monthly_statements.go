@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListMonthlyStatements handles gRPC list monthly statements requests, the PDFs aren't listed
+func (server *Server) ListMonthlyStatements(ctx context.Context, req *pb.ListMonthlyStatementsRequest) (*pb.ListMonthlyStatementsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListMonthlyStatementsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// here we check the authenticated user and account ID is associated or not
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := server.store.ListStatements(ctx, db.ListStatementsParams{
+		AccountID: req.GetAccountId(),
+		Limit:     req.GetPageSize(),
+		Offset:    (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list statements: %s", err)
+	}
+
+	resp := &pb.ListMonthlyStatementsResponse{
+		Statements: convertMonthlyStatements(statements),
+	}
+
+	return resp, nil
+}
+
+// validateListMonthlyStatementsRequest checks validations for the ListMonthlyStatementsRequest
+func validateListMonthlyStatementsRequest(req *pb.ListMonthlyStatementsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
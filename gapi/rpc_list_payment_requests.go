@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPaymentRequests handles gRPC list payment requests requests, only the requests which the authenticated user
+// sent or must pay are listed
+func (server *Server) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListPaymentRequestsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListPaymentRequestsPageParams{
+		Username:  authPayload.Username,
+		Direction: req.GetDirection(),
+		Status:    req.GetStatus(),
+	}
+
+	page, err := pagination.New(req.GetCursor(), req.GetPageSize(), req.GetSort(), arg)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("cursor", err)})
+	}
+
+	arg.PageParams = page.Params()
+
+	requests, err := server.store.ListPaymentRequestsPage(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment requests: %s", err)
+	}
+
+	requests, nextCursor := pagination.Cut(page, requests, func(request db.PaymentRequest) int64 { return request.ID })
+
+	resp := &pb.ListPaymentRequestsResponse{
+		PaymentRequests: convertPaymentRequests(requests),
+		NextCursor:      nextCursor,
+	}
+
+	return resp, nil
+}
+
+// validateListPaymentRequestsRequest checks validations for the ListPaymentRequestsRequest
+func validateListPaymentRequestsRequest(req *pb.ListPaymentRequestsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateCursorPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateSort(req.GetSort()); err != nil {
+		violations = append(violations, fieldViolation("sort", err))
+	}
+
+	if err := val.ValidateTransferDirection(req.GetDirection()); err != nil {
+		violations = append(violations, fieldViolation("direction", err))
+	}
+
+	if err := val.ValidatePaymentRequestStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListScheduledTransferExecutions handles gRPC list scheduled transfer executions requests
+func (server *Server) ListScheduledTransferExecutions(ctx context.Context, req *pb.ListScheduledTransferExecutionsRequest) (*pb.ListScheduledTransferExecutionsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListScheduledTransferExecutionsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.authorizeScheduledTransfer(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	executions, err := server.store.ListScheduledTransferExecutions(ctx, db.ListScheduledTransferExecutionsParams{
+		ScheduledTransferID: req.GetId(),
+		Limit:               req.GetPageSize(),
+		Offset:              (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfer executions: %s", err)
+	}
+
+	resp := &pb.ListScheduledTransferExecutionsResponse{
+		Executions: convertScheduledTransferExecutions(executions),
+	}
+
+	return resp, nil
+}
+
+// validateListScheduledTransferExecutionsRequest checks validations for the ListScheduledTransferExecutionsRequest
+func validateListScheduledTransferExecutionsRequest(req *pb.ListScheduledTransferExecutionsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
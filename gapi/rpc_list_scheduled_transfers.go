@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListScheduledTransfers handles gRPC list scheduled transfers requests
+func (server *Server) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListScheduledTransfersRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduledTransfers, err := server.store.ListScheduledTransfers(ctx, db.ListScheduledTransfersParams{
+		Owner:  authPayload.Username,
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled transfers: %s", err)
+	}
+
+	resp := &pb.ListScheduledTransfersResponse{
+		ScheduledTransfers: convertScheduledTransfers(scheduledTransfers),
+	}
+
+	return resp, nil
+}
+
+// validateListScheduledTransfersRequest checks validations for the ListScheduledTransfersRequest
+func validateListScheduledTransfersRequest(req *pb.ListScheduledTransfersRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,126 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListTransfers handles gRPC list transfers requests
+func (server *Server) ListTransfers(ctx context.Context, req *pb.ListTransfersRequest) (*pb.ListTransfersResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateListTransfersRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListTransfersPageParams{
+		AccountID:             req.GetAccountId(),
+		Direction:             req.GetDirection(),
+		CounterpartyAccountID: req.GetCounterpartyAccountId(),
+	}
+
+	if req.GetFrom() != nil {
+		arg.From = req.GetFrom().AsTime()
+	}
+
+	if req.GetTo() != nil {
+		arg.To = req.GetTo().AsTime()
+	}
+
+	if req.GetMinAmount() != nil {
+		minAmount := req.GetMinAmount().GetValue()
+		arg.MinAmount = &minAmount
+	}
+
+	if req.GetMaxAmount() != nil {
+		maxAmount := req.GetMaxAmount().GetValue()
+		arg.MaxAmount = &maxAmount
+	}
+
+	page, err := pagination.New(req.GetCursor(), req.GetPageSize(), req.GetSort(), arg)
+
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("cursor", err)})
+	}
+
+	// here we check the authenticated user and account ID is associated or not, admins can list every transfer
+	_, err = server.authorizeAccount(ctx, req.GetAccountId(), authPayload, policy.ReadAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg.PageParams = page.Params()
+
+	transfers, err := server.store.ListTransfersPage(ctx, arg)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	transfers, nextCursor := pagination.Cut(page, transfers, func(transfer db.Transfer) int64 { return transfer.ID })
+
+	details, err := server.store.TransferDetails(ctx, db.TransferDetailsParams{
+		Transfers: transfers,
+		AccountID: req.GetAccountId(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transfer details: %s", err)
+	}
+
+	resp := &pb.ListTransfersResponse{
+		Transfers:  convertTransferDetails(details),
+		NextCursor: nextCursor,
+	}
+
+	return resp, nil
+}
+
+// validateListTransfersRequest checks validations for the ListTransfersRequest
+func validateListTransfersRequest(req *pb.ListTransfersRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateCursorPageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if err := val.ValidateSort(req.GetSort()); err != nil {
+		violations = append(violations, fieldViolation("sort", err))
+	}
+
+	if err := val.ValidateTransferDirection(req.GetDirection()); err != nil {
+		violations = append(violations, fieldViolation("direction", err))
+	}
+
+	if req.GetCounterpartyAccountId() != 0 {
+		if err := val.ValidateID(req.GetCounterpartyAccountId()); err != nil {
+			violations = append(violations, fieldViolation("counterparty_account_id", err))
+		}
+	}
+
+	if req.GetFrom() != nil && req.GetTo() != nil && !req.GetTo().AsTime().After(req.GetFrom().AsTime()) {
+		violations = append(violations, fieldViolation("to", fmt.Errorf("must be after from")))
+	}
+
+	return violations
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListUserEntries handles gRPC list user entries requests of admins, entries of every account of the user are listed
+func (server *Server) ListUserEntries(ctx context.Context, req *pb.ListUserEntriesRequest) (*pb.ListUserEntriesResponse, error) {
+	violations := validateListUserEntriesRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	entries, err := server.store.ListEntriesByOwner(ctx, db.ListEntriesByOwnerParams{
+		Owner:  req.GetUsername(),
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	if err := server.auditAdminAction(ctx, db.AdminActionListUserEntries, db.AuditTargetUser, req.GetUsername()); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListUserEntriesResponse{
+		Entries: convertEntries(entries),
+	}
+
+	return resp, nil
+}
+
+// validateListUserEntriesRequest checks validations for the ListUserEntriesRequest
+func validateListUserEntriesRequest(req *pb.ListUserEntriesRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LogoutUser handles gRPC logout user requests, it blocks the session of the refresh token
+func (server *Server) LogoutUser(ctx context.Context, req *pb.LogoutUserRequest) (*pb.LogoutUserResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateLogoutUserRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, err := server.authorizeCurrentSession(ctx, req.GetRefreshToken(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	session, err = server.store.BlockSession(ctx, session.ID)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to logout user: %s", err)
+	}
+
+	resp := &pb.LogoutUserResponse{
+		Session: convertSession(session),
+	}
+
+	return resp, nil
+}
+
+// validateLogoutUserRequest checks validations for the LogoutUserRequest
+func validateLogoutUserRequest(req *pb.LogoutUserRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetRefreshToken() == "" {
+		violations = append(violations, fieldViolation("refresh_token", fmt.Errorf("must be set")))
+	}
+
+	return violations
+}
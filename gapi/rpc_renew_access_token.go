@@ -0,0 +1,117 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RenewAccessToken handles gRPC renew access token requests, the refresh token is rotated on every renewal
+// and presenting a rotated refresh token again blocks every session which is rotated from the same login
+func (server *Server) RenewAccessToken(ctx context.Context, req *pb.RenewAccessTokenRequest) (*pb.RenewAccessTokenResponse, error) {
+	violations := validateRenewAccessTokenRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	refreshPayload, err := server.tokenMaker.VerifyToken(req.GetRefreshToken())
+
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token: %s", err)
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if session.IsBlocked {
+		return nil, status.Errorf(codes.Unauthenticated, "%s", ErrSessionBlocked)
+	}
+
+	if session.Username != refreshPayload.Username {
+		return nil, status.Errorf(codes.Unauthenticated, "%s", ErrSessionUserIsInvalid)
+	}
+
+	if session.RefreshToken != req.GetRefreshToken() {
+		return nil, status.Errorf(codes.Unauthenticated, "%s", ErrInvalidSessionToken)
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "%s", ErrExpiredSession)
+	}
+
+	// role is read again, so the new tokens carry the current role of the user
+	user, err := server.store.GetUser(ctx, session.Username)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %s", err)
+	}
+
+	accessToken, accessPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.AccessTokenDuration)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create access token: %s", err)
+	}
+
+	// and a new refresh token which replaces the presented one
+	refreshToken, newRefreshPayload, err := server.tokenMaker.CreateToken(user.Username, user.Role, server.config.RefreshTokenDuration)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create refresh token: %s", err)
+	}
+
+	mtdt := server.extractMetadata(ctx)
+
+	result, err := server.store.RotateSessionTx(ctx, db.RotateSessionTxParams{
+		SessionID: session.ID,
+		NewSession: db.CreateSessionParams{
+			ID:           newRefreshPayload.ID,
+			RefreshToken: refreshToken,
+			UserAgent:    mtdt.UserAgent,
+			ClientIp:     mtdt.ClientIP,
+			ExpiresAt:    newRefreshPayload.ExpiredAt,
+		},
+	})
+
+	if err != nil {
+		if err == db.ErrRefreshTokenReused || err == db.ErrSessionBlocked {
+			return nil, status.Errorf(codes.Unauthenticated, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to rotate session: %s", err)
+	}
+
+	resp := &pb.RenewAccessTokenResponse{
+		SessionId:             result.Session.ID.String(),
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  timestamppb.New(accessPayload.ExpiredAt),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(newRefreshPayload.ExpiredAt),
+	}
+
+	return resp, nil
+}
+
+// validateRenewAccessTokenRequest checks validations for the RenewAccessTokenRequest
+func validateRenewAccessTokenRequest(req *pb.RenewAccessTokenRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetRefreshToken() == "" {
+		violations = append(violations, fieldViolation("refresh_token", fmt.Errorf("must be set")))
+	}
+
+	return violations
+}
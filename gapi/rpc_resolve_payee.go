@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResolvePayee handles gRPC resolve payee requests, the recipient is masked so users can confirm it before they send money
+func (server *Server) ResolvePayee(ctx context.Context, req *pb.ResolvePayeeRequest) (*pb.ResolvePayeeResponse, error) {
+	if _, err := authPayloadFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	violations := validateResolvePayeeRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payee, err := server.resolvePayee(ctx, req.GetRecipient(), req.GetCurrency())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ResolvePayeeResponse{
+		Recipient: req.GetRecipient(),
+		FullName:  util.MaskName(payee.FullName),
+		Email:     util.MaskEmail(payee.Email),
+		Currency:  payee.Account.Currency,
+	}
+
+	return resp, nil
+}
+
+// resolvePayee finds the account of the recipient in the currency
+func (server *Server) resolvePayee(ctx context.Context, recipient, currency string) (db.Payee, error) {
+	payee, err := server.store.ResolvePayee(ctx, recipient, currency)
+
+	if err != nil {
+		switch err {
+		case db.ErrRecipientNotFound:
+			return payee, status.Errorf(codes.NotFound, "%s", err)
+		case db.ErrRecipientHasNoAccount:
+			return payee, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return payee, status.Errorf(codes.Internal, "failed to resolve payee: %s", err)
+	}
+
+	return payee, nil
+}
+
+// validateResolvePayeeRequest checks validations for the ResolvePayeeRequest
+func validateResolvePayeeRequest(req *pb.ResolvePayeeRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateRecipient(req.GetRecipient()); err != nil {
+		violations = append(violations, fieldViolation("recipient", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	return violations
+}
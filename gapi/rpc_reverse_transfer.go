@@ -0,0 +1,99 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReverseTransfer handles gRPC reverse transfer requests, only the recipient of a transfer can refund it
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateReverseTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	idempotency, err := idempotencyParams(ctx, authPayload.Username)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "transfer not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	// here we check if the to account belongs to the authenticated user
+	toAccount, err := server.authorizeAccount(ctx, transfer.ToAccountID, authPayload, policy.ReverseTransfer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.ReverseTransferTxParams{
+		TransferID:    req.GetTransferId(),
+		Amount:        req.GetAmount(),
+		AdminUsername: adminActor(authPayload, toAccount.Owner),
+		Idempotency:   idempotency,
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, arg)
+
+	if err != nil {
+		switch err {
+		case db.ErrTransferAlreadyReversed, db.ErrRefundExceedsTransfer, db.ErrReversalNotReversible,
+			db.ErrFxTransferNotReversible, db.ErrInsufficientFunds, db.ErrIdempotencyKeyMismatch,
+			db.ErrAccountFrozen, db.ErrAccountClosed:
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reverse transfer: %s", err)
+	}
+
+	rsp := &pb.ReverseTransferResponse{
+		OriginalTransfer: convertTransfer(result.OriginalTransfer),
+		Transfer:         convertTransfer(result.Transfer),
+		FromAccount:      convertAccount(result.FromAccount),
+		ToAccount:        convertAccount(result.ToAccount),
+		FromEntry:        convertEntry(result.FromEntry),
+		ToEntry:          convertEntry(result.ToEntry),
+	}
+
+	return rsp, nil
+}
+
+// validateReverseTransferRequest checks validations for the ReverseTransferRequest, zero amount reverses the rest
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	if req.GetAmount() != 0 {
+		if err := val.ValidateTransferAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
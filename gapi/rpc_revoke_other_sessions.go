@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeOtherSessions handles gRPC revoke other sessions requests, every session of the user
+// except the one of the refresh token is blocked
+func (server *Server) RevokeOtherSessions(ctx context.Context, req *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeOtherSessionsRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, err := server.authorizeCurrentSession(ctx, req.GetRefreshToken(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := server.store.BlockOtherSessions(ctx, db.BlockOtherSessionsParams{
+		Username: session.Username,
+		ID:       session.ID,
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %s", err)
+	}
+
+	resp := &pb.RevokeOtherSessionsResponse{
+		RevokedSessions: revoked,
+	}
+
+	return resp, nil
+}
+
+// validateRevokeOtherSessionsRequest checks validations for the RevokeOtherSessionsRequest
+func validateRevokeOtherSessionsRequest(req *pb.RevokeOtherSessionsRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if req.GetRefreshToken() == "" {
+		violations = append(violations, fieldViolation("refresh_token", fmt.Errorf("must be set")))
+	}
+
+	return violations
+}
@@ -0,0 +1,67 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeSession handles gRPC revoke session requests, refresh token of a revoked session can't renew access tokens anymore
+func (server *Server) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateRevokeSessionRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	id := uuid.MustParse(req.GetId())
+
+	session, err := server.store.GetSession(ctx, id)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if !policy.Can(authPayload, policy.ManageSession, session.Username) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s", ErrSessionIsNotAuthenticatedUsers)
+	}
+
+	session, err = server.store.BlockSession(ctx, id)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %s", err)
+	}
+
+	resp := &pb.RevokeSessionResponse{
+		Session: convertSession(session),
+	}
+
+	return resp, nil
+}
+
+// validateRevokeSessionRequest checks validations for the RevokeSessionRequest
+func validateRevokeSessionRequest(req *pb.RevokeSessionRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateUUID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
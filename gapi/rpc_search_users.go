@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchUsers handles gRPC search users requests of admins, users whose email contains the given email are listed
+func (server *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	violations := validateSearchUsersRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	users, err := server.store.SearchUsers(ctx, db.SearchUsersParams{
+		Email:  req.GetEmail(),
+		Limit:  req.GetPageSize(),
+		Offset: (req.GetPageId() - 1) * req.GetPageSize(),
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %s", err)
+	}
+
+	if err := server.auditAdminAction(ctx, db.AdminActionSearchUsers, db.AuditTargetUser, req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchUsersResponse{
+		Users: make([]*pb.User, len(users)),
+	}
+
+	for i, user := range users {
+		resp.Users[i] = convertUser(user)
+	}
+
+	return resp, nil
+}
+
+// validateSearchUsersRequest checks validations for the SearchUsersRequest
+func validateSearchUsersRequest(req *pb.SearchUsersRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateString(req.GetEmail(), 1, 200); err != nil {
+		violations = append(violations, fieldViolation("email", err))
+	}
+
+	if err := val.ValidatePageID(req.GetPageId()); err != nil {
+		violations = append(violations, fieldViolation("page_id", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetOverdraftLimit handles gRPC set overdraft limit requests of admins, the reason is written to the audit log
+func (server *Server) SetOverdraftLimit(ctx context.Context, req *pb.SetOverdraftLimitRequest) (*pb.SetOverdraftLimitResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateSetOverdraftLimitRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.SetOverdraftLimitTx(ctx, db.SetOverdraftLimitTxParams{
+		AdminUsername:  authPayload.Username,
+		AccountID:      req.GetAccountId(),
+		OverdraftLimit: req.GetOverdraftLimit(),
+		Reason:         req.GetReason(),
+		Actor:          server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "account not found: %s", err)
+		}
+		if err == db.ErrAccountClosed || err == db.ErrSystemAccount {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set overdraft limit: %s", err)
+	}
+
+	resp := &pb.SetOverdraftLimitResponse{
+		Account:  convertAccount(result.Account),
+		AuditLog: convertAdminAuditLog(result.AuditLog),
+	}
+
+	return resp, nil
+}
+
+// validateSetOverdraftLimitRequest checks validations for the SetOverdraftLimitRequest
+func validateSetOverdraftLimitRequest(req *pb.SetOverdraftLimitRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetOverdraftLimit() < 0 {
+		violations = append(violations, fieldViolation("overdraft_limit", fmt.Errorf("must not be negative")))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
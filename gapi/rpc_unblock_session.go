@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnblockSession handles gRPC unblock session requests of admins, the reason is written to the audit log
+func (server *Server) UnblockSession(ctx context.Context, req *pb.UnblockSessionRequest) (*pb.UnblockSessionResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUnblockSessionRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	id := uuid.MustParse(req.GetId())
+
+	if _, err := server.store.GetSession(ctx, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "session not found: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	result, err := server.store.UnblockSessionTx(ctx, db.UnblockSessionTxParams{
+		AdminUsername: authPayload.Username,
+		SessionID:     id,
+		Reason:        req.GetReason(),
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		// only blocked sessions are unblocked
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", ErrSessionNotBlocked)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unblock session: %s", err)
+	}
+
+	resp := &pb.UnblockSessionResponse{
+		Session:  convertSession(result.Session),
+		AuditLog: convertAdminAuditLog(result.AuditLog),
+	}
+
+	return resp, nil
+}
+
+// validateUnblockSessionRequest checks validations for the UnblockSessionRequest
+func validateUnblockSessionRequest(req *pb.UnblockSessionRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateUUID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateString(req.GetReason(), 1, 200); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
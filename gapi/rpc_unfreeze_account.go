@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnfreezeAccount handles gRPC unfreeze account requests, owners can only lift the freezes which they placed
+func (server *Server) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUnfreezeAccountRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.authorizeAccount(ctx, req.GetId(), authPayload, policy.UnfreezeAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// owners can't lift the freezes which admins placed on their accounts
+	if !policy.CanUnfreeze(authPayload, account.Owner, account.FrozenBy) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s", ErrAccountFrozenByOther)
+	}
+
+	account, err = server.store.UnfreezeAccountTx(ctx, db.UnfreezeAccountTxParams{
+		AccountID:     req.GetId(),
+		AdminUsername: adminActor(authPayload, account.Owner),
+		Actor:         server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", ErrAccountNotFrozen)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unfreeze account: %s", err)
+	}
+
+	resp := &pb.UnfreezeAccountResponse{
+		Account: convertAccount(account),
+	}
+
+	return resp, nil
+}
+
+// validateUnfreezeAccountRequest checks validations for the UnfreezeAccountRequest
+func validateUnfreezeAccountRequest(req *pb.UnfreezeAccountRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
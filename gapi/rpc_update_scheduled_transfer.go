@@ -0,0 +1,130 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateScheduledTransfer handles gRPC update scheduled transfer requests
+func (server *Server) UpdateScheduledTransfer(ctx context.Context, req *pb.UpdateScheduledTransferRequest) (*pb.UpdateScheduledTransferResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateScheduledTransferRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	scheduled, err := server.authorizeScheduledTransfer(ctx, req.GetId(), authPayload)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID: req.GetId(),
+	}
+
+	if req.GetAmount() != nil {
+		arg.Amount = sql.NullInt64{Int64: req.GetAmount().GetValue(), Valid: true}
+	}
+
+	if req.GetEndAt() != nil {
+		endAt := req.GetEndAt().AsTime()
+
+		if endAt.Before(scheduled.StartAt) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("end_at", ErrEndBeforeStart)})
+		}
+
+		arg.EndAt = &endAt
+	}
+
+	if req.GetMaxOccurrences() != nil {
+		maxOccurrences := req.GetMaxOccurrences().GetValue()
+		arg.MaxOccurrences = &maxOccurrences
+	}
+
+	if req.GetMaxRetries() != nil {
+		arg.MaxRetries = sql.NullInt32{Int32: req.GetMaxRetries().GetValue(), Valid: true}
+	}
+
+	if req.GetRetryInterval() != nil {
+		arg.RetryInterval = sql.NullInt64{Int64: req.GetRetryInterval().GetValue(), Valid: true}
+	}
+
+	// the retry policy is checked with the fields which aren't updated
+	maxRetries, retryInterval := scheduled.MaxRetries, scheduled.RetryInterval
+
+	if arg.MaxRetries.Valid {
+		maxRetries = arg.MaxRetries.Int32
+	}
+
+	if arg.RetryInterval.Valid {
+		retryInterval = arg.RetryInterval.Int64
+	}
+
+	if err := val.ValidateRetryInterval(retryInterval, maxRetries); err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("retry_interval", err)})
+	}
+
+	scheduled, err = server.store.UpdateScheduledTransfer(ctx, arg)
+
+	if err != nil {
+		// only active scheduled transfers are updated
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", ErrScheduledTransferNotActive)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled transfer: %s", err)
+	}
+
+	resp := &pb.UpdateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+
+	return resp, nil
+}
+
+// validateUpdateScheduledTransferRequest checks validations for the UpdateScheduledTransferRequest
+func validateUpdateScheduledTransferRequest(req *pb.UpdateScheduledTransferRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.GetAmount() != nil {
+		if err := val.ValidateTransferAmount(req.GetAmount().GetValue()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	if req.GetMaxOccurrences() != nil && req.GetMaxOccurrences().GetValue() < 1 {
+		violations = append(violations, fieldViolation("max_occurrences", fmt.Errorf("must be at least 1")))
+	}
+
+	if req.GetMaxRetries() != nil {
+		if err := val.ValidateMaxRetries(req.GetMaxRetries().GetValue()); err != nil {
+			violations = append(violations, fieldViolation("max_retries", err))
+		}
+	}
+
+	if req.GetRetryInterval() != nil {
+		if err := val.ValidateRetryInterval(req.GetRetryInterval().GetValue(), 0); err != nil {
+			violations = append(violations, fieldViolation("retry_interval", err))
+		}
+	}
+
+	return violations
+}
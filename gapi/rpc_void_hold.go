@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pb"
+	"github.com/burakkarasel/Bank-App/policy"
+	"github.com/burakkarasel/Bank-App/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VoidHold handles gRPC void hold requests, the owner of the account which the hold is for or an admin can void it
+func (server *Server) VoidHold(ctx context.Context, req *pb.VoidHoldRequest) (*pb.VoidHoldResponse, error) {
+	authPayload, err := authPayloadFromContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateVoidHoldRequest(req)
+
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if _, err := server.authorizePayeeHold(ctx, req.GetHoldId(), authPayload, policy.VoidHold); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.VoidHoldTx(ctx, db.VoidHoldTxParams{
+		HoldID: req.GetHoldId(),
+		Actor:  server.auditActor(ctx, authPayload.Username),
+	})
+
+	if err != nil {
+		if err == db.ErrHoldNotPending {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to void hold: %s", err)
+	}
+
+	rsp := &pb.VoidHoldResponse{
+		Hold:        convertHold(result.Hold),
+		FromAccount: convertAccount(result.FromAccount),
+	}
+
+	return rsp, nil
+}
+
+// validateVoidHoldRequest checks validations for the VoidHoldRequest
+func validateVoidHoldRequest(req *pb.VoidHoldRequest) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+
+	if err := val.ValidateID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	return violations
+}
@@ -5,19 +5,41 @@ import (
 	"fmt"
 
 	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/fx"
 	"github.com/burakkarasel/Bank-App/pb"
 	"github.com/burakkarasel/Bank-App/token"
 	"github.com/burakkarasel/Bank-App/util"
 )
 
 var ErrAccountIsNotAuthenticatedUsers = errors.New("account doesn't belong to authenticated user")
+var ErrTransferIsNotAuthenticatedUsers = errors.New("transfer doesn't belong to authenticated user")
+var ErrHoldIsNotAuthenticatedUsers = errors.New("hold doesn't belong to authenticated user")
+var ErrPaymentRequestIsNotAuthenticatedUsers = errors.New("payment request doesn't belong to authenticated user")
+var ErrBillSplitIsNotAuthenticatedUsers = errors.New("bill split doesn't belong to authenticated user")
+var ErrFxQuoteIsNotAuthenticatedUsers = errors.New("fx quote doesn't belong to authenticated user")
+var ErrSameCurrencyQuote = errors.New("fx quote currencies must be different")
+var ErrScheduledTransferIsNotAuthenticatedUsers = errors.New("scheduled transfer doesn't belong to authenticated user")
+var ErrScheduledTransferNotActive = errors.New("scheduled transfer is not active")
+var ErrEndBeforeStart = errors.New("end_at must be after start_at")
+var ErrAccountNotActive = errors.New("account is not active")
+var ErrAccountNotFrozen = errors.New("account is not frozen")
+var ErrAccountFrozenByOther = errors.New("account is frozen by another user and can't be unfrozen by its owner")
+var ErrSessionIsNotAuthenticatedUsers = errors.New("session doesn't belong to authenticated user")
+var ErrSessionBlocked = errors.New("blocked session")
+var ErrSessionUserIsInvalid = errors.New("incorrect session user")
+var ErrInvalidSessionToken = errors.New("mismatched session token")
+var ErrExpiredSession = errors.New("expired session")
+var ErrAdminRoleRequired = errors.New("admin role is required")
+var ErrSessionNotBlocked = errors.New("session is not blocked")
 
 // Server serves all HTTP request for banking services
 type Server struct {
 	pb.UnimplementedBankAppServer
-	config     util.Config
-	store      db.Store // which we will hold the db, and queries
-	tokenMaker token.Maker
+	pb.UnimplementedAdminServiceServer
+	config       util.Config
+	store        db.Store // which we will hold the db, and queries
+	tokenMaker   token.Maker
+	rateProvider fx.RateProvider
 }
 
 // NewServer creates a new Server which will hold our config and DB
@@ -28,9 +50,10 @@ func NewServer(config util.Config, store db.Store) (*Server, error) {
 		return nil, fmt.Errorf("cannot create token maker: %w", err)
 	}
 	server := &Server{
-		config:     config,
-		store:      store,
-		tokenMaker: tokenMaker,
+		config:       config,
+		store:        store,
+		tokenMaker:   tokenMaker,
+		rateProvider: fx.NewLocalRateProvider(store),
 	}
 
 	return server, nil
@@ -0,0 +1,68 @@
+package hold
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// Sweeper expires the pending holds which are not captured or voided before their TTL,
+// so their money becomes available again
+type Sweeper struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewSweeper creates a new Sweeper which checks for the expired holds every interval
+func NewSweeper(store db.Store, interval time.Duration) *Sweeper {
+	return &Sweeper{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run expires the expired holds every interval until ctx is done
+func (sweeper *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(sweeper.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := sweeper.SweepExpired(ctx); err != nil {
+			// holds stay pending, so they are picked again at the next tick
+			log.Println("cannot expire holds:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SweepExpired expires the holds which are expired until there is none left or ctx is done,
+// it returns how many holds are expired
+func (sweeper *Sweeper) SweepExpired(ctx context.Context) (int, error) {
+	count := 0
+
+	for ctx.Err() == nil {
+		// an expiry isn't cancelled halfway when we shut down, the DB waits for it to finish
+		_, err := sweeper.store.ExpireHoldTx(context.Background(), sweeper.now())
+
+		if err != nil {
+			if errors.Is(err, db.ErrNoHoldExpired) {
+				return count, nil
+			}
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
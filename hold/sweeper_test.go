@@ -0,0 +1,77 @@
+package hold
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSweepExpired tests that the sweeper expires the expired holds until there is none left
+func TestSweepExpired(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		expectedCount int
+		expectedErr   bool
+	}{
+		{
+			name: "nothing expired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.ReleaseHoldTxResult{}, db.ErrNoHoldExpired)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "expired holds",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(now)).Times(2).
+						Return(db.ReleaseHoldTxResult{Hold: db.Hold{Status: db.HoldExpired}}, nil),
+					store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(now)).Times(1).
+						Return(db.ReleaseHoldTxResult{}, db.ErrNoHoldExpired),
+				)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "DB error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.ReleaseHoldTxResult{}, errors.New("connection refused"))
+			},
+			expectedCount: 0,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			sweeper := NewSweeper(store, time.Minute)
+			sweeper.now = func() time.Time { return now }
+
+			count, err := sweeper.SweepExpired(context.Background())
+			require.Equal(t, tc.expectedCount, count)
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package pagination
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// sort orders of the pages
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// sizes of the pages when the size isn't given and at most
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+var ErrUnsupportedSort = errors.New("unsupported sort order")
+var ErrCursorMismatch = errors.New("cursor doesn't match the sort order or the filters of the request")
+
+// cursor is the position of the next page, it is encoded as an opaque string for the clients.
+// Filter is the fingerprint of the filters of the first page, so a cursor can't be used with other filters
+type cursor struct {
+	AfterID int64  `json:"after_id"`
+	Sort    string `json:"sort"`
+	Filter  string `json:"filter"`
+}
+
+// Page is the position and the size of a page of a list
+type Page struct {
+	AfterID    int64
+	Descending bool
+	Size       int32
+	sort       string
+	filter     string
+}
+
+// New returns the page which starts after the cursor, or the first page if the cursor is empty.
+// filter holds the filters of the list and must be the same for every page
+func New(encoded string, size int32, sort string, filter interface{}) (Page, error) {
+	if size <= 0 {
+		size = DefaultPageSize
+	}
+
+	if sort == "" {
+		sort = SortAsc
+	}
+
+	if !IsSupportedSort(sort) {
+		return Page{}, ErrUnsupportedSort
+	}
+
+	fingerprint, err := filterFingerprint(filter)
+
+	if err != nil {
+		return Page{}, err
+	}
+
+	page := Page{
+		Descending: sort == SortDesc,
+		Size:       size,
+		sort:       sort,
+		filter:     fingerprint,
+	}
+
+	if encoded == "" {
+		return page, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return Page{}, ErrInvalidCursor
+	}
+
+	var c cursor
+
+	if err := json.Unmarshal(data, &c); err != nil || c.AfterID <= 0 {
+		return Page{}, ErrInvalidCursor
+	}
+
+	if c.Sort != page.sort || c.Filter != page.filter {
+		return Page{}, ErrCursorMismatch
+	}
+
+	page.AfterID = c.AfterID
+
+	return page, nil
+}
+
+// Limit returns how many rows must be read for the page, one more than its size to know if there is a next page
+func (page Page) Limit() int32 {
+	return page.Size + 1
+}
+
+// Params returns the keyset of the page for the list queries
+func (page Page) Params() db.PageParams {
+	return db.PageParams{
+		AfterID:    page.AfterID,
+		Descending: page.Descending,
+		Limit:      page.Limit(),
+	}
+}
+
+// Cut drops the extra row which is read beyond the page and returns the cursor of the next page,
+// the cursor is empty on the last page. Rows are never nil, so an empty page is encoded as an empty list
+func Cut[T any](page Page, rows []T, id func(T) int64) ([]T, string) {
+	if rows == nil {
+		rows = []T{}
+	}
+
+	if len(rows) <= int(page.Size) {
+		return rows, ""
+	}
+
+	rows = rows[:page.Size]
+
+	data, _ := json.Marshal(cursor{
+		AfterID: id(rows[len(rows)-1]),
+		Sort:    page.sort,
+		Filter:  page.filter,
+	})
+
+	return rows, base64.RawURLEncoding.EncodeToString(data)
+}
+
+// IsSupportedSort returns true if the pages can be sorted in the given order
+func IsSupportedSort(sort string) bool {
+	switch sort {
+	case SortAsc, SortDesc:
+		return true
+	}
+	return false
+}
+
+// filterFingerprint returns a short hash of the filters
+func filterFingerprint(filter interface{}) (string, error) {
+	data, err := json.Marshal(filter)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:8]), nil
+}
@@ -0,0 +1,80 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testFilter struct {
+	AccountID int64  `json:"account_id"`
+	Direction string `json:"direction"`
+}
+
+func TestFirstPage(t *testing.T) {
+	page, err := New("", 0, "", testFilter{AccountID: 1})
+	require.NoError(t, err)
+	require.Zero(t, page.AfterID)
+	require.False(t, page.Descending)
+	require.Equal(t, int32(DefaultPageSize), page.Size)
+	require.Equal(t, int32(DefaultPageSize+1), page.Limit())
+
+	page, err = New("", 5, SortDesc, testFilter{AccountID: 1})
+	require.NoError(t, err)
+	require.True(t, page.Descending)
+	require.Equal(t, int32(5), page.Size)
+
+	_, err = New("", 5, "random", testFilter{AccountID: 1})
+	require.ErrorIs(t, err, ErrUnsupportedSort)
+}
+
+func TestCut(t *testing.T) {
+	filter := testFilter{AccountID: 1, Direction: "credit"}
+	ids := func(id int64) int64 { return id }
+
+	page, err := New("", 3, SortDesc, filter)
+	require.NoError(t, err)
+
+	//! the extra row shows there is a next page
+	rows, next := Cut(page, []int64{9, 8, 7, 6}, ids)
+	require.Equal(t, []int64{9, 8, 7}, rows)
+	require.NotEmpty(t, next)
+
+	page, err = New(next, 3, SortDesc, filter)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), page.AfterID)
+	require.True(t, page.Descending)
+
+	//! the last page has no cursor
+	rows, next = Cut(page, []int64{6, 5}, ids)
+	require.Equal(t, []int64{6, 5}, rows)
+	require.Empty(t, next)
+}
+
+func TestCursorMismatch(t *testing.T) {
+	filter := testFilter{AccountID: 1}
+
+	page, err := New("", 1, SortAsc, filter)
+	require.NoError(t, err)
+
+	_, next := Cut(page, []int64{1, 2}, func(id int64) int64 { return id })
+	require.NotEmpty(t, next)
+
+	_, err = New(next, 1, SortDesc, filter)
+	require.ErrorIs(t, err, ErrCursorMismatch)
+
+	_, err = New(next, 1, SortAsc, testFilter{AccountID: 2})
+	require.ErrorIs(t, err, ErrCursorMismatch)
+
+	// the size of the pages can change between pages
+	page, err = New(next, 10, SortAsc, filter)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), page.AfterID)
+}
+
+func TestInvalidCursor(t *testing.T) {
+	for _, encoded := range []string{"not base64!", "bm90IGpzb24", "e30"} {
+		_, err := New(encoded, 5, SortAsc, testFilter{})
+		require.ErrorIs(t, err, ErrInvalidCursor)
+	}
+}
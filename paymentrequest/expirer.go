@@ -0,0 +1,68 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// Expirer expires the pending payment requests which are not answered before their TTL,
+// so they can't be paid anymore
+type Expirer struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewExpirer creates a new Expirer which checks for the expired payment requests every interval
+func NewExpirer(store db.Store, interval time.Duration) *Expirer {
+	return &Expirer{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run expires the expired payment requests every interval until ctx is done
+func (expirer *Expirer) Run(ctx context.Context) {
+	ticker := time.NewTicker(expirer.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := expirer.ExpireDue(ctx); err != nil {
+			// requests stay pending, so they are picked again at the next tick
+			log.Println("cannot expire payment requests:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireDue expires the payment requests which are expired until there is none left or ctx is done,
+// it returns how many payment requests are expired
+func (expirer *Expirer) ExpireDue(ctx context.Context) (int, error) {
+	count := 0
+
+	for ctx.Err() == nil {
+		// an expiry isn't cancelled halfway when we shut down, the DB waits for it to finish
+		_, err := expirer.store.ExpirePaymentRequestTx(context.Background(), expirer.now())
+
+		if err != nil {
+			if errors.Is(err, db.ErrNoPaymentRequestExpired) {
+				return count, nil
+			}
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
@@ -0,0 +1,77 @@
+package paymentrequest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExpireDue tests that the expirer expires the expired payment requests until there is none left
+func TestExpireDue(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		expectedCount int
+		expectedErr   bool
+	}{
+		{
+			name: "nothing expired",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExpirePaymentRequestTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.PaymentRequest{}, db.ErrNoPaymentRequestExpired)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "expired payment requests",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ExpirePaymentRequestTx(gomock.Any(), gomock.Eq(now)).Times(2).
+						Return(db.PaymentRequest{Status: db.PaymentRequestExpired}, nil),
+					store.EXPECT().ExpirePaymentRequestTx(gomock.Any(), gomock.Eq(now)).Times(1).
+						Return(db.PaymentRequest{}, db.ErrNoPaymentRequestExpired),
+				)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "DB error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExpirePaymentRequestTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.PaymentRequest{}, errors.New("connection refused"))
+			},
+			expectedCount: 0,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			expirer := NewExpirer(store, time.Minute)
+			expirer.now = func() time.Time { return now }
+
+			count, err := expirer.ExpireDue(context.Background())
+			require.Equal(t, tc.expectedCount, count)
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_accept_payment_request.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AcceptPaymentRequestRequest holds the ID of the payment request which the payer pays
+type AcceptPaymentRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentRequestId int64 `protobuf:"varint,1,opt,name=payment_request_id,json=paymentRequestId,proto3" json:"payment_request_id,omitempty"`
+}
+
+func (x *AcceptPaymentRequestRequest) Reset() {
+	*x = AcceptPaymentRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_accept_payment_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestRequest) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_payment_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptPaymentRequestRequest) GetPaymentRequestId() int64 {
+	if x != nil {
+		return x.PaymentRequestId
+	}
+	return 0
+}
+
+// AcceptPaymentRequestResponse holds the accepted payment request and the transfer which paid it
+type AcceptPaymentRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentRequest *PaymentRequest `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	Transfer       *Transfer       `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	FromAccount    *Account        `protobuf:"bytes,3,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	ToAccount      *Account        `protobuf:"bytes,4,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
+	FromEntry      *Entry          `protobuf:"bytes,5,opt,name=from_entry,json=fromEntry,proto3" json:"from_entry,omitempty"`
+	ToEntry        *Entry          `protobuf:"bytes,6,opt,name=to_entry,json=toEntry,proto3" json:"to_entry,omitempty"`
+}
+
+func (x *AcceptPaymentRequestResponse) Reset() {
+	*x = AcceptPaymentRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_accept_payment_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptPaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestResponse) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_payment_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptPaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFromAccount() *Account {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetToAccount() *Account {
+	if x != nil {
+		return x.ToAccount
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFromEntry() *Entry {
+	if x != nil {
+		return x.FromEntry
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetToEntry() *Entry {
+	if x != nil {
+		return x.ToEntry
+	}
+	return nil
+}
+
+var File_rpc_accept_payment_request_proto protoreflect.FileDescriptor
+
+var file_rpc_accept_payment_request_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x1b, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0xb1, 0x02, 0x0a, 0x1c, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x09, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0a, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61,
+	0x72, 0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_accept_payment_request_proto_rawDescOnce sync.Once
+	file_rpc_accept_payment_request_proto_rawDescData = file_rpc_accept_payment_request_proto_rawDesc
+)
+
+func file_rpc_accept_payment_request_proto_rawDescGZIP() []byte {
+	file_rpc_accept_payment_request_proto_rawDescOnce.Do(func() {
+		file_rpc_accept_payment_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_accept_payment_request_proto_rawDescData)
+	})
+	return file_rpc_accept_payment_request_proto_rawDescData
+}
+
+var file_rpc_accept_payment_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_accept_payment_request_proto_goTypes = []interface{}{
+	(*AcceptPaymentRequestRequest)(nil),  // 0: pb.AcceptPaymentRequestRequest
+	(*AcceptPaymentRequestResponse)(nil), // 1: pb.AcceptPaymentRequestResponse
+	(*PaymentRequest)(nil),               // 2: pb.PaymentRequest
+	(*Transfer)(nil),                     // 3: pb.Transfer
+	(*Account)(nil),                      // 4: pb.Account
+	(*Entry)(nil),                        // 5: pb.Entry
+}
+var file_rpc_accept_payment_request_proto_depIdxs = []int32{
+	2, // 0: pb.AcceptPaymentRequestResponse.payment_request:type_name -> pb.PaymentRequest
+	3, // 1: pb.AcceptPaymentRequestResponse.transfer:type_name -> pb.Transfer
+	4, // 2: pb.AcceptPaymentRequestResponse.from_account:type_name -> pb.Account
+	4, // 3: pb.AcceptPaymentRequestResponse.to_account:type_name -> pb.Account
+	5, // 4: pb.AcceptPaymentRequestResponse.from_entry:type_name -> pb.Entry
+	5, // 5: pb.AcceptPaymentRequestResponse.to_entry:type_name -> pb.Entry
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_accept_payment_request_proto_init() }
+func file_rpc_accept_payment_request_proto_init() {
+	if File_rpc_accept_payment_request_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_entry_proto_init()
+	file_payment_request_proto_init()
+	file_transfer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_accept_payment_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPaymentRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_accept_payment_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptPaymentRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_accept_payment_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_accept_payment_request_proto_goTypes,
+		DependencyIndexes: file_rpc_accept_payment_request_proto_depIdxs,
+		MessageInfos:      file_rpc_accept_payment_request_proto_msgTypes,
+	}.Build()
+	File_rpc_accept_payment_request_proto = out.File
+	file_rpc_accept_payment_request_proto_rawDesc = nil
+	file_rpc_accept_payment_request_proto_goTypes = nil
+	file_rpc_accept_payment_request_proto_depIdxs = nil
+}
@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_create_bill_split.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateBillSplitRequest holds the values for the request, the user may be one of the participants but isn't asked
+// for its own share. expires_in is in seconds and zero is the default TTL of the payment requests
+type CreateBillSplitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalAmount  int64                   `protobuf:"varint,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Currency     string                  `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Memo         string                  `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	Rule         string                  `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	Participants []*BillSplitParticipant `protobuf:"bytes,5,rep,name=participants,proto3" json:"participants,omitempty"`
+	ExpiresIn    int64                   `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+}
+
+func (x *CreateBillSplitRequest) Reset() {
+	*x = CreateBillSplitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_bill_split_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBillSplitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBillSplitRequest) ProtoMessage() {}
+
+func (x *CreateBillSplitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_bill_split_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBillSplitRequest.ProtoReflect.Descriptor instead.
+func (*CreateBillSplitRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_bill_split_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateBillSplitRequest) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *CreateBillSplitRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateBillSplitRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateBillSplitRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *CreateBillSplitRequest) GetParticipants() []*BillSplitParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *CreateBillSplitRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// CreateBillSplitResponse holds the new bill split with the payment requests of its shares
+type CreateBillSplitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillSplit       *BillSplit         `protobuf:"bytes,1,opt,name=bill_split,json=billSplit,proto3" json:"bill_split,omitempty"`
+	PaymentRequests []*PaymentRequest  `protobuf:"bytes,2,rep,name=payment_requests,json=paymentRequests,proto3" json:"payment_requests,omitempty"`
+	Progress        *BillSplitProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *CreateBillSplitResponse) Reset() {
+	*x = CreateBillSplitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_bill_split_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBillSplitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBillSplitResponse) ProtoMessage() {}
+
+func (x *CreateBillSplitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_bill_split_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBillSplitResponse.ProtoReflect.Descriptor instead.
+func (*CreateBillSplitResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_bill_split_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBillSplitResponse) GetBillSplit() *BillSplit {
+	if x != nil {
+		return x.BillSplit
+	}
+	return nil
+}
+
+func (x *CreateBillSplitResponse) GetPaymentRequests() []*PaymentRequest {
+	if x != nil {
+		return x.PaymentRequests
+	}
+	return nil
+}
+
+func (x *CreateBillSplitResponse) GetProgress() *BillSplitProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+var File_rpc_create_bill_split_proto protoreflect.FileDescriptor
+
+var file_rpc_create_bill_split_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x69, 0x6c,
+	0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70,
+	0x62, 0x1a, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x0c,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x0c, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x70,
+	0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42,
+	0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73, 0x65, 0x6c,
+	0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_bill_split_proto_rawDescOnce sync.Once
+	file_rpc_create_bill_split_proto_rawDescData = file_rpc_create_bill_split_proto_rawDesc
+)
+
+func file_rpc_create_bill_split_proto_rawDescGZIP() []byte {
+	file_rpc_create_bill_split_proto_rawDescOnce.Do(func() {
+		file_rpc_create_bill_split_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_bill_split_proto_rawDescData)
+	})
+	return file_rpc_create_bill_split_proto_rawDescData
+}
+
+var file_rpc_create_bill_split_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_bill_split_proto_goTypes = []interface{}{
+	(*CreateBillSplitRequest)(nil),  // 0: pb.CreateBillSplitRequest
+	(*CreateBillSplitResponse)(nil), // 1: pb.CreateBillSplitResponse
+	(*BillSplitParticipant)(nil),    // 2: pb.BillSplitParticipant
+	(*BillSplit)(nil),               // 3: pb.BillSplit
+	(*PaymentRequest)(nil),          // 4: pb.PaymentRequest
+	(*BillSplitProgress)(nil),       // 5: pb.BillSplitProgress
+}
+var file_rpc_create_bill_split_proto_depIdxs = []int32{
+	2, // 0: pb.CreateBillSplitRequest.participants:type_name -> pb.BillSplitParticipant
+	3, // 1: pb.CreateBillSplitResponse.bill_split:type_name -> pb.BillSplit
+	4, // 2: pb.CreateBillSplitResponse.payment_requests:type_name -> pb.PaymentRequest
+	5, // 3: pb.CreateBillSplitResponse.progress:type_name -> pb.BillSplitProgress
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_bill_split_proto_init() }
+func file_rpc_create_bill_split_proto_init() {
+	if File_rpc_create_bill_split_proto != nil {
+		return
+	}
+	file_bill_split_proto_init()
+	file_payment_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_bill_split_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBillSplitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_bill_split_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBillSplitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_bill_split_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_bill_split_proto_goTypes,
+		DependencyIndexes: file_rpc_create_bill_split_proto_depIdxs,
+		MessageInfos:      file_rpc_create_bill_split_proto_msgTypes,
+	}.Build()
+	File_rpc_create_bill_split_proto = out.File
+	file_rpc_create_bill_split_proto_rawDesc = nil
+	file_rpc_create_bill_split_proto_goTypes = nil
+	file_rpc_create_bill_split_proto_depIdxs = nil
+}
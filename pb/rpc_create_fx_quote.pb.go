@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_create_fx_quote.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateFxQuoteRequest holds the values for the request
+type CreateFxQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromCurrency string `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency   string `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+}
+
+func (x *CreateFxQuoteRequest) Reset() {
+	*x = CreateFxQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_fx_quote_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFxQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFxQuoteRequest) ProtoMessage() {}
+
+func (x *CreateFxQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fx_quote_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFxQuoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateFxQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fx_quote_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateFxQuoteRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *CreateFxQuoteRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+// CreateFxQuoteResponse holds the values for the response
+type CreateFxQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quote *FxQuote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *CreateFxQuoteResponse) Reset() {
+	*x = CreateFxQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_fx_quote_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFxQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFxQuoteResponse) ProtoMessage() {}
+
+func (x *CreateFxQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_fx_quote_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFxQuoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateFxQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_fx_quote_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFxQuoteResponse) GetQuote() *FxQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+var File_rpc_create_fx_quote_proto protoreflect.FileDescriptor
+
+var file_rpc_create_fx_quote_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x78, 0x5f,
+	0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x0e, 0x66, 0x78, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x5c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x66, 0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x3a, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x78, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72,
+	0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_fx_quote_proto_rawDescOnce sync.Once
+	file_rpc_create_fx_quote_proto_rawDescData = file_rpc_create_fx_quote_proto_rawDesc
+)
+
+func file_rpc_create_fx_quote_proto_rawDescGZIP() []byte {
+	file_rpc_create_fx_quote_proto_rawDescOnce.Do(func() {
+		file_rpc_create_fx_quote_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_fx_quote_proto_rawDescData)
+	})
+	return file_rpc_create_fx_quote_proto_rawDescData
+}
+
+var file_rpc_create_fx_quote_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_fx_quote_proto_goTypes = []interface{}{
+	(*CreateFxQuoteRequest)(nil),  // 0: pb.CreateFxQuoteRequest
+	(*CreateFxQuoteResponse)(nil), // 1: pb.CreateFxQuoteResponse
+	(*FxQuote)(nil),               // 2: pb.FxQuote
+}
+var file_rpc_create_fx_quote_proto_depIdxs = []int32{
+	2, // 0: pb.CreateFxQuoteResponse.quote:type_name -> pb.FxQuote
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_fx_quote_proto_init() }
+func file_rpc_create_fx_quote_proto_init() {
+	if File_rpc_create_fx_quote_proto != nil {
+		return
+	}
+	file_fx_quote_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_fx_quote_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFxQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_fx_quote_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFxQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_fx_quote_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_fx_quote_proto_goTypes,
+		DependencyIndexes: file_rpc_create_fx_quote_proto_depIdxs,
+		MessageInfos:      file_rpc_create_fx_quote_proto_msgTypes,
+	}.Build()
+	File_rpc_create_fx_quote_proto = out.File
+	file_rpc_create_fx_quote_proto_rawDesc = nil
+	file_rpc_create_fx_quote_proto_goTypes = nil
+	file_rpc_create_fx_quote_proto_depIdxs = nil
+}
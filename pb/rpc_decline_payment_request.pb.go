@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_decline_payment_request.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeclinePaymentRequestRequest holds the ID of the payment request which the payer declines
+type DeclinePaymentRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentRequestId int64 `protobuf:"varint,1,opt,name=payment_request_id,json=paymentRequestId,proto3" json:"payment_request_id,omitempty"`
+}
+
+func (x *DeclinePaymentRequestRequest) Reset() {
+	*x = DeclinePaymentRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_decline_payment_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclinePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestRequest) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_decline_payment_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeclinePaymentRequestRequest) GetPaymentRequestId() int64 {
+	if x != nil {
+		return x.PaymentRequestId
+	}
+	return 0
+}
+
+// DeclinePaymentRequestResponse holds the declined payment request
+type DeclinePaymentRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentRequest *PaymentRequest `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+}
+
+func (x *DeclinePaymentRequestResponse) Reset() {
+	*x = DeclinePaymentRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_decline_payment_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclinePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestResponse) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_decline_payment_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeclinePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+var File_rpc_decline_payment_request_proto protoreflect.FileDescriptor
+
+var file_rpc_decline_payment_request_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x72, 0x70, 0x63, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c,
+	0x0a, 0x1c, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1d,
+	0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61,
+	0x72, 0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_decline_payment_request_proto_rawDescOnce sync.Once
+	file_rpc_decline_payment_request_proto_rawDescData = file_rpc_decline_payment_request_proto_rawDesc
+)
+
+func file_rpc_decline_payment_request_proto_rawDescGZIP() []byte {
+	file_rpc_decline_payment_request_proto_rawDescOnce.Do(func() {
+		file_rpc_decline_payment_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_decline_payment_request_proto_rawDescData)
+	})
+	return file_rpc_decline_payment_request_proto_rawDescData
+}
+
+var file_rpc_decline_payment_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_decline_payment_request_proto_goTypes = []interface{}{
+	(*DeclinePaymentRequestRequest)(nil),  // 0: pb.DeclinePaymentRequestRequest
+	(*DeclinePaymentRequestResponse)(nil), // 1: pb.DeclinePaymentRequestResponse
+	(*PaymentRequest)(nil),                // 2: pb.PaymentRequest
+}
+var file_rpc_decline_payment_request_proto_depIdxs = []int32{
+	2, // 0: pb.DeclinePaymentRequestResponse.payment_request:type_name -> pb.PaymentRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_decline_payment_request_proto_init() }
+func file_rpc_decline_payment_request_proto_init() {
+	if File_rpc_decline_payment_request_proto != nil {
+		return
+	}
+	file_payment_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_decline_payment_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePaymentRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_decline_payment_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclinePaymentRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_decline_payment_request_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_decline_payment_request_proto_goTypes,
+		DependencyIndexes: file_rpc_decline_payment_request_proto_depIdxs,
+		MessageInfos:      file_rpc_decline_payment_request_proto_msgTypes,
+	}.Build()
+	File_rpc_decline_payment_request_proto = out.File
+	file_rpc_decline_payment_request_proto_rawDesc = nil
+	file_rpc_decline_payment_request_proto_goTypes = nil
+	file_rpc_decline_payment_request_proto_depIdxs = nil
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_get_bill_split.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetBillSplitRequest holds the ID of the bill split
+type GetBillSplitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetBillSplitRequest) Reset() {
+	*x = GetBillSplitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_bill_split_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillSplitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillSplitRequest) ProtoMessage() {}
+
+func (x *GetBillSplitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_bill_split_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillSplitRequest.ProtoReflect.Descriptor instead.
+func (*GetBillSplitRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_bill_split_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetBillSplitRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetBillSplitResponse holds the bill split with its payment requests and how much of it is paid
+type GetBillSplitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillSplit       *BillSplit         `protobuf:"bytes,1,opt,name=bill_split,json=billSplit,proto3" json:"bill_split,omitempty"`
+	PaymentRequests []*PaymentRequest  `protobuf:"bytes,2,rep,name=payment_requests,json=paymentRequests,proto3" json:"payment_requests,omitempty"`
+	Progress        *BillSplitProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *GetBillSplitResponse) Reset() {
+	*x = GetBillSplitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_get_bill_split_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillSplitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillSplitResponse) ProtoMessage() {}
+
+func (x *GetBillSplitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_bill_split_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillSplitResponse.ProtoReflect.Descriptor instead.
+func (*GetBillSplitResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_bill_split_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetBillSplitResponse) GetBillSplit() *BillSplit {
+	if x != nil {
+		return x.BillSplit
+	}
+	return nil
+}
+
+func (x *GetBillSplitResponse) GetPaymentRequests() []*PaymentRequest {
+	if x != nil {
+		return x.PaymentRequests
+	}
+	return nil
+}
+
+func (x *GetBillSplitResponse) GetProgress() *BillSplitProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+var File_rpc_get_bill_split_proto protoreflect.FileDescriptor
+
+var file_rpc_get_bill_split_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73,
+	0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x10,
+	0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x15, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x69,
+	0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb6,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x09, 0x62, 0x69, 0x6c, 0x6c,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73,
+	0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_get_bill_split_proto_rawDescOnce sync.Once
+	file_rpc_get_bill_split_proto_rawDescData = file_rpc_get_bill_split_proto_rawDesc
+)
+
+func file_rpc_get_bill_split_proto_rawDescGZIP() []byte {
+	file_rpc_get_bill_split_proto_rawDescOnce.Do(func() {
+		file_rpc_get_bill_split_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_get_bill_split_proto_rawDescData)
+	})
+	return file_rpc_get_bill_split_proto_rawDescData
+}
+
+var file_rpc_get_bill_split_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_bill_split_proto_goTypes = []interface{}{
+	(*GetBillSplitRequest)(nil),  // 0: pb.GetBillSplitRequest
+	(*GetBillSplitResponse)(nil), // 1: pb.GetBillSplitResponse
+	(*BillSplit)(nil),            // 2: pb.BillSplit
+	(*PaymentRequest)(nil),       // 3: pb.PaymentRequest
+	(*BillSplitProgress)(nil),    // 4: pb.BillSplitProgress
+}
+var file_rpc_get_bill_split_proto_depIdxs = []int32{
+	2, // 0: pb.GetBillSplitResponse.bill_split:type_name -> pb.BillSplit
+	3, // 1: pb.GetBillSplitResponse.payment_requests:type_name -> pb.PaymentRequest
+	4, // 2: pb.GetBillSplitResponse.progress:type_name -> pb.BillSplitProgress
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_bill_split_proto_init() }
+func file_rpc_get_bill_split_proto_init() {
+	if File_rpc_get_bill_split_proto != nil {
+		return
+	}
+	file_bill_split_proto_init()
+	file_payment_request_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_get_bill_split_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillSplitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_get_bill_split_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBillSplitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_get_bill_split_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_bill_split_proto_goTypes,
+		DependencyIndexes: file_rpc_get_bill_split_proto_depIdxs,
+		MessageInfos:      file_rpc_get_bill_split_proto_msgTypes,
+	}.Build()
+	File_rpc_get_bill_split_proto = out.File
+	file_rpc_get_bill_split_proto_rawDesc = nil
+	file_rpc_get_bill_split_proto_goTypes = nil
+	file_rpc_get_bill_split_proto_depIdxs = nil
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_list_bill_splits.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListBillSplitsRequest holds the values for the request
+type ListBillSplitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Cursor   string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Sort     string `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *ListBillSplitsRequest) Reset() {
+	*x = ListBillSplitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_bill_splits_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillSplitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillSplitsRequest) ProtoMessage() {}
+
+func (x *ListBillSplitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_bill_splits_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillSplitsRequest.ProtoReflect.Descriptor instead.
+func (*ListBillSplitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_bill_splits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListBillSplitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBillSplitsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListBillSplitsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// ListBillSplitsResponse holds the values for the response, next_cursor is empty on the last page
+type ListBillSplitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillSplits []*BillSplit `protobuf:"bytes,1,rep,name=bill_splits,json=billSplits,proto3" json:"bill_splits,omitempty"`
+	NextCursor string       `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListBillSplitsResponse) Reset() {
+	*x = ListBillSplitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_bill_splits_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBillSplitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBillSplitsResponse) ProtoMessage() {}
+
+func (x *ListBillSplitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_bill_splits_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBillSplitsResponse.ProtoReflect.Descriptor instead.
+func (*ListBillSplitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_bill_splits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListBillSplitsResponse) GetBillSplits() []*BillSplit {
+	if x != nil {
+		return x.BillSplits
+	}
+	return nil
+}
+
+func (x *ListBillSplitsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_rpc_list_bill_splits_proto protoreflect.FileDescriptor
+
+var file_rpc_list_bill_splits_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x5f,
+	0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62,
+	0x1a, 0x10, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x60, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x22, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x6c, 0x6c,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x0b, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x52, 0x0a, 0x62, 0x69, 0x6c, 0x6c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42,
+	0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75,
+	0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d,
+	0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_bill_splits_proto_rawDescOnce sync.Once
+	file_rpc_list_bill_splits_proto_rawDescData = file_rpc_list_bill_splits_proto_rawDesc
+)
+
+func file_rpc_list_bill_splits_proto_rawDescGZIP() []byte {
+	file_rpc_list_bill_splits_proto_rawDescOnce.Do(func() {
+		file_rpc_list_bill_splits_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_bill_splits_proto_rawDescData)
+	})
+	return file_rpc_list_bill_splits_proto_rawDescData
+}
+
+var file_rpc_list_bill_splits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_bill_splits_proto_goTypes = []interface{}{
+	(*ListBillSplitsRequest)(nil),  // 0: pb.ListBillSplitsRequest
+	(*ListBillSplitsResponse)(nil), // 1: pb.ListBillSplitsResponse
+	(*BillSplit)(nil),              // 2: pb.BillSplit
+}
+var file_rpc_list_bill_splits_proto_depIdxs = []int32{
+	2, // 0: pb.ListBillSplitsResponse.bill_splits:type_name -> pb.BillSplit
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_bill_splits_proto_init() }
+func file_rpc_list_bill_splits_proto_init() {
+	if File_rpc_list_bill_splits_proto != nil {
+		return
+	}
+	file_bill_split_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_bill_splits_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillSplitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_bill_splits_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBillSplitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_bill_splits_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_bill_splits_proto_goTypes,
+		DependencyIndexes: file_rpc_list_bill_splits_proto_depIdxs,
+		MessageInfos:      file_rpc_list_bill_splits_proto_msgTypes,
+	}.Build()
+	File_rpc_list_bill_splits_proto = out.File
+	file_rpc_list_bill_splits_proto_rawDesc = nil
+	file_rpc_list_bill_splits_proto_goTypes = nil
+	file_rpc_list_bill_splits_proto_depIdxs = nil
+}
@@ -0,0 +1,231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_list_scheduled_transfers.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ListScheduledTransfersRequest holds the values for the request
+type ListScheduledTransfersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageId   int32 `protobuf:"varint,1,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListScheduledTransfersRequest) Reset() {
+	*x = ListScheduledTransfersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_scheduled_transfers_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersRequest) ProtoMessage() {}
+
+func (x *ListScheduledTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_scheduled_transfers_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_scheduled_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListScheduledTransfersRequest) GetPageId() int32 {
+	if x != nil {
+		return x.PageId
+	}
+	return 0
+}
+
+func (x *ListScheduledTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListScheduledTransfersResponse holds the values for the response
+type ListScheduledTransfersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduledTransfers []*ScheduledTransfer `protobuf:"bytes,1,rep,name=scheduled_transfers,json=scheduledTransfers,proto3" json:"scheduled_transfers,omitempty"`
+}
+
+func (x *ListScheduledTransfersResponse) Reset() {
+	*x = ListScheduledTransfersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_scheduled_transfers_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledTransfersResponse) ProtoMessage() {}
+
+func (x *ListScheduledTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_scheduled_transfers_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_scheduled_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListScheduledTransfersResponse) GetScheduledTransfers() []*ScheduledTransfer {
+	if x != nil {
+		return x.ScheduledTransfers
+	}
+	return nil
+}
+
+var File_rpc_list_scheduled_transfers_proto protoreflect.FileDescriptor
+
+var file_rpc_list_scheduled_transfers_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x18, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x55, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x68, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x13, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42,
+	0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_scheduled_transfers_proto_rawDescOnce sync.Once
+	file_rpc_list_scheduled_transfers_proto_rawDescData = file_rpc_list_scheduled_transfers_proto_rawDesc
+)
+
+func file_rpc_list_scheduled_transfers_proto_rawDescGZIP() []byte {
+	file_rpc_list_scheduled_transfers_proto_rawDescOnce.Do(func() {
+		file_rpc_list_scheduled_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_scheduled_transfers_proto_rawDescData)
+	})
+	return file_rpc_list_scheduled_transfers_proto_rawDescData
+}
+
+var file_rpc_list_scheduled_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_scheduled_transfers_proto_goTypes = []interface{}{
+	(*ListScheduledTransfersRequest)(nil),  // 0: pb.ListScheduledTransfersRequest
+	(*ListScheduledTransfersResponse)(nil), // 1: pb.ListScheduledTransfersResponse
+	(*ScheduledTransfer)(nil),              // 2: pb.ScheduledTransfer
+}
+var file_rpc_list_scheduled_transfers_proto_depIdxs = []int32{
+	2, // 0: pb.ListScheduledTransfersResponse.scheduled_transfers:type_name -> pb.ScheduledTransfer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_scheduled_transfers_proto_init() }
+func file_rpc_list_scheduled_transfers_proto_init() {
+	if File_rpc_list_scheduled_transfers_proto != nil {
+		return
+	}
+	file_scheduled_transfer_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_scheduled_transfers_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransfersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_scheduled_transfers_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledTransfersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_scheduled_transfers_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_scheduled_transfers_proto_goTypes,
+		DependencyIndexes: file_rpc_list_scheduled_transfers_proto_depIdxs,
+		MessageInfos:      file_rpc_list_scheduled_transfers_proto_msgTypes,
+	}.Build()
+	File_rpc_list_scheduled_transfers_proto = out.File
+	file_rpc_list_scheduled_transfers_proto_rawDesc = nil
+	file_rpc_list_scheduled_transfers_proto_goTypes = nil
+	file_rpc_list_scheduled_transfers_proto_depIdxs = nil
+}
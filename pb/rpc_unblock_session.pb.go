@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_unblock_session.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UnblockSessionRequest holds the values for the request
+type UnblockSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UnblockSessionRequest) Reset() {
+	*x = UnblockSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_unblock_session_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSessionRequest) ProtoMessage() {}
+
+func (x *UnblockSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_unblock_session_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSessionRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSessionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_unblock_session_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UnblockSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UnblockSessionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UnblockSessionResponse holds the values for the response
+type UnblockSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session  *Session       `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	AuditLog *AdminAuditLog `protobuf:"bytes,2,opt,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+}
+
+func (x *UnblockSessionResponse) Reset() {
+	*x = UnblockSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_unblock_session_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSessionResponse) ProtoMessage() {}
+
+func (x *UnblockSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_unblock_session_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSessionResponse.ProtoReflect.Descriptor instead.
+func (*UnblockSessionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_unblock_session_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UnblockSessionResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *UnblockSessionResponse) GetAuditLog() *AdminAuditLog {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+var File_rpc_unblock_session_proto protoreflect.FileDescriptor
+
+var file_rpc_unblock_session_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3f, 0x0a, 0x15, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x16, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73,
+	0x65, 0x6c, 0x2f, 0x42, 0x61, 0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_unblock_session_proto_rawDescOnce sync.Once
+	file_rpc_unblock_session_proto_rawDescData = file_rpc_unblock_session_proto_rawDesc
+)
+
+func file_rpc_unblock_session_proto_rawDescGZIP() []byte {
+	file_rpc_unblock_session_proto_rawDescOnce.Do(func() {
+		file_rpc_unblock_session_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_unblock_session_proto_rawDescData)
+	})
+	return file_rpc_unblock_session_proto_rawDescData
+}
+
+var file_rpc_unblock_session_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_unblock_session_proto_goTypes = []interface{}{
+	(*UnblockSessionRequest)(nil),  // 0: pb.UnblockSessionRequest
+	(*UnblockSessionResponse)(nil), // 1: pb.UnblockSessionResponse
+	(*Session)(nil),                // 2: pb.Session
+	(*AdminAuditLog)(nil),          // 3: pb.AdminAuditLog
+}
+var file_rpc_unblock_session_proto_depIdxs = []int32{
+	2, // 0: pb.UnblockSessionResponse.session:type_name -> pb.Session
+	3, // 1: pb.UnblockSessionResponse.audit_log:type_name -> pb.AdminAuditLog
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_unblock_session_proto_init() }
+func file_rpc_unblock_session_proto_init() {
+	if File_rpc_unblock_session_proto != nil {
+		return
+	}
+	file_session_proto_init()
+	file_admin_audit_log_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_unblock_session_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_unblock_session_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_unblock_session_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_unblock_session_proto_goTypes,
+		DependencyIndexes: file_rpc_unblock_session_proto_depIdxs,
+		MessageInfos:      file_rpc_unblock_session_proto_msgTypes,
+	}.Build()
+	File_rpc_unblock_session_proto = out.File
+	file_rpc_unblock_session_proto_rawDesc = nil
+	file_rpc_unblock_session_proto_goTypes = nil
+	file_rpc_unblock_session_proto_depIdxs = nil
+}
@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.12.4
+// source: rpc_void_hold.proto
+
+// here we declare the package name
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// VoidHoldRequest holds the ID of the hold which is voided
+type VoidHoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldId int64 `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+}
+
+func (x *VoidHoldRequest) Reset() {
+	*x = VoidHoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_void_hold_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldRequest) ProtoMessage() {}
+
+func (x *VoidHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_void_hold_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldRequest.ProtoReflect.Descriptor instead.
+func (*VoidHoldRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_void_hold_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VoidHoldRequest) GetHoldId() int64 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+// VoidHoldResponse holds the voided hold and the from account which its money is released on
+type VoidHoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hold        *Hold    `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	FromAccount *Account `protobuf:"bytes,2,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+}
+
+func (x *VoidHoldResponse) Reset() {
+	*x = VoidHoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_void_hold_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoidHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidHoldResponse) ProtoMessage() {}
+
+func (x *VoidHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_void_hold_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidHoldResponse.ProtoReflect.Descriptor instead.
+func (*VoidHoldResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_void_hold_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VoidHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *VoidHoldResponse) GetFromAccount() *Account {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+var File_rpc_void_hold_proto protoreflect.FileDescriptor
+
+var file_rpc_void_hold_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x70, 0x63, 0x5f, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2a, 0x0a, 0x0f, 0x56, 0x6f, 0x69, 0x64, 0x48, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64,
+	0x22, 0x60, 0x0a, 0x10, 0x56, 0x6f, 0x69, 0x64, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x04, 0x68, 0x6f,
+	0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x75, 0x72, 0x61, 0x6b, 0x6b, 0x61, 0x72, 0x61, 0x73, 0x65, 0x6c, 0x2f, 0x42, 0x61,
+	0x6e, 0x6b, 0x2d, 0x41, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_void_hold_proto_rawDescOnce sync.Once
+	file_rpc_void_hold_proto_rawDescData = file_rpc_void_hold_proto_rawDesc
+)
+
+func file_rpc_void_hold_proto_rawDescGZIP() []byte {
+	file_rpc_void_hold_proto_rawDescOnce.Do(func() {
+		file_rpc_void_hold_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_void_hold_proto_rawDescData)
+	})
+	return file_rpc_void_hold_proto_rawDescData
+}
+
+var file_rpc_void_hold_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_void_hold_proto_goTypes = []interface{}{
+	(*VoidHoldRequest)(nil),  // 0: pb.VoidHoldRequest
+	(*VoidHoldResponse)(nil), // 1: pb.VoidHoldResponse
+	(*Hold)(nil),             // 2: pb.Hold
+	(*Account)(nil),          // 3: pb.Account
+}
+var file_rpc_void_hold_proto_depIdxs = []int32{
+	2, // 0: pb.VoidHoldResponse.hold:type_name -> pb.Hold
+	3, // 1: pb.VoidHoldResponse.from_account:type_name -> pb.Account
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_void_hold_proto_init() }
+func file_rpc_void_hold_proto_init() {
+	if File_rpc_void_hold_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_hold_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_void_hold_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidHoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_void_hold_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoidHoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_void_hold_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_void_hold_proto_goTypes,
+		DependencyIndexes: file_rpc_void_hold_proto_depIdxs,
+		MessageInfos:      file_rpc_void_hold_proto_msgTypes,
+	}.Build()
+	File_rpc_void_hold_proto = out.File
+	file_rpc_void_hold_proto_rawDesc = nil
+	file_rpc_void_hold_proto_goTypes = nil
+	file_rpc_void_hold_proto_depIdxs = nil
+}
@@ -24,6 +24,48 @@ const _ = grpc.SupportPackageIsVersion7
 type BankAppClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	LoginUser(ctx context.Context, in *LoginUserRequest, opts ...grpc.CallOption) (*LoginUserResponse, error)
+	RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error)
+	LogoutUser(ctx context.Context, in *LogoutUserRequest, opts ...grpc.CallOption) (*LogoutUserResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error)
+	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error)
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error)
+	ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error)
+	ResolvePayee(ctx context.Context, in *ResolvePayeeRequest, opts ...grpc.CallOption) (*ResolvePayeeResponse, error)
+	CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateHoldResponse, error)
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error)
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error)
+	CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error)
+	GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*GetPaymentRequestResponse, error)
+	ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error)
+	CancelPaymentRequest(ctx context.Context, in *CancelPaymentRequestRequest, opts ...grpc.CallOption) (*CancelPaymentRequestResponse, error)
+	CreateBillSplit(ctx context.Context, in *CreateBillSplitRequest, opts ...grpc.CallOption) (*CreateBillSplitResponse, error)
+	GetBillSplit(ctx context.Context, in *GetBillSplitRequest, opts ...grpc.CallOption) (*GetBillSplitResponse, error)
+	ListBillSplits(ctx context.Context, in *ListBillSplitsRequest, opts ...grpc.CallOption) (*ListBillSplitsResponse, error)
+	CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error)
+	GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error)
+	ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error)
+	ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (BankApp_ExportStatementClient, error)
+	ListMonthlyStatements(ctx context.Context, in *ListMonthlyStatementsRequest, opts ...grpc.CallOption) (*ListMonthlyStatementsResponse, error)
+	DownloadMonthlyStatement(ctx context.Context, in *DownloadMonthlyStatementRequest, opts ...grpc.CallOption) (*DownloadMonthlyStatementResponse, error)
+	CreateFxQuote(ctx context.Context, in *CreateFxQuoteRequest, opts ...grpc.CallOption) (*CreateFxQuoteResponse, error)
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*UpdateScheduledTransferResponse, error)
+	CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error)
+	ListScheduledTransferExecutions(ctx context.Context, in *ListScheduledTransferExecutionsRequest, opts ...grpc.CallOption) (*ListScheduledTransferExecutionsResponse, error)
 }
 
 type bankAppClient struct {
@@ -52,70 +94,1398 @@ func (c *bankAppClient) LoginUser(ctx context.Context, in *LoginUserRequest, opt
 	return out, nil
 }
 
+func (c *bankAppClient) RenewAccessToken(ctx context.Context, in *RenewAccessTokenRequest, opts ...grpc.CallOption) (*RenewAccessTokenResponse, error) {
+	out := new(RenewAccessTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/RenewAccessToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) LogoutUser(ctx context.Context, in *LogoutUserRequest, opts ...grpc.CallOption) (*LogoutUserResponse, error) {
+	out := new(LogoutUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/LogoutUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsRequest, opts ...grpc.CallOption) (*RevokeOtherSessionsResponse, error) {
+	out := new(RevokeOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/RevokeOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
+	out := new(CreateAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/FreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/UnfreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CloseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error) {
+	out := new(ReverseTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ReverseTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error) {
+	out := new(GetTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListTransfers(ctx context.Context, in *ListTransfersRequest, opts ...grpc.CallOption) (*ListTransfersResponse, error) {
+	out := new(ListTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ResolvePayee(ctx context.Context, in *ResolvePayeeRequest, opts ...grpc.CallOption) (*ResolvePayeeResponse, error) {
+	out := new(ResolvePayeeResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ResolvePayee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateHold(ctx context.Context, in *CreateHoldRequest, opts ...grpc.CallOption) (*CreateHoldResponse, error) {
+	out := new(CreateHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error) {
+	out := new(GetHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CaptureHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error) {
+	out := new(VoidHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/VoidHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error) {
+	out := new(CreatePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreatePaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetPaymentRequest(ctx context.Context, in *GetPaymentRequestRequest, opts ...grpc.CallOption) (*GetPaymentRequestResponse, error) {
+	out := new(GetPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetPaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListPaymentRequests(ctx context.Context, in *ListPaymentRequestsRequest, opts ...grpc.CallOption) (*ListPaymentRequestsResponse, error) {
+	out := new(ListPaymentRequestsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListPaymentRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) AcceptPaymentRequest(ctx context.Context, in *AcceptPaymentRequestRequest, opts ...grpc.CallOption) (*AcceptPaymentRequestResponse, error) {
+	out := new(AcceptPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/AcceptPaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error) {
+	out := new(DeclinePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/DeclinePaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CancelPaymentRequest(ctx context.Context, in *CancelPaymentRequestRequest, opts ...grpc.CallOption) (*CancelPaymentRequestResponse, error) {
+	out := new(CancelPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CancelPaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateBillSplit(ctx context.Context, in *CreateBillSplitRequest, opts ...grpc.CallOption) (*CreateBillSplitResponse, error) {
+	out := new(CreateBillSplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateBillSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetBillSplit(ctx context.Context, in *GetBillSplitRequest, opts ...grpc.CallOption) (*GetBillSplitResponse, error) {
+	out := new(GetBillSplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetBillSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListBillSplits(ctx context.Context, in *ListBillSplitsRequest, opts ...grpc.CallOption) (*ListBillSplitsResponse, error) {
+	out := new(ListBillSplitsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListBillSplits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateEntry(ctx context.Context, in *CreateEntryRequest, opts ...grpc.CallOption) (*CreateEntryResponse, error) {
+	out := new(CreateEntryResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetEntry(ctx context.Context, in *GetEntryRequest, opts ...grpc.CallOption) (*GetEntryResponse, error) {
+	out := new(GetEntryResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListEntries(ctx context.Context, in *ListEntriesRequest, opts ...grpc.CallOption) (*ListEntriesResponse, error) {
+	out := new(ListEntriesResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (BankApp_ExportStatementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BankApp_ServiceDesc.Streams[0], "/pb.BankApp/ExportStatement", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bankAppExportStatementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BankApp_ExportStatementClient interface {
+	Recv() (*ExportStatementResponse, error)
+	grpc.ClientStream
+}
+
+type bankAppExportStatementClient struct {
+	grpc.ClientStream
+}
+
+func (x *bankAppExportStatementClient) Recv() (*ExportStatementResponse, error) {
+	m := new(ExportStatementResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bankAppClient) ListMonthlyStatements(ctx context.Context, in *ListMonthlyStatementsRequest, opts ...grpc.CallOption) (*ListMonthlyStatementsResponse, error) {
+	out := new(ListMonthlyStatementsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListMonthlyStatements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) DownloadMonthlyStatement(ctx context.Context, in *DownloadMonthlyStatementRequest, opts ...grpc.CallOption) (*DownloadMonthlyStatementResponse, error) {
+	out := new(DownloadMonthlyStatementResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/DownloadMonthlyStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateFxQuote(ctx context.Context, in *CreateFxQuoteRequest, opts ...grpc.CallOption) (*CreateFxQuoteResponse, error) {
+	out := new(CreateFxQuoteResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateFxQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error) {
+	out := new(CreateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CreateScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error) {
+	out := new(GetScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/GetScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListScheduledTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*UpdateScheduledTransferResponse, error) {
+	out := new(UpdateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/UpdateScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) CancelScheduledTransfer(ctx context.Context, in *CancelScheduledTransferRequest, opts ...grpc.CallOption) (*CancelScheduledTransferResponse, error) {
+	out := new(CancelScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/CancelScheduledTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bankAppClient) ListScheduledTransferExecutions(ctx context.Context, in *ListScheduledTransferExecutionsRequest, opts ...grpc.CallOption) (*ListScheduledTransferExecutionsResponse, error) {
+	out := new(ListScheduledTransferExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.BankApp/ListScheduledTransferExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BankAppServer is the server API for BankApp service.
 // All implementations must embed UnimplementedBankAppServer
 // for forward compatibility
 type BankAppServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error)
+	RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error)
+	LogoutUser(context.Context, *LogoutUserRequest) (*LogoutUserResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error)
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error)
+	GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error)
+	ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error)
+	ResolvePayee(context.Context, *ResolvePayeeRequest) (*ResolvePayeeResponse, error)
+	CreateHold(context.Context, *CreateHoldRequest) (*CreateHoldResponse, error)
+	GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error)
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error)
+	CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error)
+	GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*GetPaymentRequestResponse, error)
+	ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error)
+	AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error)
+	DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error)
+	CancelPaymentRequest(context.Context, *CancelPaymentRequestRequest) (*CancelPaymentRequestResponse, error)
+	CreateBillSplit(context.Context, *CreateBillSplitRequest) (*CreateBillSplitResponse, error)
+	GetBillSplit(context.Context, *GetBillSplitRequest) (*GetBillSplitResponse, error)
+	ListBillSplits(context.Context, *ListBillSplitsRequest) (*ListBillSplitsResponse, error)
+	CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error)
+	GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error)
+	ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error)
+	ExportStatement(*ExportStatementRequest, BankApp_ExportStatementServer) error
+	ListMonthlyStatements(context.Context, *ListMonthlyStatementsRequest) (*ListMonthlyStatementsResponse, error)
+	DownloadMonthlyStatement(context.Context, *DownloadMonthlyStatementRequest) (*DownloadMonthlyStatementResponse, error)
+	CreateFxQuote(context.Context, *CreateFxQuoteRequest) (*CreateFxQuoteResponse, error)
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*UpdateScheduledTransferResponse, error)
+	CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error)
+	ListScheduledTransferExecutions(context.Context, *ListScheduledTransferExecutionsRequest) (*ListScheduledTransferExecutionsResponse, error)
 	mustEmbedUnimplementedBankAppServer()
 }
 
-// UnimplementedBankAppServer must be embedded to have forward compatible implementations.
-type UnimplementedBankAppServer struct {
+// UnimplementedBankAppServer must be embedded to have forward compatible implementations.
+type UnimplementedBankAppServer struct {
+}
+
+func (UnimplementedBankAppServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedBankAppServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+}
+func (UnimplementedBankAppServer) RenewAccessToken(context.Context, *RenewAccessTokenRequest) (*RenewAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewAccessToken not implemented")
+}
+func (UnimplementedBankAppServer) LogoutUser(context.Context, *LogoutUserRequest) (*LogoutUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutUser not implemented")
+}
+func (UnimplementedBankAppServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedBankAppServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedBankAppServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsRequest) (*RevokeOtherSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
+func (UnimplementedBankAppServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedBankAppServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedBankAppServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedBankAppServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedBankAppServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedBankAppServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedBankAppServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedBankAppServer) ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransfer not implemented")
+}
+func (UnimplementedBankAppServer) GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedBankAppServer) ListTransfers(context.Context, *ListTransfersRequest) (*ListTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBankAppServer) ResolvePayee(context.Context, *ResolvePayeeRequest) (*ResolvePayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePayee not implemented")
+}
+func (UnimplementedBankAppServer) CreateHold(context.Context, *CreateHoldRequest) (*CreateHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHold not implemented")
+}
+func (UnimplementedBankAppServer) GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHold not implemented")
+}
+func (UnimplementedBankAppServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedBankAppServer) VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidHold not implemented")
+}
+func (UnimplementedBankAppServer) CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentRequest not implemented")
+}
+func (UnimplementedBankAppServer) GetPaymentRequest(context.Context, *GetPaymentRequestRequest) (*GetPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentRequest not implemented")
+}
+func (UnimplementedBankAppServer) ListPaymentRequests(context.Context, *ListPaymentRequestsRequest) (*ListPaymentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPaymentRequests not implemented")
+}
+func (UnimplementedBankAppServer) AcceptPaymentRequest(context.Context, *AcceptPaymentRequestRequest) (*AcceptPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPaymentRequest not implemented")
+}
+func (UnimplementedBankAppServer) DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePaymentRequest not implemented")
+}
+func (UnimplementedBankAppServer) CancelPaymentRequest(context.Context, *CancelPaymentRequestRequest) (*CancelPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentRequest not implemented")
+}
+func (UnimplementedBankAppServer) CreateBillSplit(context.Context, *CreateBillSplitRequest) (*CreateBillSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBillSplit not implemented")
+}
+func (UnimplementedBankAppServer) GetBillSplit(context.Context, *GetBillSplitRequest) (*GetBillSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillSplit not implemented")
+}
+func (UnimplementedBankAppServer) ListBillSplits(context.Context, *ListBillSplitsRequest) (*ListBillSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBillSplits not implemented")
+}
+func (UnimplementedBankAppServer) CreateEntry(context.Context, *CreateEntryRequest) (*CreateEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntry not implemented")
+}
+func (UnimplementedBankAppServer) GetEntry(context.Context, *GetEntryRequest) (*GetEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntry not implemented")
+}
+func (UnimplementedBankAppServer) ListEntries(context.Context, *ListEntriesRequest) (*ListEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntries not implemented")
+}
+func (UnimplementedBankAppServer) ExportStatement(*ExportStatementRequest, BankApp_ExportStatementServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStatement not implemented")
+}
+func (UnimplementedBankAppServer) ListMonthlyStatements(context.Context, *ListMonthlyStatementsRequest) (*ListMonthlyStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonthlyStatements not implemented")
+}
+func (UnimplementedBankAppServer) DownloadMonthlyStatement(context.Context, *DownloadMonthlyStatementRequest) (*DownloadMonthlyStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadMonthlyStatement not implemented")
+}
+func (UnimplementedBankAppServer) CreateFxQuote(context.Context, *CreateFxQuoteRequest) (*CreateFxQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFxQuote not implemented")
+}
+func (UnimplementedBankAppServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedBankAppServer) GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTransfer not implemented")
+}
+func (UnimplementedBankAppServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedBankAppServer) UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*UpdateScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTransfer not implemented")
+}
+func (UnimplementedBankAppServer) CancelScheduledTransfer(context.Context, *CancelScheduledTransferRequest) (*CancelScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransfer not implemented")
+}
+func (UnimplementedBankAppServer) ListScheduledTransferExecutions(context.Context, *ListScheduledTransferExecutionsRequest) (*ListScheduledTransferExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransferExecutions not implemented")
+}
+func (UnimplementedBankAppServer) mustEmbedUnimplementedBankAppServer() {}
+
+// UnsafeBankAppServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BankAppServer will
+// result in compilation errors.
+type UnsafeBankAppServer interface {
+	mustEmbedUnimplementedBankAppServer()
+}
+
+func RegisterBankAppServer(s grpc.ServiceRegistrar, srv BankAppServer) {
+	s.RegisterService(&BankApp_ServiceDesc, srv)
+}
+
+func _BankApp_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).LoginUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/LoginUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).LoginUser(ctx, req.(*LoginUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_RenewAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).RenewAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/RenewAccessToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).RenewAccessToken(ctx, req.(*RenewAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_LogoutUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).LogoutUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/LogoutUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).LogoutUser(ctx, req.(*LogoutUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/RevokeOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/FreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/UnfreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CloseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ReverseTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ReverseTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ReverseTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ReverseTransfer(ctx, req.(*ReverseTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListTransfers(ctx, req.(*ListTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ResolvePayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ResolvePayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ResolvePayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ResolvePayee(ctx, req.(*ResolvePayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateHold(ctx, req.(*CreateHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CaptureHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_VoidHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).VoidHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/VoidHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).VoidHold(ctx, req.(*VoidHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreatePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreatePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreatePaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreatePaymentRequest(ctx, req.(*CreatePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetPaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetPaymentRequest(ctx, req.(*GetPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListPaymentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListPaymentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListPaymentRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListPaymentRequests(ctx, req.(*ListPaymentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_AcceptPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).AcceptPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/AcceptPaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).AcceptPaymentRequest(ctx, req.(*AcceptPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_DeclinePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).DeclinePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/DeclinePaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).DeclinePaymentRequest(ctx, req.(*DeclinePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CancelPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CancelPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CancelPaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CancelPaymentRequest(ctx, req.(*CancelPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateBillSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBillSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateBillSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateBillSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateBillSplit(ctx, req.(*CreateBillSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetBillSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetBillSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetBillSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetBillSplit(ctx, req.(*GetBillSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListBillSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBillSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListBillSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListBillSplits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListBillSplits(ctx, req.(*ListBillSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedBankAppServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func _BankApp_CreateEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateEntry(ctx, req.(*CreateEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankAppServer) LoginUser(context.Context, *LoginUserRequest) (*LoginUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginUser not implemented")
+
+func _BankApp_GetEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetEntry(ctx, req.(*GetEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBankAppServer) mustEmbedUnimplementedBankAppServer() {}
 
-// UnsafeBankAppServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BankAppServer will
-// result in compilation errors.
-type UnsafeBankAppServer interface {
-	mustEmbedUnimplementedBankAppServer()
+func _BankApp_ListEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListEntries(ctx, req.(*ListEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBankAppServer(s grpc.ServiceRegistrar, srv BankAppServer) {
-	s.RegisterService(&BankApp_ServiceDesc, srv)
+func _BankApp_ExportStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BankAppServer).ExportStatement(m, &bankAppExportStatementServer{stream})
 }
 
-func _BankApp_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+type BankApp_ExportStatementServer interface {
+	Send(*ExportStatementResponse) error
+	grpc.ServerStream
+}
+
+type bankAppExportStatementServer struct {
+	grpc.ServerStream
+}
+
+func (x *bankAppExportStatementServer) Send(m *ExportStatementResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BankApp_ListMonthlyStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMonthlyStatementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankAppServer).CreateUser(ctx, in)
+		return srv.(BankAppServer).ListMonthlyStatements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.BankApp/CreateUser",
+		FullMethod: "/pb.BankApp/ListMonthlyStatements",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankAppServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(BankAppServer).ListMonthlyStatements(ctx, req.(*ListMonthlyStatementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BankApp_LoginUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginUserRequest)
+func _BankApp_DownloadMonthlyStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadMonthlyStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BankAppServer).LoginUser(ctx, in)
+		return srv.(BankAppServer).DownloadMonthlyStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.BankApp/LoginUser",
+		FullMethod: "/pb.BankApp/DownloadMonthlyStatement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankAppServer).LoginUser(ctx, req.(*LoginUserRequest))
+		return srv.(BankAppServer).DownloadMonthlyStatement(ctx, req.(*DownloadMonthlyStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateFxQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFxQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateFxQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateFxQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateFxQuote(ctx, req.(*CreateFxQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CreateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CreateScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_GetScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).GetScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/GetScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).GetScheduledTransfer(ctx, req.(*GetScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListScheduledTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_UpdateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).UpdateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/UpdateScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).UpdateScheduledTransfer(ctx, req.(*UpdateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_CancelScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).CancelScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/CancelScheduledTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).CancelScheduledTransfer(ctx, req.(*CancelScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BankApp_ListScheduledTransferExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransferExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BankAppServer).ListScheduledTransferExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.BankApp/ListScheduledTransferExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BankAppServer).ListScheduledTransferExecutions(ctx, req.(*ListScheduledTransferExecutionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -135,7 +1505,177 @@ var BankApp_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginUser",
 			Handler:    _BankApp_LoginUser_Handler,
 		},
+		{
+			MethodName: "RenewAccessToken",
+			Handler:    _BankApp_RenewAccessToken_Handler,
+		},
+		{
+			MethodName: "LogoutUser",
+			Handler:    _BankApp_LogoutUser_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _BankApp_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _BankApp_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _BankApp_RevokeOtherSessions_Handler,
+		},
+		{
+			MethodName: "CreateAccount",
+			Handler:    _BankApp_CreateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _BankApp_GetAccount_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _BankApp_ListAccounts_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _BankApp_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _BankApp_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _BankApp_CloseAccount_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _BankApp_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "ReverseTransfer",
+			Handler:    _BankApp_ReverseTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _BankApp_GetTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _BankApp_ListTransfers_Handler,
+		},
+		{
+			MethodName: "ResolvePayee",
+			Handler:    _BankApp_ResolvePayee_Handler,
+		},
+		{
+			MethodName: "CreateHold",
+			Handler:    _BankApp_CreateHold_Handler,
+		},
+		{
+			MethodName: "GetHold",
+			Handler:    _BankApp_GetHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _BankApp_CaptureHold_Handler,
+		},
+		{
+			MethodName: "VoidHold",
+			Handler:    _BankApp_VoidHold_Handler,
+		},
+		{
+			MethodName: "CreatePaymentRequest",
+			Handler:    _BankApp_CreatePaymentRequest_Handler,
+		},
+		{
+			MethodName: "GetPaymentRequest",
+			Handler:    _BankApp_GetPaymentRequest_Handler,
+		},
+		{
+			MethodName: "ListPaymentRequests",
+			Handler:    _BankApp_ListPaymentRequests_Handler,
+		},
+		{
+			MethodName: "AcceptPaymentRequest",
+			Handler:    _BankApp_AcceptPaymentRequest_Handler,
+		},
+		{
+			MethodName: "DeclinePaymentRequest",
+			Handler:    _BankApp_DeclinePaymentRequest_Handler,
+		},
+		{
+			MethodName: "CancelPaymentRequest",
+			Handler:    _BankApp_CancelPaymentRequest_Handler,
+		},
+		{
+			MethodName: "CreateBillSplit",
+			Handler:    _BankApp_CreateBillSplit_Handler,
+		},
+		{
+			MethodName: "GetBillSplit",
+			Handler:    _BankApp_GetBillSplit_Handler,
+		},
+		{
+			MethodName: "ListBillSplits",
+			Handler:    _BankApp_ListBillSplits_Handler,
+		},
+		{
+			MethodName: "CreateEntry",
+			Handler:    _BankApp_CreateEntry_Handler,
+		},
+		{
+			MethodName: "GetEntry",
+			Handler:    _BankApp_GetEntry_Handler,
+		},
+		{
+			MethodName: "ListEntries",
+			Handler:    _BankApp_ListEntries_Handler,
+		},
+		{
+			MethodName: "ListMonthlyStatements",
+			Handler:    _BankApp_ListMonthlyStatements_Handler,
+		},
+		{
+			MethodName: "DownloadMonthlyStatement",
+			Handler:    _BankApp_DownloadMonthlyStatement_Handler,
+		},
+		{
+			MethodName: "CreateFxQuote",
+			Handler:    _BankApp_CreateFxQuote_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _BankApp_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransfer",
+			Handler:    _BankApp_GetScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _BankApp_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTransfer",
+			Handler:    _BankApp_UpdateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransfer",
+			Handler:    _BankApp_CancelScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransferExecutions",
+			Handler:    _BankApp_ListScheduledTransferExecutions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStatement",
+			Handler:       _BankApp_ExportStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_bank_app.proto",
 }
@@ -0,0 +1,72 @@
+package policy
+
+import (
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// Action is an operation a principal wants to do on a resource
+type Action string
+
+const (
+	ReadAccount             Action = "read_account"
+	FreezeAccount           Action = "freeze_account"
+	UnfreezeAccount         Action = "unfreeze_account"
+	CloseAccount            Action = "close_account"
+	MoveMoney               Action = "move_money"
+	ReverseTransfer         Action = "reverse_transfer"
+	CaptureHold             Action = "capture_hold"
+	VoidHold                Action = "void_hold"
+	ReadPaymentRequest      Action = "read_payment_request"
+	ManagePaymentRequest    Action = "manage_payment_request"
+	UseFxQuote              Action = "use_fx_quote"
+	ManageScheduledTransfer Action = "manage_scheduled_transfer"
+	ManageSession           Action = "manage_session"
+	UseAdminAPI             Action = "use_admin_api"
+)
+
+// roleActions holds the actions a role may do on the resources of other users,
+// owners may do every action on their own resources except lifting a freeze which someone else placed
+var roleActions = map[string]map[Action]bool{
+	util.AdminRole: {
+		ReadAccount:        true,
+		FreezeAccount:      true,
+		UnfreezeAccount:    true,
+		ReverseTransfer:    true,
+		VoidHold:           true,
+		ReadPaymentRequest: true,
+		UseAdminAPI:        true,
+	},
+}
+
+// Can returns true if the principal may do the action on a resource which belongs to owner
+func Can(principal *token.Payload, action Action, owner string) bool {
+	if principal == nil {
+		return false
+	}
+
+	if principal.Username == owner {
+		return true
+	}
+
+	return Allowed(principal, action)
+}
+
+// CanUnfreeze returns true if the principal may lift the freeze of an account which belongs to owner and was frozen
+// by frozenBy, owners may only lift their own freezes and the others are lifted by the roles which may unfreeze any account
+func CanUnfreeze(principal *token.Payload, owner string, frozenBy *string) bool {
+	if frozenBy != nil && *frozenBy != owner {
+		return Allowed(principal, UnfreezeAccount)
+	}
+
+	return Can(principal, UnfreezeAccount, owner)
+}
+
+// Allowed returns true if the role of the principal may do the action regardless of the owner of the resource
+func Allowed(principal *token.Payload, action Action) bool {
+	if principal == nil {
+		return false
+	}
+
+	return roleActions[principal.Role][action]
+}
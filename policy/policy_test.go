@@ -0,0 +1,106 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/token"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCan tests the decisions of the policy for owners, depositors and admins
+func TestCan(t *testing.T) {
+	owner := util.RandomOwner()
+
+	depositor, err := token.NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	admin, err := token.NewPayload(util.RandomOwner(), util.AdminRole, time.Minute)
+	require.NoError(t, err)
+
+	ownerPayload, err := token.NewPayload(owner, util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		principal *token.Payload
+		action    Action
+		allowed   bool
+	}{
+		{name: "owner moves money", principal: ownerPayload, action: MoveMoney, allowed: true},
+		{name: "owner manages session", principal: ownerPayload, action: ManageSession, allowed: true},
+		{name: "depositor reads account", principal: depositor, action: ReadAccount, allowed: false},
+		{name: "depositor freezes account", principal: depositor, action: FreezeAccount, allowed: false},
+		{name: "admin reads account", principal: admin, action: ReadAccount, allowed: true},
+		{name: "admin freezes account", principal: admin, action: FreezeAccount, allowed: true},
+		{name: "admin unfreezes account", principal: admin, action: UnfreezeAccount, allowed: true},
+		{name: "admin reverses transfer", principal: admin, action: ReverseTransfer, allowed: true},
+		{name: "admin voids hold", principal: admin, action: VoidHold, allowed: true},
+		{name: "admin captures hold", principal: admin, action: CaptureHold, allowed: false},
+		{name: "depositor captures hold", principal: depositor, action: CaptureHold, allowed: false},
+		{name: "admin reads payment request", principal: admin, action: ReadPaymentRequest, allowed: true},
+		{name: "admin manages payment request", principal: admin, action: ManagePaymentRequest, allowed: false},
+		{name: "depositor reads payment request", principal: depositor, action: ReadPaymentRequest, allowed: false},
+		{name: "admin moves money", principal: admin, action: MoveMoney, allowed: false},
+		{name: "admin closes account", principal: admin, action: CloseAccount, allowed: false},
+		{name: "admin manages session", principal: admin, action: ManageSession, allowed: false},
+		{name: "admin uses admin api", principal: admin, action: UseAdminAPI, allowed: true},
+		{name: "no principal", principal: nil, action: ReadAccount, allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, Can(tc.principal, tc.action, owner))
+		})
+	}
+}
+
+// TestCanUnfreeze tests that freezes which an admin placed can't be lifted by the owner
+func TestCanUnfreeze(t *testing.T) {
+	owner := util.RandomOwner()
+	adminUsername := util.RandomOwner()
+
+	ownerPayload, err := token.NewPayload(owner, util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	depositor, err := token.NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	admin, err := token.NewPayload(util.RandomOwner(), util.AdminRole, time.Minute)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		principal *token.Payload
+		frozenBy  *string
+		allowed   bool
+	}{
+		{name: "owner lifts own freeze", principal: ownerPayload, frozenBy: &owner, allowed: true},
+		{name: "owner lifts freeze of unknown source", principal: ownerPayload, frozenBy: nil, allowed: true},
+		{name: "owner lifts admin freeze", principal: ownerPayload, frozenBy: &adminUsername, allowed: false},
+		{name: "depositor lifts owner freeze", principal: depositor, frozenBy: &owner, allowed: false},
+		{name: "admin lifts owner freeze", principal: admin, frozenBy: &owner, allowed: true},
+		{name: "admin lifts admin freeze", principal: admin, frozenBy: &adminUsername, allowed: true},
+		{name: "no principal", principal: nil, frozenBy: &adminUsername, allowed: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, CanUnfreeze(tc.principal, owner, tc.frozenBy))
+		})
+	}
+}
+
+// TestAllowed tests that only the role decides on the actions which don't have an owner
+func TestAllowed(t *testing.T) {
+	depositor, err := token.NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
+	require.NoError(t, err)
+
+	admin, err := token.NewPayload(util.RandomOwner(), util.AdminRole, time.Minute)
+	require.NoError(t, err)
+
+	require.True(t, Allowed(admin, UseAdminAPI))
+	require.False(t, Allowed(depositor, UseAdminAPI))
+	require.False(t, Allowed(nil, UseAdminAPI))
+}
@@ -0,0 +1,78 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// Reconciler checks the invariants of the ledger and writes a reconciliation report
+type Reconciler struct {
+	store    db.Store
+	interval time.Duration
+}
+
+// NewReconciler creates a new Reconciler which reconciles the ledger every interval
+func NewReconciler(store db.Store, interval time.Duration) *Reconciler {
+	return &Reconciler{
+		store:    store,
+		interval: interval,
+	}
+}
+
+// Run reconciles the ledger every interval until ctx is done
+func (reconciler *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(reconciler.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := reconciler.Reconcile(ctx); err != nil {
+			log.Println("cannot reconcile ledger:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Reconcile checks the invariants of the ledger once and logs each discrepancy it finds,
+// the discrepancies are also written to the report
+func (reconciler *Reconciler) Reconcile(ctx context.Context) (db.ReconcileTxResult, error) {
+	result, err := reconciler.store.ReconcileTx(ctx)
+
+	if err != nil {
+		return result, err
+	}
+
+	for _, discrepancy := range result.Discrepancies {
+		log.Printf("reconciliation report [%d] discrepancy: %s", result.Report.ID, Describe(discrepancy))
+	}
+
+	return result, nil
+}
+
+// Describe returns a readable description of the discrepancy
+func Describe(discrepancy db.Discrepancy) string {
+	switch discrepancy.Kind {
+	case db.DiscrepancyAccountBalance:
+		return fmt.Sprintf("balance of account [%d] is %d but its entries sum to %d",
+			*discrepancy.AccountID, discrepancy.Actual, discrepancy.Expected)
+	case db.DiscrepancyTransferEntries:
+		return fmt.Sprintf("transfer [%d] has %d entries in its journal transaction, %d matching entries are expected",
+			*discrepancy.TransferID, discrepancy.Actual, discrepancy.Expected)
+	case db.DiscrepancyUnbalancedJournal:
+		return fmt.Sprintf("journal transaction [%d] sums to %d in %s",
+			*discrepancy.JournalID, discrepancy.Actual, discrepancy.Currency)
+	case db.DiscrepancyCurrencyNetBalance:
+		return fmt.Sprintf("balances in %s net to %d instead of %d",
+			discrepancy.Currency, discrepancy.Actual, discrepancy.Expected)
+	}
+
+	return fmt.Sprintf("%s: expected %d, actual %d", discrepancy.Kind, discrepancy.Expected, discrepancy.Actual)
+}
@@ -0,0 +1,95 @@
+package reconciliation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReconcile tests that the reconciler returns the written report and its discrepancies
+func TestReconcile(t *testing.T) {
+	accountID := int64(7)
+
+	testCases := []struct {
+		name                  string
+		buildStubs            func(store *mockdb.MockStore)
+		expectedDiscrepancies int
+		expectedErr           bool
+	}{
+		{
+			name: "reconciled",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReconcileTx(gomock.Any()).Times(1).
+					Return(db.ReconcileTxResult{
+						Report:        db.ReconciliationReport{ID: 1},
+						Discrepancies: []db.Discrepancy{},
+					}, nil)
+			},
+			expectedDiscrepancies: 0,
+		},
+		{
+			name: "discrepancies",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReconcileTx(gomock.Any()).Times(1).
+					Return(db.ReconcileTxResult{
+						Report: db.ReconciliationReport{ID: 2, DiscrepancyCount: 2},
+						Discrepancies: []db.Discrepancy{
+							{Kind: db.DiscrepancyAccountBalance, AccountID: &accountID, Expected: 100, Actual: 90},
+							{Kind: db.DiscrepancyCurrencyNetBalance, Currency: "USD", Expected: 0, Actual: -10},
+						},
+					}, nil)
+			},
+			expectedDiscrepancies: 2,
+		},
+		{
+			name: "DB error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReconcileTx(gomock.Any()).Times(1).
+					Return(db.ReconcileTxResult{}, errors.New("connection refused"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			reconciler := NewReconciler(store, time.Hour)
+
+			result, err := reconciler.Reconcile(context.Background())
+
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result.Discrepancies, tc.expectedDiscrepancies)
+		})
+	}
+}
+
+// TestDescribe tests that each kind of discrepancy is described with its IDs and amounts
+func TestDescribe(t *testing.T) {
+	id := int64(42)
+
+	require.Equal(t, "balance of account [42] is 90 but its entries sum to 100",
+		Describe(db.Discrepancy{Kind: db.DiscrepancyAccountBalance, AccountID: &id, Expected: 100, Actual: 90}))
+	require.Equal(t, "transfer [42] has 3 entries in its journal transaction, 2 matching entries are expected",
+		Describe(db.Discrepancy{Kind: db.DiscrepancyTransferEntries, TransferID: &id, Expected: 2, Actual: 3}))
+	require.Equal(t, "journal transaction [42] sums to 5 in EUR",
+		Describe(db.Discrepancy{Kind: db.DiscrepancyUnbalancedJournal, JournalID: &id, Currency: "EUR", Actual: 5}))
+	require.Equal(t, "balances in CAD net to -10 instead of 0",
+		Describe(db.Discrepancy{Kind: db.DiscrepancyCurrencyNetBalance, Currency: "CAD", Expected: 0, Actual: -10}))
+}
@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// Executor executes the scheduled transfers when they are due
+type Executor struct {
+	store    db.Store
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewExecutor creates a new Executor which checks for the due scheduled transfers every interval
+func NewExecutor(store db.Store, interval time.Duration) *Executor {
+	return &Executor{
+		store:    store,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run executes the due scheduled transfers every interval until ctx is done
+func (executor *Executor) Run(ctx context.Context) {
+	ticker := time.NewTicker(executor.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := executor.ExecuteDue(ctx); err != nil {
+			// rows stay due, so they are picked again at the next tick
+			log.Println("cannot execute scheduled transfers:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExecuteDue executes the scheduled transfers which are due until there is none left or ctx is done,
+// it returns how many executions are made
+func (executor *Executor) ExecuteDue(ctx context.Context) (int, error) {
+	count := 0
+
+	for ctx.Err() == nil {
+		// an execution isn't cancelled halfway when we shut down, the DB waits for it to finish
+		result, err := executor.store.ExecuteScheduledTransferTx(context.Background(), executor.now())
+
+		if err != nil {
+			if errors.Is(err, db.ErrNoScheduledTransferDue) {
+				return count, nil
+			}
+			return count, err
+		}
+
+		count++
+
+		if result.Execution.Status == db.ExecutionFailed {
+			log.Printf("scheduled transfer [%d] failed: %s", result.ScheduledTransfer.ID, *result.Execution.FailureReason)
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExecuteDue tests that the executor runs the due scheduled transfers until there is none left
+func TestExecuteDue(t *testing.T) {
+	now := time.Now()
+	reason := db.ErrInsufficientFunds.Error()
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		expectedCount int
+		expectedErr   bool
+	}{
+		{
+			name: "nothing due",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExecuteScheduledTransferTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.ExecuteScheduledTransferTxResult{}, db.ErrNoScheduledTransferDue)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "succeeded and failed executions",
+			buildStubs: func(store *mockdb.MockStore) {
+				gomock.InOrder(
+					store.EXPECT().ExecuteScheduledTransferTx(gomock.Any(), gomock.Eq(now)).Times(1).
+						Return(db.ExecuteScheduledTransferTxResult{
+							Execution: db.ScheduledTransferExecution{Status: db.ExecutionSucceeded},
+						}, nil),
+					store.EXPECT().ExecuteScheduledTransferTx(gomock.Any(), gomock.Eq(now)).Times(1).
+						Return(db.ExecuteScheduledTransferTxResult{
+							Execution: db.ScheduledTransferExecution{Status: db.ExecutionFailed, FailureReason: &reason},
+						}, nil),
+					store.EXPECT().ExecuteScheduledTransferTx(gomock.Any(), gomock.Eq(now)).Times(1).
+						Return(db.ExecuteScheduledTransferTxResult{}, db.ErrNoScheduledTransferDue),
+				)
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "DB error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ExecuteScheduledTransferTx(gomock.Any(), gomock.Eq(now)).Times(1).
+					Return(db.ExecuteScheduledTransferTxResult{}, errors.New("connection refused"))
+			},
+			expectedCount: 0,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			executor := NewExecutor(store, time.Minute)
+			executor.now = func() time.Time { return now }
+
+			count, err := executor.ExecuteDue(context.Background())
+			require.Equal(t, tc.expectedCount, count)
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
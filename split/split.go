@@ -0,0 +1,165 @@
+package split
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// rules which a bill is split by
+const (
+	RuleEqual      = "equal"
+	RuleExact      = "exact"
+	RulePercentage = "percentage"
+)
+
+// MaxParticipants is the most users who can share a bill
+const MaxParticipants = 50
+
+// WholeBasisPoints is the sum of the shares of a percentage split, a basis point is 1/100 of a percent
+const WholeBasisPoints = 10000
+
+var ErrUnsupportedRule = errors.New("unsupported split rule")
+var ErrNoParticipants = errors.New("split needs at least one participant")
+var ErrTooManyParticipants = fmt.Errorf("split can have at most %d participants", MaxParticipants)
+var ErrDuplicateParticipant = errors.New("participants must be different users")
+var ErrNegativeShare = errors.New("shares can't be negative")
+var ErrExactAmountsMismatch = errors.New("exact amounts must add up to the total amount")
+var ErrPercentagesMismatch = errors.New("percentages must add up to 10000 basis points")
+
+// Participant is a user who shares the bill. Value is the amount in minor units for exact splits and the share
+// in basis points for percentage splits, it is ignored by equal splits
+type Participant struct {
+	Username string
+	Value    int64
+}
+
+// IsSupportedRule returns true if bills can be split by the given rule
+func IsSupportedRule(rule string) bool {
+	switch rule {
+	case RuleEqual, RuleExact, RulePercentage:
+		return true
+	}
+
+	return false
+}
+
+// Shares splits the total amount in minor units between the participants by the rule and returns the share
+// of each participant in their order. The shares always add up to the total, the minor units which can't be split
+// evenly go to the participants with the largest rounded off fractions and the earlier ones on ties
+func Shares(total int64, rule string, participants []Participant) ([]int64, error) {
+	if len(participants) == 0 {
+		return nil, ErrNoParticipants
+	}
+
+	if len(participants) > MaxParticipants {
+		return nil, ErrTooManyParticipants
+	}
+
+	usernames := make(map[string]bool, len(participants))
+
+	for _, participant := range participants {
+		if usernames[participant.Username] {
+			return nil, ErrDuplicateParticipant
+		}
+		usernames[participant.Username] = true
+
+		if participant.Value < 0 {
+			return nil, ErrNegativeShare
+		}
+	}
+
+	switch rule {
+	case RuleEqual:
+		return equalShares(total, len(participants)), nil
+	case RuleExact:
+		return exactShares(total, participants)
+	case RulePercentage:
+		return percentageShares(total, participants)
+	}
+
+	return nil, ErrUnsupportedRule
+}
+
+// equalShares splits the total into n shares which differ by at most one minor unit
+func equalShares(total int64, n int) []int64 {
+	shares := make([]int64, n)
+	base, extra := total/int64(n), total%int64(n)
+
+	for i := range shares {
+		shares[i] = base
+
+		if int64(i) < extra {
+			shares[i]++
+		}
+	}
+
+	return shares
+}
+
+// exactShares returns the amounts of the participants if they add up to the total
+func exactShares(total int64, participants []Participant) ([]int64, error) {
+	shares := make([]int64, len(participants))
+	var sum int64
+
+	for i, participant := range participants {
+		// the sum is checked before it is added to, so it can't overflow
+		if participant.Value > total-sum {
+			return nil, ErrExactAmountsMismatch
+		}
+
+		shares[i] = participant.Value
+		sum += participant.Value
+	}
+
+	if sum != total {
+		return nil, ErrExactAmountsMismatch
+	}
+
+	return shares, nil
+}
+
+// percentageShares splits the total by the basis points of the participants with the largest remainder method
+func percentageShares(total int64, participants []Participant) ([]int64, error) {
+	var points int64
+
+	for _, participant := range participants {
+		points += participant.Value
+
+		if points > WholeBasisPoints {
+			return nil, ErrPercentagesMismatch
+		}
+	}
+
+	if points != WholeBasisPoints {
+		return nil, ErrPercentagesMismatch
+	}
+
+	shares := make([]int64, len(participants))
+	remainders := make([]int64, len(participants))
+	left := total
+
+	// total is split into whole and rest, so the products stay far below the max of int64
+	whole, rest := total/WholeBasisPoints, total%WholeBasisPoints
+
+	for i, participant := range participants {
+		shares[i] = whole*participant.Value + rest*participant.Value/WholeBasisPoints
+		remainders[i] = rest * participant.Value % WholeBasisPoints
+		left -= shares[i]
+	}
+
+	order := make([]int, len(participants))
+
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+
+	// the rounded off units are fewer than the participants
+	for _, i := range order[:left] {
+		shares[i]++
+	}
+
+	return shares, nil
+}
@@ -0,0 +1,179 @@
+package split
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// participants returns the participants with the values in order
+func participants(values ...int64) []Participant {
+	result := make([]Participant, len(values))
+
+	for i, value := range values {
+		result[i] = Participant{Username: string(rune('a' + i)), Value: value}
+	}
+
+	return result
+}
+
+// TestShares tests the shares of each rule and that they add up to the total
+func TestShares(t *testing.T) {
+	testCases := []struct {
+		name           string
+		total          int64
+		rule           string
+		participants   []Participant
+		expectedShares []int64
+		expectedErr    error
+	}{
+		{
+			name:           "equal",
+			total:          900,
+			rule:           RuleEqual,
+			participants:   participants(0, 0, 0),
+			expectedShares: []int64{300, 300, 300},
+		},
+		{
+			name:           "equal with remainder goes to the first participants",
+			total:          1000,
+			rule:           RuleEqual,
+			participants:   participants(0, 0, 0),
+			expectedShares: []int64{334, 333, 333},
+		},
+		{
+			name:           "equal below a unit each",
+			total:          2,
+			rule:           RuleEqual,
+			participants:   participants(0, 0, 0),
+			expectedShares: []int64{1, 1, 0},
+		},
+		{
+			name:           "exact",
+			total:          1000,
+			rule:           RuleExact,
+			participants:   participants(250, 700, 50),
+			expectedShares: []int64{250, 700, 50},
+		},
+		{
+			name:         "exact below total",
+			total:        1000,
+			rule:         RuleExact,
+			participants: participants(250, 700),
+			expectedErr:  ErrExactAmountsMismatch,
+		},
+		{
+			name:         "exact above total",
+			total:        1000,
+			rule:         RuleExact,
+			participants: participants(900, 9223372036854775807),
+			expectedErr:  ErrExactAmountsMismatch,
+		},
+		{
+			name:           "percentage",
+			total:          1000,
+			rule:           RulePercentage,
+			participants:   participants(5000, 3000, 2000),
+			expectedShares: []int64{500, 300, 200},
+		},
+		{
+			name:           "percentage thirds",
+			total:          1000,
+			rule:           RulePercentage,
+			participants:   participants(3333, 3333, 3334),
+			expectedShares: []int64{333, 333, 334},
+		},
+		{
+			name:           "percentage rounds by the largest remainder",
+			total:          1001,
+			rule:           RulePercentage,
+			participants:   participants(1550, 4225, 4225),
+			expectedShares: []int64{155, 423, 423},
+		},
+		{
+			name:           "percentage ties go to the first participants",
+			total:          10,
+			rule:           RulePercentage,
+			participants:   participants(2500, 2500, 2500, 2500),
+			expectedShares: []int64{3, 3, 2, 2},
+		},
+		{
+			name:           "percentage of a large total",
+			total:          9000000000000000001,
+			rule:           RulePercentage,
+			participants:   participants(5000, 5000),
+			expectedShares: []int64{4500000000000000001, 4500000000000000000},
+		},
+		{
+			name:         "percentages below whole",
+			total:        1000,
+			rule:         RulePercentage,
+			participants: participants(5000, 4000),
+			expectedErr:  ErrPercentagesMismatch,
+		},
+		{
+			name:         "percentages above whole",
+			total:        1000,
+			rule:         RulePercentage,
+			participants: participants(5000, 6000),
+			expectedErr:  ErrPercentagesMismatch,
+		},
+		{
+			name:         "negative share",
+			total:        1000,
+			rule:         RuleExact,
+			participants: participants(1100, -100),
+			expectedErr:  ErrNegativeShare,
+		},
+		{
+			name:         "no participants",
+			total:        1000,
+			rule:         RuleEqual,
+			participants: nil,
+			expectedErr:  ErrNoParticipants,
+		},
+		{
+			name:         "too many participants",
+			total:        1000,
+			rule:         RuleEqual,
+			participants: participants(make([]int64, MaxParticipants+1)...),
+			expectedErr:  ErrTooManyParticipants,
+		},
+		{
+			name:         "duplicate participant",
+			total:        1000,
+			rule:         RuleEqual,
+			participants: []Participant{{Username: "a"}, {Username: "a"}},
+			expectedErr:  ErrDuplicateParticipant,
+		},
+		{
+			name:         "unsupported rule",
+			total:        1000,
+			rule:         "random",
+			participants: participants(0),
+			expectedErr:  ErrUnsupportedRule,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			shares, err := Shares(tc.total, tc.rule, tc.participants)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedShares, shares)
+
+			var sum int64
+
+			for _, share := range shares {
+				sum += share
+			}
+
+			require.Equal(t, tc.total, sum)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// camtNamespace is the namespace of the ISO 20022 bank to customer statement message
+const camtNamespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+// camtTime formats the time as an ISO date time in UTC
+func camtTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// camtAmount is an amount in the currency of the account, CAMT amounts are never negative,
+// their direction is the credit or debit indicator next to them
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// newCAMTAmount returns the CAMT amount and the credit or debit indicator of the amount
+func newCAMTAmount(amount int64, currency string) (camtAmount, string) {
+	value := util.FormatAmount(amount)
+
+	if amount < 0 {
+		return camtAmount{Currency: currency, Value: strings.TrimPrefix(value, "-")}, "DBIT"
+	}
+
+	return camtAmount{Currency: currency, Value: value}, "CRDT"
+}
+
+// camtGroupHeader identifies the message
+type camtGroupHeader struct {
+	XMLName         xml.Name `xml:"GrpHdr"`
+	MessageID       string   `xml:"MsgId"`
+	CreatedDateTime string   `xml:"CreDtTm"`
+}
+
+// camtAccount identifies the account of the statement
+type camtAccount struct {
+	XMLName  xml.Name `xml:"Acct"`
+	ID       string   `xml:"Id>Othr>Id"`
+	Currency string   `xml:"Ccy"`
+	Owner    string   `xml:"Ownr>Nm"`
+}
+
+// camtBalance is the opening (OPBD) or the closing (CLBD) balance of the statement
+type camtBalance struct {
+	XMLName   xml.Name   `xml:"Bal"`
+	Type      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amount    camtAmount `xml:"Amt"`
+	Indicator string     `xml:"CdtDbtInd"`
+	DateTime  string     `xml:"Dt>DtTm"`
+}
+
+// camtEntry is a line of the statement, CAMT has no running balance element, so it is written to the additional info
+type camtEntry struct {
+	XMLName         xml.Name   `xml:"Ntry"`
+	Reference       string     `xml:"NtryRef"`
+	Amount          camtAmount `xml:"Amt"`
+	Indicator       string     `xml:"CdtDbtInd"`
+	Status          string     `xml:"Sts>Cd"`
+	BookingDateTime string     `xml:"BookgDt>DtTm"`
+	ValueDateTime   string     `xml:"ValDt>DtTm"`
+	TransactionCode string     `xml:"BkTxCd>Prtry>Cd"`
+	AdditionalInfo  string     `xml:"AddtlNtryInf"`
+}
+
+// camtEncoder renders a statement as an ISO 20022 camt.053 bank to customer statement
+type camtEncoder struct {
+	*xmlWriter
+	statement Statement
+}
+
+// newCAMTEncoder creates a camtEncoder and writes everything which comes before the entries
+func newCAMTEncoder(w io.Writer, statement Statement) (*camtEncoder, error) {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return nil, err
+	}
+
+	encoder := &camtEncoder{
+		xmlWriter: newXMLWriter(w),
+		statement: statement,
+	}
+
+	if err := encoder.writeHeader(); err != nil {
+		return nil, err
+	}
+
+	return encoder, nil
+}
+
+// writeHeader writes the group header, the account and the balances of the statement
+func (encoder *camtEncoder) writeHeader() error {
+	statement := encoder.statement
+	currency := statement.Account.Currency
+
+	err := encoder.encoder.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "Document"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: camtNamespace}},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.start("BkToCstmrStmt"); err != nil {
+		return err
+	}
+
+	err = encoder.encoder.Encode(camtGroupHeader{
+		MessageID:       statement.ID(),
+		CreatedDateTime: camtTime(statement.GeneratedAt),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.start("Stmt"); err != nil {
+		return err
+	}
+
+	if err := encoder.element("Id", statement.ID()); err != nil {
+		return err
+	}
+
+	if err := encoder.element("CreDtTm", camtTime(statement.GeneratedAt)); err != nil {
+		return err
+	}
+
+	if err := encoder.start("FrToDt"); err != nil {
+		return err
+	}
+
+	if err := encoder.element("FrDtTm", camtTime(statement.From)); err != nil {
+		return err
+	}
+
+	if err := encoder.element("ToDtTm", camtTime(statement.To)); err != nil {
+		return err
+	}
+
+	if err := encoder.end("FrToDt"); err != nil {
+		return err
+	}
+
+	err = encoder.encoder.Encode(camtAccount{
+		ID:       strconv.FormatInt(statement.Account.ID, 10),
+		Currency: currency,
+		Owner:    statement.Account.Owner,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	opening, openingIndicator := newCAMTAmount(statement.OpeningBalance, currency)
+
+	err = encoder.encoder.Encode(camtBalance{
+		Type:      "OPBD",
+		Amount:    opening,
+		Indicator: openingIndicator,
+		DateTime:  camtTime(statement.From),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	closing, closingIndicator := newCAMTAmount(statement.ClosingBalance, currency)
+
+	return encoder.encoder.Encode(camtBalance{
+		Type:      "CLBD",
+		Amount:    closing,
+		Indicator: closingIndicator,
+		DateTime:  camtTime(statement.To),
+	})
+}
+
+// WriteLine writes the entry of the line
+func (encoder *camtEncoder) WriteLine(line Line) error {
+	amount, indicator := newCAMTAmount(line.Amount, encoder.statement.Account.Currency)
+	code := line.JournalKind
+
+	// entries which were written before the ledger have no journal transaction
+	if code == "" {
+		code = "entry"
+	}
+
+	return encoder.encoder.Encode(camtEntry{
+		Reference:       strconv.FormatInt(line.EntryID, 10),
+		Amount:          amount,
+		Indicator:       indicator,
+		Status:          "BOOK",
+		BookingDateTime: camtTime(line.CreatedAt),
+		ValueDateTime:   camtTime(line.CreatedAt),
+		TransactionCode: code,
+		AdditionalInfo:  line.Description() + ", balance " + util.FormatAmount(line.Balance),
+	})
+}
+
+// Close closes the open elements
+func (encoder *camtEncoder) Close() error {
+	if err := encoder.end("Stmt", "BkToCstmrStmt", "Document"); err != nil {
+		return err
+	}
+
+	return encoder.encoder.Flush()
+}
@@ -0,0 +1,83 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// csvEncoder renders a statement as CSV with a row for each line between the opening and closing balance rows
+type csvEncoder struct {
+	writer    *csv.Writer
+	statement Statement
+}
+
+// newCSVEncoder creates a csvEncoder and writes the column names and the opening balance row
+func newCSVEncoder(w io.Writer, statement Statement) (*csvEncoder, error) {
+	encoder := &csvEncoder{
+		writer:    csv.NewWriter(w),
+		statement: statement,
+	}
+
+	err := encoder.writer.Write([]string{"date", "entry_id", "journal_id", "description", "amount", "currency", "balance"})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.writeBalance(statement.From, "Opening balance", statement.OpeningBalance)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return encoder, nil
+}
+
+// writeBalance writes a row of a balance which has no entry
+func (encoder *csvEncoder) writeBalance(date time.Time, description string, balance int64) error {
+	return encoder.writer.Write([]string{
+		date.UTC().Format(time.RFC3339),
+		"",
+		"",
+		description,
+		"",
+		encoder.statement.Account.Currency,
+		util.FormatAmount(balance),
+	})
+}
+
+// WriteLine writes the row of the line
+func (encoder *csvEncoder) WriteLine(line Line) error {
+	journalID := ""
+
+	if line.JournalID != nil {
+		journalID = strconv.FormatInt(*line.JournalID, 10)
+	}
+
+	return encoder.writer.Write([]string{
+		line.CreatedAt.UTC().Format(time.RFC3339),
+		strconv.FormatInt(line.EntryID, 10),
+		journalID,
+		line.Description(),
+		util.FormatAmount(line.Amount),
+		encoder.statement.Account.Currency,
+		util.FormatAmount(line.Balance),
+	})
+}
+
+// Close writes the closing balance row and flushes the rows
+func (encoder *csvEncoder) Close() error {
+	err := encoder.writeBalance(encoder.statement.To, "Closing balance", encoder.statement.ClosingBalance)
+
+	if err != nil {
+		return err
+	}
+
+	encoder.writer.Flush()
+
+	return encoder.writer.Error()
+}
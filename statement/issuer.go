@@ -0,0 +1,147 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// issueBatchSize is how many accounts are read at once while the monthly statements are issued
+const issueBatchSize = 100
+
+// Issuer issues the monthly PDF statements of the accounts once their month is over
+type Issuer struct {
+	store     db.Store
+	generator *Generator
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// NewIssuer creates a new Issuer which checks for the statements to issue every interval
+func NewIssuer(store db.Store, interval time.Duration) *Issuer {
+	return &Issuer{
+		store:     store,
+		generator: NewGenerator(store),
+		interval:  interval,
+		now:       time.Now,
+	}
+}
+
+// Run issues the due monthly statements every interval until ctx is done
+func (issuer *Issuer) Run(ctx context.Context) {
+	ticker := time.NewTicker(issuer.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := issuer.IssueDue(ctx); err != nil {
+			// accounts stay without a statement, so they are picked again at the next tick
+			log.Println("cannot issue monthly statements:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// LastMonth returns the start and the exclusive end of the last month which is over at now, months are in UTC
+func LastMonth(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return end.AddDate(0, -1, 0), end
+}
+
+// IssueDue issues the statements of the last month for the accounts which don't have one yet,
+// it returns how many statements are issued. An account whose statement can't be issued doesn't stop the others,
+// the failures are returned together once every account is tried
+func (issuer *Issuer) IssueDue(ctx context.Context) (int, error) {
+	start, end := LastMonth(issuer.now())
+	count := 0
+
+	var failed []int64
+	var lastErr error
+
+	// accounts are read after the last one which is tried, so the failed ones aren't read again in this run
+	var afterID int64
+
+	for ctx.Err() == nil {
+		accounts, err := issuer.store.ListAccountsWithoutStatement(ctx, db.ListAccountsWithoutStatementParams{
+			AfterID:     afterID,
+			PeriodStart: start,
+			PeriodEnd:   end,
+			LimitCount:  issueBatchSize,
+		})
+
+		if err != nil {
+			return count, err
+		}
+
+		for _, account := range accounts {
+			afterID = account.ID
+
+			issued, err := issuer.issue(ctx, account, start, end)
+
+			if err != nil {
+				log.Printf("cannot issue the statement of account [%d]: %s", account.ID, err)
+				failed = append(failed, account.ID)
+				lastErr = err
+				continue
+			}
+
+			if issued {
+				count++
+			}
+		}
+
+		if len(accounts) < issueBatchSize {
+			break
+		}
+	}
+
+	if len(failed) > 0 {
+		return count, fmt.Errorf("cannot issue the statements of %d accounts %v, last error: %w", len(failed), failed, lastErr)
+	}
+
+	return count, nil
+}
+
+// issue renders the PDF statement of the account for the period and stores it,
+// it returns false if another instance has stored the statement meanwhile
+func (issuer *Issuer) issue(ctx context.Context, account db.Account, start, end time.Time) (bool, error) {
+	statement, err := issuer.generator.Generate(ctx, account, start, end)
+
+	if err != nil {
+		return false, err
+	}
+
+	var content bytes.Buffer
+
+	if err := issuer.generator.Write(ctx, &content, FormatPDF, statement); err != nil {
+		return false, err
+	}
+
+	_, err = issuer.store.CreateStatement(ctx, db.CreateStatementParams{
+		AccountID:      account.ID,
+		PeriodStart:    start,
+		PeriodEnd:      end,
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		FileName:       FileName(statement, FormatPDF),
+		Content:        content.Bytes(),
+	})
+
+	// the statement of the period is already stored
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLastMonth tests that the last month is the calendar month before now in UTC
+func TestLastMonth(t *testing.T) {
+	start, end := LastMonth(time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+	require.Equal(t, time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), end)
+
+	//! first of january is still in december of the previous year in UTC
+	start, end = LastMonth(time.Date(2023, 1, 1, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)))
+	require.Equal(t, time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+// TestIssueDue tests that the issuer stores a PDF statement of the last month for each account without one
+func TestIssueDue(t *testing.T) {
+	now := time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC)
+	start, end := LastMonth(now)
+
+	account1 := db.Account{ID: 1, Owner: "owner1", Currency: "USD"}
+	account2 := db.Account{ID: 2, Owner: "owner2", Currency: "EUR"}
+	account3 := db.Account{ID: 3, Owner: "owner3", Currency: "CAD"}
+
+	arg := db.ListAccountsWithoutStatementParams{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		LimitCount:  issueBatchSize,
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		expectedCount int
+		expectedErr   bool
+	}{
+		{
+			name: "nothing due",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsWithoutStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.Account{}, nil)
+				store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCount: 0,
+		},
+		{
+			name: "issued and already issued statements",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsWithoutStatement(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return([]db.Account{account1, account2}, nil)
+				store.EXPECT().GetAccountBalanceAt(gomock.Any(), gomock.Any()).Times(4).Return(int64(0), nil)
+				store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Times(2).
+					Return([]db.ListStatementEntriesRow{}, nil)
+
+				gomock.InOrder(
+					store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(1).
+						DoAndReturn(func(_ context.Context, arg db.CreateStatementParams) (db.CreateStatementRow, error) {
+							require.Equal(t, account1.ID, arg.AccountID)
+							require.Equal(t, start, arg.PeriodStart)
+							require.Equal(t, end, arg.PeriodEnd)
+							require.Equal(t, "statement-1-20230201-20230301.pdf", arg.FileName)
+							require.True(t, bytes.HasPrefix(arg.Content, []byte("%PDF-")))
+
+							return db.CreateStatementRow{ID: 1}, nil
+						}),
+					// another instance has issued the second one meanwhile
+					store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.CreateStatementRow{}, sql.ErrNoRows),
+				)
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "failing account in the middle",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsWithoutStatement(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return([]db.Account{account1, account2, account3}, nil)
+				store.EXPECT().GetAccountBalanceAt(gomock.Any(), gomock.Any()).Times(6).Return(int64(0), nil)
+				store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Any()).Times(3).
+					Return([]db.ListStatementEntriesRow{}, nil)
+
+				//! the statement of the second account can't be stored, the third one is still issued
+				gomock.InOrder(
+					store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.CreateStatementRow{ID: 1}, nil),
+					store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(1).
+						Return(db.CreateStatementRow{}, errors.New("connection reset")),
+					store.EXPECT().CreateStatement(gomock.Any(), gomock.Any()).Times(1).
+						DoAndReturn(func(_ context.Context, arg db.CreateStatementParams) (db.CreateStatementRow, error) {
+							require.Equal(t, account3.ID, arg.AccountID)
+
+							return db.CreateStatementRow{ID: 3}, nil
+						}),
+				)
+			},
+			expectedCount: 2,
+			expectedErr:   true,
+		},
+		{
+			name: "DB error",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsWithoutStatement(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(nil, errors.New("connection refused"))
+			},
+			expectedCount: 0,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			issuer := NewIssuer(store, time.Hour)
+			issuer.now = func() time.Time { return now }
+
+			count, err := issuer.IssueDue(context.Background())
+			require.Equal(t, tc.expectedCount, count)
+
+			if tc.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
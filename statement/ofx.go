@@ -0,0 +1,200 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// ofxHeader is the XML declaration and the OFX 2.1.1 processing instruction which open every OFX file
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="211" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// ofxBankID identifies our bank in the account aggregates of the OFX files
+const ofxBankID = "CACTUSBANK"
+
+// ofxTime formats the time as an OFX datetime in UTC
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405.000") + "[0:GMT]"
+}
+
+// ofxStatus is the status aggregate of an OFX response
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+// ofxSignOn is the sign-on response which every OFX file starts with
+type ofxSignOn struct {
+	XMLName  xml.Name  `xml:"SIGNONMSGSRSV1"`
+	Status   ofxStatus `xml:"SONRS>STATUS"`
+	DTServer string    `xml:"SONRS>DTSERVER"`
+	Language string    `xml:"SONRS>LANGUAGE"`
+}
+
+// ofxBankAccount is the account aggregate of the statement
+type ofxBankAccount struct {
+	XMLName  xml.Name `xml:"BANKACCTFROM"`
+	BankID   string   `xml:"BANKID"`
+	AcctID   string   `xml:"ACCTID"`
+	AcctType string   `xml:"ACCTTYPE"`
+}
+
+// ofxTransaction is a line of the statement, OFX has no running balance element, so it is written to the memo
+type ofxTransaction struct {
+	XMLName  xml.Name `xml:"STMTTRN"`
+	TrnType  string   `xml:"TRNTYPE"`
+	DTPosted string   `xml:"DTPOSTED"`
+	TrnAmt   string   `xml:"TRNAMT"`
+	FitID    string   `xml:"FITID"`
+	Name     string   `xml:"NAME"`
+	Memo     string   `xml:"MEMO"`
+}
+
+// ofxLedgerBalance is the closing balance of the statement
+type ofxLedgerBalance struct {
+	XMLName xml.Name `xml:"LEDGERBAL"`
+	BalAmt  string   `xml:"BALAMT"`
+	DTAsOf  string   `xml:"DTASOF"`
+}
+
+// ofxTransactionType returns the OFX type of the transaction of the line
+func ofxTransactionType(line Line) string {
+	switch line.JournalKind {
+	case db.JournalTransfer, db.JournalFxTransfer, db.JournalReversal:
+		return "XFER"
+	case db.JournalDeposit:
+		return "DEP"
+	case db.JournalWithdrawal:
+		return "CASH"
+	}
+
+	if line.Amount < 0 {
+		return "DEBIT"
+	}
+
+	return "CREDIT"
+}
+
+// ofxEncoder renders a statement as an OFX 2.1.1 bank statement response
+type ofxEncoder struct {
+	*xmlWriter
+	statement Statement
+}
+
+// newOFXEncoder creates an ofxEncoder and writes everything which comes before the transactions
+func newOFXEncoder(w io.Writer, statement Statement) (*ofxEncoder, error) {
+	if _, err := io.WriteString(w, ofxHeader); err != nil {
+		return nil, err
+	}
+
+	encoder := &ofxEncoder{
+		xmlWriter: newXMLWriter(w),
+		statement: statement,
+	}
+
+	if err := encoder.writeHeader(); err != nil {
+		return nil, err
+	}
+
+	return encoder, nil
+}
+
+// writeHeader writes the sign-on response, the account and opens the transaction list of the statement
+func (encoder *ofxEncoder) writeHeader() error {
+	statement := encoder.statement
+	success := ofxStatus{Code: 0, Severity: "INFO"}
+
+	if err := encoder.start("OFX"); err != nil {
+		return err
+	}
+
+	err := encoder.encoder.Encode(ofxSignOn{
+		Status:   success,
+		DTServer: ofxTime(statement.GeneratedAt),
+		Language: "ENG",
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.start("BANKMSGSRSV1", "STMTTRNRS"); err != nil {
+		return err
+	}
+
+	if err := encoder.element("TRNUID", statement.ID()); err != nil {
+		return err
+	}
+
+	if err := encoder.encoder.EncodeElement(success, xml.StartElement{Name: xml.Name{Local: "STATUS"}}); err != nil {
+		return err
+	}
+
+	if err := encoder.start("STMTRS"); err != nil {
+		return err
+	}
+
+	if err := encoder.element("CURDEF", statement.Account.Currency); err != nil {
+		return err
+	}
+
+	err = encoder.encoder.Encode(ofxBankAccount{
+		BankID:   ofxBankID,
+		AcctID:   strconv.FormatInt(statement.Account.ID, 10),
+		AcctType: "CHECKING",
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.start("BANKTRANLIST"); err != nil {
+		return err
+	}
+
+	if err := encoder.element("DTSTART", ofxTime(statement.From)); err != nil {
+		return err
+	}
+
+	return encoder.element("DTEND", ofxTime(statement.To))
+}
+
+// WriteLine writes the transaction of the line
+func (encoder *ofxEncoder) WriteLine(line Line) error {
+	return encoder.encoder.Encode(ofxTransaction{
+		TrnType:  ofxTransactionType(line),
+		DTPosted: ofxTime(line.CreatedAt),
+		TrnAmt:   util.FormatAmount(line.Amount),
+		FitID:    strconv.FormatInt(line.EntryID, 10),
+		Name:     line.Description(),
+		Memo:     "Balance " + util.FormatAmount(line.Balance),
+	})
+}
+
+// Close writes the closing balance and closes the open elements
+func (encoder *ofxEncoder) Close() error {
+	if err := encoder.end("BANKTRANLIST"); err != nil {
+		return err
+	}
+
+	err := encoder.encoder.Encode(ofxLedgerBalance{
+		BalAmt: util.FormatAmount(encoder.statement.ClosingBalance),
+		DTAsOf: ofxTime(encoder.statement.To),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.end("STMTRS", "STMTTRNRS", "BANKMSGSRSV1", "OFX"); err != nil {
+		return err
+	}
+
+	return encoder.encoder.Flush()
+}
@@ -0,0 +1,271 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/burakkarasel/Bank-App/util"
+)
+
+// layout of the PDF statements in points, pages are A4
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 8
+	pdfLineHeight   = 11
+	pdfTitleSize    = 16
+	pdfFooterHeight = 30
+)
+
+// widths of the columns of the lines in characters, the table is set in Courier so the columns line up
+const (
+	pdfDateWidth         = 16
+	pdfDescriptionWidth  = 30
+	pdfCounterpartyWidth = 26
+	pdfAmountWidth       = 14
+)
+
+// objects which are written before the pages, the pages object is written last since it lists every page
+const (
+	pdfCatalogObject   = 1
+	pdfPagesObject     = 2
+	pdfTitleFont       = 3
+	pdfTableFont       = 4
+	pdfFirstPageObject = 5
+)
+
+// pdfCountingWriter counts the bytes written to w, PDF files end with the offset of each of their objects
+type pdfCountingWriter struct {
+	w      io.Writer
+	offset int64
+}
+
+// Write writes p to w and counts its bytes
+func (writer *pdfCountingWriter) Write(p []byte) (int, error) {
+	n, err := writer.w.Write(p)
+	writer.offset += int64(n)
+
+	return n, err
+}
+
+// pdfEncoder renders a statement as a PDF with the standard fonts, the pages are written as they are filled
+type pdfEncoder struct {
+	writer    *pdfCountingWriter
+	statement Statement
+	offsets   map[int]int64
+	pageIDs   []int
+	nextID    int
+	page      bytes.Buffer
+	y         int
+}
+
+// newPDFEncoder creates a pdfEncoder and writes the header, the fonts and the summary of the statement
+func newPDFEncoder(w io.Writer, statement Statement) (*pdfEncoder, error) {
+	encoder := &pdfEncoder{
+		writer:    &pdfCountingWriter{w: w},
+		statement: statement,
+		offsets:   make(map[int]int64),
+		nextID:    pdfFirstPageObject,
+	}
+
+	if _, err := io.WriteString(encoder.writer, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.writeObject(pdfTitleFont, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.writeObject(pdfTableFont, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>"); err != nil {
+		return nil, err
+	}
+
+	encoder.startPage()
+	encoder.writeSummary()
+	encoder.writeColumns()
+
+	return encoder, nil
+}
+
+// writeObject writes the object with the given number and records its offset
+func (encoder *pdfEncoder) writeObject(id int, body string) error {
+	encoder.offsets[id] = encoder.writer.offset
+
+	_, err := fmt.Fprintf(encoder.writer, "%d 0 obj\n%s\nendobj\n", id, body)
+
+	return err
+}
+
+// text adds a line of text at the given position to the current page
+func (encoder *pdfEncoder) text(font string, size, x, y int, value string) {
+	fmt.Fprintf(&encoder.page, "BT /%s %d Tf %d %d Td (%s) Tj ET\n", font, size, x, y, pdfString(value))
+}
+
+// tableLine adds a line of the table to the current page and moves down
+func (encoder *pdfEncoder) tableLine(value string) {
+	encoder.text("F2", pdfFontSize, pdfMargin, encoder.y, value)
+	encoder.y -= pdfLineHeight
+}
+
+// startPage starts a new page from the top
+func (encoder *pdfEncoder) startPage() {
+	encoder.page.Reset()
+	encoder.y = pdfPageHeight - pdfMargin
+}
+
+// writeSummary adds the title, the account and the balances of the statement to the first page
+func (encoder *pdfEncoder) writeSummary() {
+	statement := encoder.statement
+
+	encoder.text("F1", pdfTitleSize, pdfMargin, encoder.y-pdfTitleSize, "Cactus Bank account statement")
+	encoder.y -= pdfTitleSize + 2*pdfLineHeight
+
+	encoder.tableLine(fmt.Sprintf("Account:          %d (%s)", statement.Account.ID, statement.Account.Currency))
+	encoder.tableLine(fmt.Sprintf("Owner:            %s", statement.Account.Owner))
+	encoder.tableLine(fmt.Sprintf("Period:           %s - %s (exclusive)", pdfTime(statement.From), pdfTime(statement.To)))
+	encoder.tableLine(fmt.Sprintf("Opening balance:  %s %s", util.FormatAmount(statement.OpeningBalance), statement.Account.Currency))
+	encoder.tableLine(fmt.Sprintf("Closing balance:  %s %s", util.FormatAmount(statement.ClosingBalance), statement.Account.Currency))
+	encoder.tableLine(fmt.Sprintf("Generated at:     %s", pdfTime(statement.GeneratedAt)))
+	encoder.y -= pdfLineHeight
+}
+
+// writeColumns adds the names of the columns to the current page
+func (encoder *pdfEncoder) writeColumns() {
+	encoder.tableLine(pdfRow("Date (UTC)", "Description", "Counterparty", "Amount", "Balance"))
+	encoder.tableLine(strings.Repeat("-", pdfDateWidth+pdfDescriptionWidth+pdfCounterpartyWidth+2*pdfAmountWidth+4))
+}
+
+// flushPage writes the current page with its content and footer
+func (encoder *pdfEncoder) flushPage() error {
+	pageID := encoder.nextID
+	contentID := encoder.nextID + 1
+	encoder.nextID += 2
+	encoder.pageIDs = append(encoder.pageIDs, pageID)
+
+	encoder.text("F2", pdfFontSize, pdfMargin, pdfMargin-pdfLineHeight, fmt.Sprintf("Page %d", len(encoder.pageIDs)))
+
+	page := fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Contents %d 0 R /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> >>",
+		pdfPagesObject, pdfPageWidth, pdfPageHeight, contentID, pdfTitleFont, pdfTableFont)
+
+	if err := encoder.writeObject(pageID, page); err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", encoder.page.Len(), encoder.page.String())
+
+	return encoder.writeObject(contentID, content)
+}
+
+// WriteLine adds the line to the current page, the page is written and a new one is started when it is full
+func (encoder *pdfEncoder) WriteLine(line Line) error {
+	if encoder.y < pdfMargin+pdfFooterHeight {
+		if err := encoder.flushPage(); err != nil {
+			return err
+		}
+
+		encoder.startPage()
+		encoder.writeColumns()
+	}
+
+	encoder.tableLine(pdfRow(
+		line.CreatedAt.UTC().Format("2006-01-02 15:04"),
+		line.Description(),
+		line.Counterparty,
+		util.FormatAmount(line.Amount),
+		util.FormatAmount(line.Balance),
+	))
+
+	return nil
+}
+
+// Close writes the last page, the page tree, the catalog and the cross-reference table
+func (encoder *pdfEncoder) Close() error {
+	if err := encoder.flushPage(); err != nil {
+		return err
+	}
+
+	kids := make([]string, len(encoder.pageIDs))
+
+	for i, id := range encoder.pageIDs {
+		kids[i] = fmt.Sprintf("%d 0 R", id)
+	}
+
+	pages := fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids))
+
+	if err := encoder.writeObject(pdfPagesObject, pages); err != nil {
+		return err
+	}
+
+	if err := encoder.writeObject(pdfCatalogObject, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObject)); err != nil {
+		return err
+	}
+
+	xref := encoder.writer.offset
+
+	var trailer strings.Builder
+	fmt.Fprintf(&trailer, "xref\n0 %d\n0000000000 65535 f \n", encoder.nextID)
+
+	for id := 1; id < encoder.nextID; id++ {
+		fmt.Fprintf(&trailer, "%010d 00000 n \n", encoder.offsets[id])
+	}
+
+	fmt.Fprintf(&trailer, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", encoder.nextID, pdfCatalogObject, xref)
+
+	_, err := io.WriteString(encoder.writer, trailer.String())
+
+	return err
+}
+
+// pdfTime formats the time in UTC for the statement
+func pdfTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04")
+}
+
+// pdfRow lays out the columns of a line of the table, text is cut to its column and amounts are aligned right
+func pdfRow(date, description, counterparty, amount, balance string) string {
+	return fmt.Sprintf("%-*s %-*s %-*s %*s %*s",
+		pdfDateWidth, pdfCut(date, pdfDateWidth),
+		pdfDescriptionWidth, pdfCut(description, pdfDescriptionWidth),
+		pdfCounterpartyWidth, pdfCut(counterparty, pdfCounterpartyWidth),
+		pdfAmountWidth, amount,
+		pdfAmountWidth, balance,
+	)
+}
+
+// pdfCut cuts the value to the given number of characters
+func pdfCut(value string, width int) string {
+	runes := []rune(value)
+
+	if len(runes) <= width {
+		return value
+	}
+
+	return string(runes[:width])
+}
+
+// pdfString encodes the value as the content of a PDF string in WinAnsiEncoding, characters which
+// the standard fonts can't show are replaced with ?
+func pdfString(value string) string {
+	var result strings.Builder
+
+	for _, r := range value {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			result.WriteByte('\\')
+			result.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			result.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			// latin-1 characters are the same in WinAnsiEncoding, we write them as octal escapes
+			fmt.Fprintf(&result, "\\%03o", r)
+		default:
+			result.WriteByte('?')
+		}
+	}
+
+	return result.String()
+}
@@ -0,0 +1,90 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/stretchr/testify/require"
+)
+
+// requireValidPDF checks that the cross-reference table of the PDF points to its objects and returns the page count
+func requireValidPDF(t *testing.T, data []byte) int {
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(data, []byte("%%EOF\n")))
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	require.NotNil(t, startxref)
+
+	xref, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data[xref:], []byte("xref\n")))
+
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+	require.NotEmpty(t, offsets)
+
+	for i, offset := range offsets {
+		at, err := strconv.Atoi(string(offset[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(data[at:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+
+	count := regexp.MustCompile(`/Type /Pages /Kids \[[^\]]*\] /Count (\d+)`).FindSubmatch(data)
+	require.NotNil(t, count)
+
+	pages, err := strconv.Atoi(string(count[1]))
+	require.NoError(t, err)
+
+	return pages
+}
+
+// TestWritePDF tests that PDF statements are valid and hold the lines with their counterparties
+func TestWritePDF(t *testing.T) {
+	_, data := testStatement(t, FormatPDF)
+
+	require.Equal(t, 1, requireValidPDF(t, data))
+	require.Contains(t, string(data), "(Cactus Bank account statement)")
+	require.Contains(t, string(data), "Opening balance:  10.00 USD")
+	require.Contains(t, string(data), "Closing balance:  12.50 USD")
+	require.Regexp(t, `2023-01-01 02:00 Transfer out +Jane Doe +-2.50 +12.50`, string(data))
+}
+
+// TestWritePDFPages tests that long statements continue on new pages
+func TestWritePDFPages(t *testing.T) {
+	var buf bytes.Buffer
+
+	statement := Statement{
+		Account: db.Account{ID: 1, Owner: "owner", Currency: "EUR"},
+		From:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	encoder, err := NewEncoder(&buf, FormatPDF, statement)
+	require.NoError(t, err)
+
+	for i := 1; i <= 200; i++ {
+		err = encoder.WriteLine(Line{
+			EntryID:     int64(i),
+			JournalKind: db.JournalDeposit,
+			Amount:      100,
+			Balance:     int64(i * 100),
+			CreatedAt:   statement.From.Add(time.Duration(i) * time.Minute),
+		})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, encoder.Close())
+	require.Greater(t, requireValidPDF(t, buf.Bytes()), 1)
+	require.Contains(t, buf.String(), "(Page 2)")
+}
+
+// TestPDFString tests escaping the text of the PDF strings
+func TestPDFString(t *testing.T) {
+	require.Equal(t, `a\(b\)c\\d`, pdfString(`a(b)c\d`))
+	require.Equal(t, `G\374l`, pdfString("Gül"))
+	require.Equal(t, "??", pdfString("日本"))
+}
@@ -0,0 +1,234 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+)
+
+// formats which the statements are rendered in
+const (
+	FormatCSV  = "csv"
+	FormatOFX  = "ofx"
+	FormatCAMT = "camt053"
+	FormatPDF  = "pdf"
+)
+
+// lineBatchSize is how many entries are read at once while the lines of a statement are written
+const lineBatchSize = 500
+
+var ErrUnsupportedFormat = errors.New("unsupported statement format")
+
+// IsSupportedFormat returns true if statements can be rendered in the given format
+func IsSupportedFormat(format string) bool {
+	switch format {
+	case FormatCSV, FormatOFX, FormatCAMT, FormatPDF:
+		return true
+	}
+
+	return false
+}
+
+// ContentType returns the media type of the statements which are rendered in the format
+func ContentType(format string) string {
+	switch format {
+	case FormatOFX:
+		return "application/x-ofx"
+	case FormatCAMT:
+		return "application/xml"
+	case FormatPDF:
+		return "application/pdf"
+	}
+
+	return "text/csv"
+}
+
+// FileName returns the name of the file of the statement in the format
+func FileName(statement Statement, format string) string {
+	extension := format
+
+	if format == FormatCAMT {
+		extension = "xml"
+	}
+
+	return fmt.Sprintf("statement-%d-%s-%s.%s", statement.Account.ID,
+		statement.From.UTC().Format("20060102"), statement.To.UTC().Format("20060102"), extension)
+}
+
+// Statement holds the account and the balances of a statement period, From is inclusive and To is exclusive
+type Statement struct {
+	Account        db.Account
+	From           time.Time
+	To             time.Time
+	OpeningBalance int64
+	ClosingBalance int64
+	GeneratedAt    time.Time
+}
+
+// ID returns the identifier of the statement which is written to the XML formats
+func (statement Statement) ID() string {
+	return fmt.Sprintf("STMT-%d-%d-%d", statement.Account.ID, statement.From.Unix(), statement.To.Unix())
+}
+
+// Line is an entry of the statement with the balance of the account right after it
+type Line struct {
+	EntryID     int64
+	JournalID   *int64
+	JournalKind string
+	// full name of the owner of the other account of the transfer, empty if the entry isn't a transfer
+	Counterparty string
+	Amount       int64
+	Balance      int64
+	CreatedAt    time.Time
+}
+
+// Description returns a readable description of the line, entries which were written before the ledger
+// have no journal transaction, so they are described by their direction
+func (line Line) Description() string {
+	switch line.JournalKind {
+	case db.JournalTransfer:
+		if line.Amount < 0 {
+			return "Transfer out"
+		}
+		return "Transfer in"
+	case db.JournalFxTransfer:
+		if line.Amount < 0 {
+			return "Currency exchange transfer out"
+		}
+		return "Currency exchange transfer in"
+	case db.JournalReversal:
+		return "Transfer reversal"
+	case db.JournalDeposit:
+		return "Deposit"
+	case db.JournalWithdrawal:
+		return "Withdrawal"
+	case db.JournalAdjustment:
+		return "Balance adjustment"
+	}
+
+	if line.Amount < 0 {
+		return "Debit"
+	}
+
+	return "Credit"
+}
+
+// Encoder renders a statement, the header is written when it is created and the footer when it is closed
+type Encoder interface {
+	WriteLine(line Line) error
+	Close() error
+}
+
+// NewEncoder creates an Encoder which renders the statement to w in the format and writes its header
+func NewEncoder(w io.Writer, format string, statement Statement) (Encoder, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVEncoder(w, statement)
+	case FormatOFX:
+		return newOFXEncoder(w, statement)
+	case FormatCAMT:
+		return newCAMTEncoder(w, statement)
+	case FormatPDF:
+		return newPDFEncoder(w, statement)
+	}
+
+	return nil, ErrUnsupportedFormat
+}
+
+// Generator generates the statements of the accounts from their entries
+type Generator struct {
+	store db.Store
+	now   func() time.Time
+}
+
+// NewGenerator creates a new Generator
+func NewGenerator(store db.Store) *Generator {
+	return &Generator{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Generate returns the statement of the account from from until to with its opening and closing balances,
+// which are the sums of the entries of the account before from and before to
+func (generator *Generator) Generate(ctx context.Context, account db.Account, from, to time.Time) (Statement, error) {
+	statement := Statement{
+		Account:     account,
+		From:        from,
+		To:          to,
+		GeneratedAt: generator.now(),
+	}
+
+	var err error
+
+	statement.OpeningBalance, err = generator.store.GetAccountBalanceAt(ctx, db.GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        from,
+	})
+
+	if err != nil {
+		return statement, err
+	}
+
+	statement.ClosingBalance, err = generator.store.GetAccountBalanceAt(ctx, db.GetAccountBalanceAtParams{
+		AccountID: account.ID,
+		At:        to,
+	})
+
+	return statement, err
+}
+
+// Write renders the statement to w in the format, the entries are read in batches,
+// so statements of long periods aren't kept in memory
+func (generator *Generator) Write(ctx context.Context, w io.Writer, format string, statement Statement) error {
+	encoder, err := NewEncoder(w, format, statement)
+
+	if err != nil {
+		return err
+	}
+
+	balance := statement.OpeningBalance
+	var afterID int64
+
+	for {
+		entries, err := generator.store.ListStatementEntries(ctx, db.ListStatementEntriesParams{
+			AccountID:  statement.Account.ID,
+			FromTime:   statement.From,
+			ToTime:     statement.To,
+			AfterID:    afterID,
+			LimitCount: lineBatchSize,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			balance += entry.Amount
+
+			err = encoder.WriteLine(Line{
+				EntryID:      entry.ID,
+				JournalID:    entry.JournalID,
+				JournalKind:  entry.JournalKind,
+				Counterparty: entry.Counterparty,
+				Amount:       entry.Amount,
+				Balance:      balance,
+				CreatedAt:    entry.CreatedAt,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			afterID = entry.ID
+		}
+
+		if len(entries) < lineBatchSize {
+			return encoder.Close()
+		}
+	}
+}
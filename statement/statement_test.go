@@ -0,0 +1,139 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	mockdb "github.com/burakkarasel/Bank-App/db/mock"
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// testStatement writes the statement of an account with a deposit and an outgoing transfer with the mock store
+func testStatement(t *testing.T, format string) (Statement, []byte) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+
+	account := db.Account{ID: util.RandomInt(1, 1000), Owner: util.RandomOwner(), Currency: util.USD}
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	journalID := int64(9)
+
+	store.EXPECT().GetAccountBalanceAt(gomock.Any(), gomock.Eq(db.GetAccountBalanceAtParams{AccountID: account.ID, At: from})).
+		Times(1).Return(int64(1000), nil)
+	store.EXPECT().GetAccountBalanceAt(gomock.Any(), gomock.Eq(db.GetAccountBalanceAtParams{AccountID: account.ID, At: to})).
+		Times(1).Return(int64(1250), nil)
+
+	arg := db.ListStatementEntriesParams{
+		AccountID:  account.ID,
+		FromTime:   from,
+		ToTime:     to,
+		AfterID:    0,
+		LimitCount: lineBatchSize,
+	}
+
+	store.EXPECT().ListStatementEntries(gomock.Any(), gomock.Eq(arg)).Times(1).Return([]db.ListStatementEntriesRow{
+		{ID: 1, AccountID: account.ID, Amount: 500, CreatedAt: from.Add(time.Hour), JournalKind: db.JournalDeposit},
+		{ID: 2, AccountID: account.ID, Amount: -250, CreatedAt: from.Add(2 * time.Hour), JournalID: &journalID, JournalKind: db.JournalTransfer, Counterparty: "Jane Doe"},
+	}, nil)
+
+	generator := NewGenerator(store)
+
+	statement, err := generator.Generate(context.Background(), account, from, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), statement.OpeningBalance)
+	require.Equal(t, int64(1250), statement.ClosingBalance)
+
+	var buf bytes.Buffer
+	err = generator.Write(context.Background(), &buf, format, statement)
+	require.NoError(t, err)
+
+	return statement, buf.Bytes()
+}
+
+// TestWriteCSV tests that CSV statements have the opening and closing balances and a running balance per line
+func TestWriteCSV(t *testing.T) {
+	_, data := testStatement(t, FormatCSV)
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+
+	require.Equal(t, []string{"date", "entry_id", "journal_id", "description", "amount", "currency", "balance"}, records[0])
+	require.Equal(t, []string{"2023-01-01T00:00:00Z", "", "", "Opening balance", "", "USD", "10.00"}, records[1])
+	require.Equal(t, []string{"2023-01-01T01:00:00Z", "1", "", "Deposit", "5.00", "USD", "15.00"}, records[2])
+	require.Equal(t, []string{"2023-01-01T02:00:00Z", "2", "9", "Transfer out", "-2.50", "USD", "12.50"}, records[3])
+	require.Equal(t, []string{"2023-02-01T00:00:00Z", "", "", "Closing balance", "", "USD", "12.50"}, records[4])
+}
+
+// TestWriteOFX tests that OFX statements are well-formed and hold the transactions and the closing balance
+func TestWriteOFX(t *testing.T) {
+	statement, data := testStatement(t, FormatOFX)
+
+	require.True(t, bytes.HasPrefix(data, []byte(ofxHeader)))
+
+	var got struct {
+		Currency     string           `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>CURDEF"`
+		Account      ofxBankAccount   `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKACCTFROM"`
+		Transactions []ofxTransaction `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>BANKTRANLIST>STMTTRN"`
+		Balance      ofxLedgerBalance `xml:"BANKMSGSRSV1>STMTTRNRS>STMTRS>LEDGERBAL"`
+	}
+	err := xml.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, "USD", got.Currency)
+	require.Equal(t, ofxBankID, got.Account.BankID)
+	require.Len(t, got.Transactions, 2)
+	require.Equal(t, "DEP", got.Transactions[0].TrnType)
+	require.Equal(t, "5.00", got.Transactions[0].TrnAmt)
+	require.Equal(t, "Balance 15.00", got.Transactions[0].Memo)
+	require.Equal(t, "XFER", got.Transactions[1].TrnType)
+	require.Equal(t, "-2.50", got.Transactions[1].TrnAmt)
+	require.Equal(t, "20230101020000.000[0:GMT]", got.Transactions[1].DTPosted)
+	require.Equal(t, "12.50", got.Balance.BalAmt)
+	require.Equal(t, ofxTime(statement.To), got.Balance.DTAsOf)
+}
+
+// TestWriteCAMT tests that CAMT statements are well-formed and hold the balances and the entries
+func TestWriteCAMT(t *testing.T) {
+	statement, data := testStatement(t, FormatCAMT)
+
+	var got struct {
+		XMLName  xml.Name      `xml:"urn:iso:std:iso:20022:tech:xsd:camt.053.001.08 Document"`
+		ID       string        `xml:"BkToCstmrStmt>Stmt>Id"`
+		Balances []camtBalance `xml:"BkToCstmrStmt>Stmt>Bal"`
+		Entries  []camtEntry   `xml:"BkToCstmrStmt>Stmt>Ntry"`
+	}
+	err := xml.Unmarshal(data, &got)
+	require.NoError(t, err)
+
+	require.Equal(t, statement.ID(), got.ID)
+	require.Len(t, got.Balances, 2)
+	require.Equal(t, "OPBD", got.Balances[0].Type)
+	require.Equal(t, "10.00", got.Balances[0].Amount.Value)
+	require.Equal(t, "CLBD", got.Balances[1].Type)
+	require.Equal(t, "12.50", got.Balances[1].Amount.Value)
+
+	require.Len(t, got.Entries, 2)
+	require.Equal(t, "CRDT", got.Entries[0].Indicator)
+	require.Equal(t, db.JournalDeposit, got.Entries[0].TransactionCode)
+	require.Equal(t, "DBIT", got.Entries[1].Indicator)
+	require.Equal(t, "2.50", got.Entries[1].Amount.Value)
+	require.Equal(t, "USD", got.Entries[1].Amount.Currency)
+	require.Equal(t, "Transfer out, balance 12.50", got.Entries[1].AdditionalInfo)
+}
+
+// TestNewEncoderUnsupportedFormat tests that statements can't be rendered in unknown formats
+func TestNewEncoderUnsupportedFormat(t *testing.T) {
+	_, err := NewEncoder(&bytes.Buffer{}, "docx", Statement{})
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+	require.False(t, IsSupportedFormat("docx"))
+}
@@ -0,0 +1,46 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// xmlWriter writes the XML formats token by token, so the lines are streamed between the header and the footer
+type xmlWriter struct {
+	encoder *xml.Encoder
+}
+
+// newXMLWriter creates an xmlWriter which indents the elements it writes to w
+func newXMLWriter(w io.Writer) *xmlWriter {
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	return &xmlWriter{encoder: encoder}
+}
+
+// start opens the elements in the given order
+func (writer *xmlWriter) start(names ...string) error {
+	for _, name := range names {
+		if err := writer.encoder.EncodeToken(xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// end closes the elements in the given order
+func (writer *xmlWriter) end(names ...string) error {
+	for _, name := range names {
+		if err := writer.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// element writes an element which only holds the value
+func (writer *xmlWriter) element(name, value string) error {
+	return writer.encoder.EncodeElement(value, xml.StartElement{Name: xml.Name{Local: name}})
+}
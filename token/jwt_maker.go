@@ -24,10 +24,10 @@ func NewJWTMaker(secretKey string) (Maker, error) {
 	return &JWTMaker{secretKey}, nil
 }
 
-// CreateToken creates a new token for a specific username for a specific duration
-func (maker *JWTMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
+// CreateToken creates a new token for a specific username and role for a specific duration
+func (maker *JWTMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
 	// here we create a new payload for the token
-	payload, err := NewPayload(username, duration)
+	payload, err := NewPayload(username, role, duration)
 
 	// check for error if any error occurs we return an empty string and the error
 	if err != nil {
@@ -17,6 +17,7 @@ func TestJWTMaker(t *testing.T) {
 
 	// then we create a new random owner for username
 	username := util.RandomOwner()
+	role := util.DepositorRole
 	duration := time.Minute
 
 	// we assign time.Now to issued at, and add duration to it and assign it to expired at
@@ -24,7 +25,7 @@ func TestJWTMaker(t *testing.T) {
 	expiredAt := issuedAt.Add(duration)
 
 	// then we create token with these information
-	token, payload, err := maker.CreateToken(username, duration)
+	token, payload, err := maker.CreateToken(username, role, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -36,6 +37,7 @@ func TestJWTMaker(t *testing.T) {
 
 	require.NotZero(t, payload.ID)
 	require.Equal(t, username, payload.Username)
+	require.Equal(t, role, payload.Role)
 	require.WithinDuration(t, issuedAt, payload.IssuedAt, time.Second)
 	require.WithinDuration(t, expiredAt, payload.ExpiredAt, time.Second)
 }
@@ -47,7 +49,7 @@ func TestExpiredJWTToken(t *testing.T) {
 	require.NoError(t, err)
 
 	// then we create a new token with -1 minute duration which will be always expired
-	token, payload, err := maker.CreateToken(util.RandomOwner(), -time.Minute)
+	token, payload, err := maker.CreateToken(util.RandomOwner(), util.DepositorRole, -time.Minute)
 	require.NoError(t, err)
 	require.NotEmpty(t, token)
 	require.NotEmpty(t, payload)
@@ -62,7 +64,7 @@ func TestExpiredJWTToken(t *testing.T) {
 // TestInvalidJWTTokenAlgNone
 func TestInvalidJWTTokenAlgNone(t *testing.T) {
 	// here we create a new payload with random owner and 1 minute duration
-	payload, err := NewPayload(util.RandomOwner(), time.Minute)
+	payload, err := NewPayload(util.RandomOwner(), util.DepositorRole, time.Minute)
 	require.NoError(t, err)
 
 	// here we create a new token with no signing method and this payload
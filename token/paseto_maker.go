@@ -28,10 +28,10 @@ func NewPasetoMaker(symmetricKey string) (Maker, error) {
 	return maker, nil
 }
 
-// CreateToken creates a new token for a specific username for a specific duration
-func (maker *PasetoMaker) CreateToken(username string, duration time.Duration) (string, *Payload, error) {
+// CreateToken creates a new token for a specific username and role for a specific duration
+func (maker *PasetoMaker) CreateToken(username string, role string, duration time.Duration) (string, *Payload, error) {
 	// we create a new payload
-	payload, err := NewPayload(username, duration)
+	payload, err := NewPayload(username, role, duration)
 
 	if err != nil {
 		return "", payload, err
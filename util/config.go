@@ -8,14 +8,26 @@ import (
 
 // Config holds the configuration values of the app.
 type Config struct {
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GrpcServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
+	DBDriver                     string        `mapstructure:"DB_DRIVER"`
+	DBSource                     string        `mapstructure:"DB_SOURCE"`
+	DBTxMaxRetries               int           `mapstructure:"DB_TX_MAX_RETRIES"`
+	DBTxRetryDelay               time.Duration `mapstructure:"DB_TX_RETRY_DELAY"`
+	HTTPServerAddress            string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GrpcServerAddress            string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	GatewayServerAddress         string        `mapstructure:"GATEWAY_SERVER_ADDRESS"`
+	ServerModes                  []string      `mapstructure:"SERVER_MODES"`
+	ShutdownTimeout              time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+	TokenSymmetricKey            string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	AccessTokenDuration          time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration         time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	MigrationURL                 string        `mapstructure:"MIGRATION_URL"`
+	FxRatesFile                  string        `mapstructure:"FX_RATES_FILE"`
+	FxQuoteDuration              time.Duration `mapstructure:"FX_QUOTE_DURATION"`
+	SchedulerInterval            time.Duration `mapstructure:"SCHEDULER_INTERVAL"`
+	ReconcileInterval            time.Duration `mapstructure:"RECONCILE_INTERVAL"`
+	StatementInterval            time.Duration `mapstructure:"STATEMENT_INTERVAL"`
+	HoldSweepInterval            time.Duration `mapstructure:"HOLD_SWEEP_INTERVAL"`
+	PaymentRequestExpiryInterval time.Duration `mapstructure:"PAYMENT_REQUEST_EXPIRY_INTERVAL"`
 }
 
 // LoadConfig reads configuration from file or environment variables
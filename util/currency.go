@@ -1,5 +1,10 @@
 package util
 
+import (
+	"fmt"
+	"math/big"
+)
+
 const (
 	USD = "USD"
 	EUR = "EUR"
@@ -16,3 +21,41 @@ func IsSupportedCurrency(currency string) bool {
 
 	return false
 }
+
+// ConvertAmount converts an amount in minor units with the given decimal rate,
+// the result is rounded half away from zero to the nearest minor unit
+func ConvertAmount(amount int64, rate string) (int64, error) {
+	r, ok := new(big.Rat).SetString(rate)
+
+	if !ok || r.Sign() <= 0 {
+		return 0, fmt.Errorf("invalid rate: %s", rate)
+	}
+
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), r)
+
+	quo, rem := new(big.Int).QuoRem(product.Num(), product.Denom(), new(big.Int))
+
+	// if the remainder is at least half of the denominator we round away from zero
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(product.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(product.Sign())))
+	}
+
+	if !quo.IsInt64() {
+		return 0, fmt.Errorf("converted amount overflows: %s", quo)
+	}
+
+	return quo.Int64(), nil
+}
+
+// FormatAmount formats an amount in minor units as a decimal with two fraction digits, every supported currency has two
+func FormatAmount(amount int64) string {
+	sign := ""
+	value := uint64(amount)
+
+	if amount < 0 {
+		sign = "-"
+		value = uint64(-amount)
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, value/100, value%100)
+}
@@ -0,0 +1,48 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConvertAmount tests converting amounts with decimal rates
+func TestConvertAmount(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   int64
+		rate     string
+		expected int64
+	}{
+		{name: "same rate", amount: 1000, rate: "1", expected: 1000},
+		{name: "round down", amount: 1000, rate: "0.92341", expected: 923},
+		{name: "round half up", amount: 10, rate: "1.25", expected: 13},
+		{name: "negative round half away from zero", amount: -10, rate: "1.25", expected: -13},
+		{name: "zero amount", amount: 0, rate: "1.36", expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := ConvertAmount(tc.amount, tc.rate)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, converted)
+		})
+	}
+
+	// invalid rates can't be used
+	for _, rate := range []string{"", "abc", "0", "-1.2"} {
+		_, err := ConvertAmount(100, rate)
+		require.Error(t, err)
+	}
+}
+
+// TestFormatAmount tests formatting amounts in minor units as decimals
+func TestFormatAmount(t *testing.T) {
+	require.Equal(t, "0.00", FormatAmount(0))
+	require.Equal(t, "0.05", FormatAmount(5))
+	require.Equal(t, "12.34", FormatAmount(1234))
+	require.Equal(t, "-0.50", FormatAmount(-50))
+	require.Equal(t, "-1000.00", FormatAmount(-100000))
+	require.Equal(t, "-92233720368547758.08", FormatAmount(math.MinInt64))
+}
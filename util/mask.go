@@ -0,0 +1,39 @@
+package util
+
+import "strings"
+
+// maskRune replaces the hidden characters of the masked values
+const maskRune = "*"
+
+// MaskName keeps the first letter of each word of the name and masks the rest
+func MaskName(name string) string {
+	words := strings.Fields(name)
+
+	for i, word := range words {
+		words[i] = maskWord(word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// MaskEmail keeps the first letter of the local part and the domain of the email and masks the rest
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+
+	if at < 0 {
+		return maskWord(email)
+	}
+
+	return maskWord(email[:at]) + email[at:]
+}
+
+// maskWord keeps the first letter of the word and masks the rest with as many characters
+func maskWord(word string) string {
+	runes := []rune(word)
+
+	if len(runes) == 0 {
+		return word
+	}
+
+	return string(runes[0]) + strings.Repeat(maskRune, len(runes)-1)
+}
@@ -0,0 +1,20 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMask tests masking the names and the emails of the payees
+func TestMask(t *testing.T) {
+	require.Equal(t, "J*** D**", MaskName("John Doe"))
+	require.Equal(t, "J*** D**", MaskName("  John   Doe "))
+	require.Equal(t, "Ö**", MaskName("Öze"))
+	require.Equal(t, "", MaskName(""))
+
+	require.Equal(t, "j***@example.com", MaskEmail("john@example.com"))
+	require.Equal(t, "j@example.com", MaskEmail("j@example.com"))
+	require.Equal(t, "@example.com", MaskEmail("@example.com"))
+	require.Equal(t, "j***", MaskEmail("john"))
+}
@@ -0,0 +1,17 @@
+package util
+
+// roles of the users
+const (
+	DepositorRole = "depositor"
+	AdminRole     = "admin"
+)
+
+// IsSupportedRole returns true if the role is supported
+func IsSupportedRole(role string) bool {
+	switch role {
+	case DepositorRole, AdminRole:
+		return true
+	}
+
+	return false
+}
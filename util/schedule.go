@@ -0,0 +1,53 @@
+package util
+
+import "time"
+
+const (
+	Once    = "once"
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+)
+
+// IsSupportedFrequency returns true if given frequency of a scheduled transfer is supported
+func IsSupportedFrequency(frequency string) bool {
+	switch frequency {
+	case Once, Daily, Weekly, Monthly:
+		return true
+	}
+
+	return false
+}
+
+// Occurrence returns the nth occurrence (starting from 0) of a schedule which starts at start,
+// it returns false if the schedule has no such occurrence
+func Occurrence(frequency string, start time.Time, n int) (time.Time, bool) {
+	switch frequency {
+	case Once:
+		return start, n == 0
+	case Daily:
+		return start.AddDate(0, 0, n), true
+	case Weekly:
+		return start.AddDate(0, 0, 7*n), true
+	case Monthly:
+		return addMonths(start, n), true
+	}
+
+	return time.Time{}, false
+}
+
+// addMonths adds months to t, the day is clamped to the last day of the month
+// so a schedule starting on the 31st runs on the last day of the shorter months
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	// day 0 of the following month is the last day of the target month
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month+time.Month(months), day, hour, min, sec, t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOccurrence tests calculating occurrences of schedules
+func TestOccurrence(t *testing.T) {
+	start := time.Date(2023, time.January, 31, 9, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		frequency string
+		n         int
+		expected  time.Time
+		ok        bool
+	}{
+		{name: "once first", frequency: Once, n: 0, expected: start, ok: true},
+		{name: "once second", frequency: Once, n: 1, ok: false},
+		{name: "daily", frequency: Daily, n: 1, expected: time.Date(2023, time.February, 1, 9, 30, 0, 0, time.UTC), ok: true},
+		{name: "weekly", frequency: Weekly, n: 2, expected: time.Date(2023, time.February, 14, 9, 30, 0, 0, time.UTC), ok: true},
+		{name: "monthly clamped", frequency: Monthly, n: 1, expected: time.Date(2023, time.February, 28, 9, 30, 0, 0, time.UTC), ok: true},
+		{name: "monthly after clamp", frequency: Monthly, n: 2, expected: time.Date(2023, time.March, 31, 9, 30, 0, 0, time.UTC), ok: true},
+		{name: "monthly next year", frequency: Monthly, n: 13, expected: time.Date(2024, time.February, 29, 9, 30, 0, 0, time.UTC), ok: true},
+		{name: "unsupported", frequency: "hourly", n: 1, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrence, ok := Occurrence(tc.frequency, start, tc.n)
+			require.Equal(t, tc.ok, ok)
+
+			if tc.ok {
+				require.Equal(t, tc.expected, occurrence)
+			}
+		})
+	}
+}
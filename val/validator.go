@@ -4,6 +4,15 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"strings"
+	"time"
+
+	db "github.com/burakkarasel/Bank-App/db/sqlc"
+	"github.com/burakkarasel/Bank-App/pagination"
+	"github.com/burakkarasel/Bank-App/split"
+	"github.com/burakkarasel/Bank-App/statement"
+	"github.com/burakkarasel/Bank-App/util"
+	"github.com/google/uuid"
 )
 
 var (
@@ -49,6 +58,14 @@ func ValidateEmail(value string) error {
 	return nil
 }
 
+// ValidateRecipient checks if a given recipient of a transfer is a valid username or email
+func ValidateRecipient(value string) error {
+	if strings.Contains(value, "@") {
+		return ValidateEmail(value)
+	}
+	return ValidateUsername(value)
+}
+
 // ValidateFullName checks for a given full name input if it meets the validations
 func ValidateFullName(value string) error {
 	if err := ValidateString(value, 3, 100); err != nil {
@@ -58,6 +75,176 @@ func ValidateFullName(value string) error {
 	if !isValidFullName(value) {
 		return fmt.Errorf("must contain only letters or spaces")
 	}
+	return nil
+}
+
+// ValidateID checks if a given ID is a valid positive number
+func ValidateID(value int64) error {
+	if value < 1 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+// ValidateCurrency checks if a given currency is supported
+func ValidateCurrency(value string) error {
+	if !util.IsSupportedCurrency(value) {
+		return fmt.Errorf("unsupported currency %s", value)
+	}
+	return nil
+}
+
+// ValidateTransferAmount checks if a given transfer amount is greater than zero
+func ValidateTransferAmount(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be greater than zero")
+	}
+	return nil
+}
+
+// ValidateEntryAmount checks if a given entry amount is not zero, it can be negative or positive
+func ValidateEntryAmount(value int64) error {
+	if value == 0 {
+		return fmt.Errorf("must not be zero")
+	}
+	return nil
+}
+
+// ValidatePageID checks if a given page ID is valid
+func ValidatePageID(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be at least 1")
+	}
+	return nil
+}
+
+// ValidatePageSize checks if a given page size is in the allowed range
+func ValidatePageSize(value int32) error {
+	if value < 5 || value > 10 {
+		return fmt.Errorf("must be from 5-10")
+	}
+	return nil
+}
+
+// ValidateUUID checks if a given string is a valid UUID
+func ValidateUUID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+// ValidateIdempotencyKey checks if a given idempotency key has a valid length
+func ValidateIdempotencyKey(value string) error {
+	return ValidateString(value, 1, 255)
+}
+
+// ValidateFrequency checks if a given frequency of a scheduled transfer is supported
+func ValidateFrequency(value string) error {
+	if !util.IsSupportedFrequency(value) {
+		return fmt.Errorf("unsupported frequency %s", value)
+	}
+	return nil
+}
+
+// ValidateMaxRetries checks if a given retry count of a scheduled transfer is in the allowed range
+func ValidateMaxRetries(value int32) error {
+	if value < 0 || value > 10 {
+		return fmt.Errorf("must be from 0-10")
+	}
+	return nil
+}
+
+// ValidateRetryInterval checks if a given retry interval in seconds is not negative, it must be at least a second
+// when failed executions are retried so that the retries aren't run back-to-back
+func ValidateRetryInterval(value int64, maxRetries int32) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative")
+	}
 
+	if maxRetries > 0 && value < 1 {
+		return fmt.Errorf("must be at least 1 when max_retries is set")
+	}
 	return nil
 }
+
+// ValidateHoldTTL checks if a given TTL of a hold in seconds is in the allowed range, zero is the default TTL
+func ValidateHoldTTL(value int64) error {
+	if maxTTL := int64(db.MaxHoldTTL / time.Second); value < 0 || value > maxTTL {
+		return fmt.Errorf("must be from 0-%d", maxTTL)
+	}
+	return nil
+}
+
+// ValidatePaymentRequestTTL checks if a given TTL of a payment request in seconds is in the allowed range, zero is the default TTL
+func ValidatePaymentRequestTTL(value int64) error {
+	if maxTTL := int64(db.MaxPaymentRequestTTL / time.Second); value < 0 || value > maxTTL {
+		return fmt.Errorf("must be from 0-%d", maxTTL)
+	}
+	return nil
+}
+
+// ValidateMemo checks if a given memo of a payment request isn't too long, it may be empty
+func ValidateMemo(value string) error {
+	return ValidateString(value, 0, 140)
+}
+
+// ValidatePaymentRequestStatus checks if payment requests can be filtered by a given status, empty status matches every request
+func ValidatePaymentRequestStatus(value string) error {
+	switch value {
+	case "", db.PaymentRequestPending, db.PaymentRequestAccepted, db.PaymentRequestDeclined, db.PaymentRequestCancelled,
+		db.PaymentRequestExpired:
+		return nil
+	}
+	return fmt.Errorf("unsupported payment request status %s", value)
+}
+
+// ValidateSplitRule checks if bills can be split by a given rule
+func ValidateSplitRule(value string) error {
+	if !split.IsSupportedRule(value) {
+		return fmt.Errorf("unsupported split rule %s", value)
+	}
+	return nil
+}
+
+// ValidateStatementFormat checks if statements can be rendered in a given format
+func ValidateStatementFormat(value string) error {
+	if !statement.IsSupportedFormat(value) {
+		return fmt.Errorf("unsupported statement format %s", value)
+	}
+	return nil
+}
+
+// ValidateCursorPageSize checks if a given size of a page which is paged by cursor is in the allowed range, zero is the default size
+func ValidateCursorPageSize(value int32) error {
+	if value < 0 || value > pagination.MaxPageSize {
+		return fmt.Errorf("must be from 0-%d", pagination.MaxPageSize)
+	}
+	return nil
+}
+
+// ValidateSort checks if a given sort order is supported, empty order is ascending
+func ValidateSort(value string) error {
+	if value != "" && !pagination.IsSupportedSort(value) {
+		return fmt.Errorf("unsupported sort order %s", value)
+	}
+	return nil
+}
+
+// ValidateEntryDirection checks if entries can be filtered by a given direction, empty direction matches every entry
+func ValidateEntryDirection(value string) error {
+	switch value {
+	case "", db.DirectionCredit, db.DirectionDebit:
+		return nil
+	}
+	return fmt.Errorf("must be %s or %s", db.DirectionCredit, db.DirectionDebit)
+}
+
+// ValidateTransferDirection checks if transfers can be filtered by a given direction, empty direction matches every transfer
+func ValidateTransferDirection(value string) error {
+	switch value {
+	case "", db.DirectionIncoming, db.DirectionOutgoing:
+		return nil
+	}
+	return fmt.Errorf("must be %s or %s", db.DirectionIncoming, db.DirectionOutgoing)
+}